@@ -27,8 +27,11 @@ import (
 	"gitlab.com/autokubeops/serverless"
 	"gitlab.com/go-prism/prism3/batch/internal/task"
 	"gitlab.com/go-prism/prism3/batch/internal/task/helmidx"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/integrity"
+	"gitlab.com/go-prism/prism3/core/pkg/retention"
 	"gitlab.com/go-prism/prism3/core/pkg/storage"
 	"gitlab.com/go-prism/prism3/core/pkg/tasks"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
@@ -47,8 +50,9 @@ type environment struct {
 	DB struct {
 		DSN string `split_words:"true" required:"true"`
 	}
-	S3  storage.S3Options
-	Dev struct {
+	S3      storage.S3Options
+	Storage storage.Options
+	Dev     struct {
 		Handlers bool `split_words:"true" default:"true"`
 	}
 	Redis struct {
@@ -56,6 +60,9 @@ type environment struct {
 		Password string `split_words:"true"`
 	}
 	Otel tracing.OtelOptions
+	OSV  struct {
+		Sources []string `split_words:"true"`
+	}
 }
 
 func main() {
@@ -88,7 +95,7 @@ func main() {
 		return
 	}
 	repos := repo.NewRepos(database.DB())
-	s3, err := storage.NewS3(context.Background(), e.S3)
+	store, err := storage.New(context.Background(), e.Storage, e.S3)
 	if err != nil {
 		log.Error(err, "failed to connect to object storage")
 		os.Exit(1)
@@ -104,16 +111,21 @@ func main() {
 	client := asynq.NewClient(redisOpt)
 
 	// configure tasks
-	helm := helmidx.NewHelmProcessor(repos, s3)
-	rp := task.NewRemoteProcessor(client, repos, helm)
+	helm := helmidx.NewHelmProcessor(repos, store)
+	rp := task.NewRemoteProcessor(client, repos, helm, retention.NewCollector(repos, store), integrity.NewVerifier(repos, store), advisory.NewImporter(repos, store))
 
 	handler := asynq.NewServeMux()
 	handler.Handle(tasks.TypeHelmRepository, helm)
 	handler.HandleFunc(tasks.TypeIndexRemote, rp.HandleIndexTask)
 	handler.HandleFunc(tasks.TypeIndexRemoteAll, rp.HandleIndexAllTask)
+	handler.HandleFunc(tasks.TypeCollectGarbage, rp.HandleCollectTask)
+	handler.HandleFunc(tasks.TypeCollectGarbageAll, rp.HandleCollectAllTask)
+	handler.HandleFunc(tasks.TypeVerifyRemote, rp.HandleVerifyTask)
+	handler.HandleFunc(tasks.TypeVerifyRemoteAll, rp.HandleVerifyAllTask)
+	handler.HandleFunc(tasks.TypeImportAdvisories, rp.HandleImportAdvisoriesTask)
 
 	mgr, err := asynq.NewPeriodicTaskManager(asynq.PeriodicTaskManagerOpts{
-		PeriodicTaskConfigProvider: task.NewStaticConfigProvider(ctx, rp),
+		PeriodicTaskConfigProvider: task.NewStaticConfigProvider(ctx, rp, e.OSV.Sources),
 		RedisConnOpt:               redisOpt,
 		SyncInterval:               time.Minute,
 	})
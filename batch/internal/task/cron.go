@@ -6,21 +6,44 @@ import (
 	"gitlab.com/go-prism/prism3/core/pkg/tasks"
 )
 
-func NewStaticConfigProvider(ctx context.Context, rp *RemoteProcessor) *StaticConfigProvider {
+func NewStaticConfigProvider(ctx context.Context, rp *RemoteProcessor, sources []string) *StaticConfigProvider {
 	return &StaticConfigProvider{
-		rp:  rp,
-		ctx: ctx,
+		rp:      rp,
+		ctx:     ctx,
+		sources: sources,
 	}
 }
 
 func (p *StaticConfigProvider) GetConfigs() ([]*asynq.PeriodicTaskConfig, error) {
 	indexAll, _ := tasks.NewTask(p.ctx, tasks.TypeIndexRemoteAll, &tasks.IndexRemoteAllPayload{})
+	collectAll, _ := tasks.NewTask(p.ctx, tasks.TypeCollectGarbageAll, &tasks.CollectGarbageAllPayload{})
+	verifyAll, _ := tasks.NewTask(p.ctx, tasks.TypeVerifyRemoteAll, &tasks.VerifyRemoteAllPayload{})
 	t := []*asynq.PeriodicTaskConfig{
 		{
 			// every hour
 			Cronspec: "*/5 * * * *",
 			Task:     indexAll,
 		},
+		{
+			// every day at 3am
+			Cronspec: "0 3 * * *",
+			Task:     collectAll,
+		},
+		{
+			// every day at 4am
+			Cronspec: "0 4 * * *",
+			Task:     verifyAll,
+		},
+	}
+	// advisories can only be imported
+	// if we know where they are
+	if len(p.sources) > 0 {
+		importAdvisories, _ := tasks.NewTask(p.ctx, tasks.TypeImportAdvisories, &tasks.ImportAdvisoriesPayload{Sources: p.sources})
+		t = append(t, &asynq.PeriodicTaskConfig{
+			// every day at 2am
+			Cronspec: "0 2 * * *",
+			Task:     importAdvisories,
+		})
 	}
 	return t, nil
 }
@@ -56,11 +56,12 @@ func (p *HelmProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
 	if err != nil {
 		return err
 	}
-	rem := remote.NewBackedRemote(ctx, r, p.store, quota.NewNetObserver(ctx, p.repos.BandwidthRepo), p.repos.ArtifactRepo.CreateArtifact, p.repos.PyPackageRepo.GetPackage, p.repos.HelmPackageRepo.GetPackage)
+	rem := remote.NewBackedRemote(ctx, r, p.store, quota.NewNetObserver(ctx, p.repos.BandwidthRepo), nil, nil, p.repos.ArtifactRepo.CreateArtifact, p.repos.ArtifactRepo.SetDigest, p.repos.PyPackageRepo.GetPackage, p.repos.HelmPackageRepo.GetPackage)
 	resp, err := rem.Download(ctx, "/index.yaml", &schemas.RequestContext{})
 	if err != nil {
 		return err
 	}
+	defer resp.Close()
 	data, err := io.ReadAll(resp)
 	if err != nil {
 		log.Error(err, "failed to read response")
@@ -90,6 +91,7 @@ func (p *HelmProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
 					AppVersion:  ee.AppVersion,
 					KubeVersion: ee.KubeVersion,
 					Type:        ee.Type,
+					Created:     ee.Created,
 					RemoteID:    r.ID,
 				})
 			}
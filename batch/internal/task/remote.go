@@ -5,17 +5,23 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/hibiken/asynq"
 	"gitlab.com/go-prism/prism3/batch/internal/task/helmidx"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/integrity"
+	"gitlab.com/go-prism/prism3/core/pkg/retention"
 	"gitlab.com/go-prism/prism3/core/pkg/tasks"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 )
 
-func NewRemoteProcessor(client *asynq.Client, repos *repo.Repos, helm *helmidx.HelmProcessor) *RemoteProcessor {
+func NewRemoteProcessor(client *asynq.Client, repos *repo.Repos, helm *helmidx.HelmProcessor, gc *retention.Collector, verify *integrity.Verifier, osv *advisory.Importer) *RemoteProcessor {
 	return &RemoteProcessor{
 		client: client,
 		repos:  repos,
 		helm:   helm,
+		gc:     gc,
+		verify: verify,
+		osv:    osv,
 	}
 }
 
@@ -59,6 +65,12 @@ func (p *RemoteProcessor) HandleIndexTask(ctx context.Context, t *asynq.Task) er
 	}
 	log = log.WithValues("Name", rem.Name, "Archetype", rem.Archetype)
 	log.V(1).Info("loaded remote")
+	// hosted remotes are indexed as
+	// files are uploaded
+	if rem.Hosted {
+		log.Info("skipping hosted remote")
+		return nil
+	}
 	var ts *asynq.Task
 	switch rem.Archetype {
 	case "HELM":
@@ -74,3 +86,117 @@ func (p *RemoteProcessor) HandleIndexTask(ctx context.Context, t *asynq.Task) er
 	_, _ = p.client.Enqueue(ts)
 	return nil
 }
+
+func (p *RemoteProcessor) HandleCollectAllTask(ctx context.Context, t *asynq.Task) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "task_remote_collectAll")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Type", t.Type())
+	log.Info("handling task")
+	var payload tasks.CollectGarbageAllPayload
+	err := tasks.Deserialise(ctx, t.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+	remotes, err := p.repos.RemoteRepo.ListRemotes(ctx, "", false)
+	if err != nil {
+		return err
+	}
+	for _, r := range remotes {
+		// only bother with remotes that
+		// have a retention policy
+		if r.Hosted || (r.RetentionMaxAge == 0 && r.RetentionMaxSize == 0 && r.RetentionKeepLatest == 0) {
+			continue
+		}
+		ts, err := tasks.NewTask(ctx, tasks.TypeCollectGarbage, &tasks.CollectGarbagePayload{RemoteID: r.ID})
+		if err != nil {
+			continue
+		}
+		_, _ = p.client.Enqueue(ts)
+	}
+	return nil
+}
+
+func (p *RemoteProcessor) HandleCollectTask(ctx context.Context, t *asynq.Task) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "task_remote_collect")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Type", t.Type())
+	log.Info("handling task")
+	var payload tasks.CollectGarbagePayload
+	err := tasks.Deserialise(ctx, t.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+	report, err := p.gc.Collect(ctx, payload.RemoteID, false)
+	if err != nil {
+		return err
+	}
+	log.Info("collected garbage", "RemoteID", payload.RemoteID, "Count", report.Count, "Bytes", report.Size)
+	return nil
+}
+
+func (p *RemoteProcessor) HandleVerifyAllTask(ctx context.Context, t *asynq.Task) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "task_remote_verifyAll")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Type", t.Type())
+	log.Info("handling task")
+	var payload tasks.VerifyRemoteAllPayload
+	err := tasks.Deserialise(ctx, t.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+	remotes, err := p.repos.RemoteRepo.ListRemotes(ctx, "", false)
+	if err != nil {
+		return err
+	}
+	for _, r := range remotes {
+		// files in hosted remotes aren't
+		// hashed when they are uploaded
+		if r.Hosted {
+			continue
+		}
+		ts, err := tasks.NewTask(ctx, tasks.TypeVerifyRemote, &tasks.VerifyRemotePayload{RemoteID: r.ID})
+		if err != nil {
+			continue
+		}
+		_, _ = p.client.Enqueue(ts)
+	}
+	return nil
+}
+
+func (p *RemoteProcessor) HandleVerifyTask(ctx context.Context, t *asynq.Task) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "task_remote_verify")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Type", t.Type())
+	log.Info("handling task")
+	var payload tasks.VerifyRemotePayload
+	err := tasks.Deserialise(ctx, t.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+	report, err := p.verify.Verify(ctx, payload.RemoteID)
+	if err != nil {
+		return err
+	}
+	log.Info("verified remote", "RemoteID", payload.RemoteID, "Checked", report.Checked, "Corrupt", report.Corrupt)
+	return nil
+}
+
+func (p *RemoteProcessor) HandleImportAdvisoriesTask(ctx context.Context, t *asynq.Task) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "task_advisories_import")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Type", t.Type())
+	log.Info("handling task")
+	var payload tasks.ImportAdvisoriesPayload
+	err := tasks.Deserialise(ctx, t.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+	for _, src := range payload.Sources {
+		report, err := p.osv.Import(ctx, src)
+		if err != nil {
+			return err
+		}
+		log.Info("imported advisories", "Source", src, "Imported", report.Imported, "Withdrawn", report.Withdrawn, "Skipped", report.Skipped)
+	}
+	return nil
+}
@@ -4,16 +4,25 @@ import (
 	"context"
 	"github.com/hibiken/asynq"
 	"gitlab.com/go-prism/prism3/batch/internal/task/helmidx"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/integrity"
+	"gitlab.com/go-prism/prism3/core/pkg/retention"
 )
 
 type StaticConfigProvider struct {
 	rp  *RemoteProcessor
 	ctx context.Context
+	// sources of OSV advisories that
+	// are imported every day
+	sources []string
 }
 
 type RemoteProcessor struct {
 	client *asynq.Client
 	repos  *repo.Repos
 	helm   *helmidx.HelmProcessor
+	gc     *retention.Collector
+	verify *integrity.Verifier
+	osv    *advisory.Importer
 }
@@ -35,18 +35,21 @@ import (
 	"gitlab.com/autokubeops/serverless"
 	"gitlab.com/av1o/cap10/pkg/client"
 	"gitlab.com/av1o/cap10/pkg/verify"
-	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
 	v1 "gitlab.com/go-prism/prism3/core/internal/api/v1"
+	"gitlab.com/go-prism/prism3/core/internal/audit"
 	"gitlab.com/go-prism/prism3/core/internal/graph"
 	"gitlab.com/go-prism/prism3/core/internal/graph/generated"
+	"gitlab.com/go-prism/prism3/core/internal/impl/apkapi"
 	"gitlab.com/go-prism/prism3/core/internal/permissions"
 	"gitlab.com/go-prism/prism3/core/internal/resolver"
+	"gitlab.com/go-prism/prism3/core/internal/tokens"
 	"gitlab.com/go-prism/prism3/core/pkg/db"
 	"gitlab.com/go-prism/prism3/core/pkg/db/notify"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
 	"gitlab.com/go-prism/prism3/core/pkg/errtack"
 	"gitlab.com/go-prism/prism3/core/pkg/flag"
 	"gitlab.com/go-prism/prism3/core/pkg/quota"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/storage"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
@@ -75,17 +78,21 @@ type environment struct {
 	PublicURL string `split_words:"true" required:"true"`
 
 	Auth struct {
-		SuperUser string `split_words:"true"`
+		SuperUser  string `split_words:"true"`
+		GroupClaim string `split_words:"true" default:"groups"`
 	}
 	DB struct {
 		DSN string `split_words:"true" required:"true"`
 	}
-	S3  storage.S3Options
-	Dev struct {
+	S3      storage.S3Options
+	Storage storage.Options
+	APK     apkapi.Options
+	Dev     struct {
 		Handlers bool `split_words:"true" default:"true"`
 	}
 	Flag   flag.Options
 	Sentry errtack.Options
+	Audit  audit.Options
 
 	Plugin struct {
 		GoURL   string `split_words:"true"`
@@ -163,7 +170,7 @@ func main() {
 	}
 	notifier.Listen()
 	repos := repo.NewRepos(database.DB())
-	s3, err := storage.NewS3(context.Background(), e.S3)
+	store, err := storage.New(context.Background(), e.Storage, e.S3)
 	if err != nil {
 		log.Error(err, "failed to connect to object storage")
 		os.Exit(1)
@@ -197,9 +204,9 @@ func main() {
 		os.Exit(1)
 		return
 	}
-	rbacClient := rbac.NewAuthorityClient(conn)
+	rbacClient := permissions.NewAuthority(conn)
 
-	perms, err := permissions.NewManager(ctx, rbacClient, e.Auth.SuperUser)
+	perms, err := permissions.NewManager(ctx, rbacClient, e.Auth.SuperUser, e.Auth.GroupClaim)
 	if err != nil {
 		log.Error(err, "failed to setup permissions manager")
 		os.Exit(1)
@@ -207,8 +214,17 @@ func main() {
 	}
 
 	// configure graphql
-	h := v1.NewGateway(resolver.NewResolver(ctx, repos, s3, e.PublicURL), goProxyURL, repos.ArtifactRepo, quota.NewNetObserver(ctx, repos.BandwidthRepo))
-	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(repos, s3, batchClient, notifier, perms)}))
+	locker, err := db.NewAdvisoryLocker(database)
+	if err != nil {
+		log.Error(err, "failed to setup advisory locks")
+		os.Exit(1)
+		return
+	}
+	health := remote.NewHealth()
+	res := resolver.NewResolver(ctx, repos, store, locker, health, e.PublicURL, e.APK, perms)
+	res.Listen(ctx, notifier)
+	h := v1.NewGateway(res, goProxyURL, repos.ArtifactRepo, quota.NewNetObserver(ctx, repos.BandwidthRepo))
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(repos, store, batchClient, notifier, health, perms)}))
 	srv.AddTransport(transport.POST{})
 	srv.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
@@ -220,33 +236,71 @@ func main() {
 		},
 	})
 	c := client.NewClient(verify.NewNoOpVerifier())
+	// access tokens can be used instead of cap10
+	// by clients that can't go through the proxy
+	auth := tokens.NewAuthenticator(c, repos.TokenRepo.GetTokenByHash, repos.TokenRepo.TouchToken)
+	exporter := audit.NewExporter(repos.AuditRepo.StreamEvents, perms, audit.NewRecorder(repos.AuditRepo.CreateEvent))
+	proxies, err := audit.ParseProxies(e.Audit.TrustedProxies)
+	if err != nil {
+		log.Error(err, "failed to read trusted proxies")
+		os.Exit(1)
+		return
+	}
 
 	// configure routing
 	router := mux.NewRouter()
 	router.Use(logging.Middleware(log), metrics.Middleware(), otelmux.Middleware(tracing.ServiceNameCore))
-	router.Use(sentryhttp.New(sentryhttp.Options{Repanic: true}).Handle)
+	router.Use(sentryhttp.New(sentryhttp.Options{Repanic: true}).Handle, audit.Middleware(proxies))
 	router.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		_, _ = w.Write([]byte("OK"))
 	})
 	router.HandleFunc("/metrics", prom.ServeHTTP)
 	router.Handle("/api/graphql", playground.Handler("GraphQL Playground", "/api/query"))
 	router.Handle("/api/query", c.WithOptionalUser(srv))
+	router.HandleFunc("/api/audit/export", c.WithUserFunc(exporter.ServeHTTP)).
+		Methods(http.MethodGet)
 	// generic
 	router.PathPrefix("/api/v1/{bucket}/").
-		HandlerFunc(h.ServeHTTPGeneric).
+		HandlerFunc(auth.WithOptionalUserFunc(h.ServeHTTPGeneric)).
 		Methods(http.MethodGet, http.MethodHead)
+	router.PathPrefix("/api/v1/{bucket}/").
+		HandlerFunc(auth.WithUserFunc(h.ServeHTTPGenericUpload)).
+		Methods(http.MethodPut)
 	// helm
 	router.PathPrefix("/api/helm/{bucket}/").
-		HandlerFunc(h.ServeHTTPHelm).
+		HandlerFunc(auth.WithOptionalUserFunc(h.ServeHTTPHelm)).
+		Methods(http.MethodGet)
+	router.HandleFunc("/api/helm/{bucket}/api/charts", auth.WithUserFunc(h.PushHTTPHelm)).
+		Methods(http.MethodPost)
+	// cargo
+	router.HandleFunc("/api/cargo/{bucket}/{path:.+}", auth.WithOptionalUserFunc(h.ServeHTTPCargo)).
 		Methods(http.MethodGet)
+	// debian
+	router.PathPrefix("/api/deb/{bucket}/").
+		HandlerFunc(auth.WithOptionalUserFunc(h.ServeHTTPDebian)).
+		Methods(http.MethodGet)
+	// oci
+	router.HandleFunc("/v2/", h.ServeOCIBase).
+		Methods(http.MethodGet, http.MethodHead)
+	router.HandleFunc("/v2/{bucket}/{path:.+}", auth.WithOptionalUserFunc(h.ServeHTTPOCI)).
+		Methods(http.MethodGet, http.MethodHead)
 	// npm
 	npmRouter := router.PathPrefix("/api/npm").Subrouter()
+	// the user is optional so that firewall
+	// rules can see who is making the request
+	npmRouter.Use(auth.WithOptionalUser)
 	h.RouteNPM(npmRouter)
+	npmRouter.HandleFunc("/{bucket}/{package}", auth.WithUserFunc(h.PublishHTTPNPM)).
+		Methods(http.MethodPut)
+	npmRouter.HandleFunc("/{bucket}/@{scope}/{package}", auth.WithUserFunc(h.PublishHTTPNPM)).
+		Methods(http.MethodPut)
 	// pypi
-	router.HandleFunc("/api/pypi/{bucket}/simple/{package}/", h.ServePyPi).
+	router.HandleFunc("/api/pypi/{bucket}/simple/{package}/", auth.WithOptionalUserFunc(h.ServePyPi)).
 		Methods(http.MethodGet)
+	router.HandleFunc("/api/pypi/{bucket}/", auth.WithUserFunc(h.UploadPyPi)).
+		Methods(http.MethodPost)
 	// go
-	router.PathPrefix("/api/go").HandlerFunc(h.ServeGo).
+	router.PathPrefix("/api/go").HandlerFunc(auth.WithOptionalUserFunc(h.ServeGo)).
 		Methods(http.MethodGet)
 
 	// start serving
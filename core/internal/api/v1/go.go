@@ -21,6 +21,7 @@ import (
 	"context"
 	"github.com/felixge/httpsnoop"
 	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/pkg/db"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
@@ -40,6 +41,12 @@ func (g *Gateway) ServeGo(w http.ResponseWriter, r *http.Request) {
 		http.NotFound(w, r)
 		return
 	}
+	// the Go refraction can be private
+	// like any other refraction
+	if err := g.resolver.Authorise(ctx, "go"); err != nil {
+		_ = problem.MustWrite(w, err)
+		return
+	}
 	metricCount.Add(ctx, 1, attribute.String("type", "go"), attribute.String("bucket", "go"))
 	name := strings.TrimPrefix(r.URL.Path, "/api/go")
 	r.URL.Path = name
@@ -29,6 +29,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"io"
 	"net/http"
+	"strings"
 )
 
 func (g *Gateway) ServeHelm(ctx context.Context, r *resolver.Request) (io.Reader, error) {
@@ -68,9 +69,53 @@ func (g *Gateway) ServeHTTPHelm(w http.ResponseWriter, r *http.Request) {
 		_ = problem.MustWrite(w, err)
 		return
 	}
+	defer closeReader(reader)
 
 	metricCountResolved.Add(ctx, 1, attributes...)
 
 	// copy the response back
-	_, _ = io.Copy(w, reader)
+	copyResponse(ctx, w, reader)
+}
+
+// PushHTTPHelm handles chart uploads using the ChartMuseum
+// API (i.e., "helm cm-push"). The chart can either be sent
+// as the "chart" field of a multipart form or as the body.
+func (g *Gateway) PushHTTPHelm(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(r.Context(), "gateway_helm_push")
+	defer span.End()
+	bucket := mux.Vars(r)["bucket"]
+	span.SetAttributes(
+		attribute.String("bucket", bucket),
+		attribute.String("type", "helm"),
+	)
+	log := logr.FromContextOrDiscard(ctx).WithValues("Bucket", bucket)
+	log.V(2).Info("pushing on helm gateway")
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.ParseMultipartForm(maxMemory); err != nil {
+			log.V(1).Error(err, "failed to parse multipart form")
+			_ = problem.MustWrite(w, uploadError(err, problem.New(http.StatusBadRequest).Errorf("malformed upload request")))
+			return
+		}
+		f, _, err := r.FormFile("chart")
+		if err != nil {
+			log.V(1).Error(err, "failed to retrieve chart from upload")
+			_ = problem.MustWrite(w, problem.New(http.StatusBadRequest).Errorf("upload does not contain a chart"))
+			return
+		}
+		defer f.Close()
+		body = f
+	}
+	req := g.pool.Get().(*resolver.Request)
+	req.New(bucket, "", r.Method)
+	defer g.pool.Put(req)
+
+	if err := g.resolver.PushHelm(ctx, req, body); err != nil {
+		_ = problem.MustWrite(w, uploadError(err, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte(`{"saved":true}`))
 }
@@ -33,4 +33,9 @@ var (
 		"prism.core.gateway.resolved.total",
 		instrument.WithUnit(unit.Dimensionless),
 	)
+	metricCountStale, _ = meter.SyncInt64().Counter(
+		"prism.core.gateway.stale.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total responses that were served from a stored copy because the remotes could not be used."),
+	)
 )
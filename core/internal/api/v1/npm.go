@@ -27,7 +27,6 @@ import (
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"io"
 	"net/http"
 	"strings"
 )
@@ -98,10 +97,46 @@ func (g *Gateway) ServeHTTPNPM(w http.ResponseWriter, r *http.Request) {
 		_ = problem.MustWrite(w, err)
 		return
 	}
+	defer closeReader(reader)
 
 	metricCountResolved.Add(ctx, 1, attributes...)
+	setWarning(ctx, w, reader, attributes...)
 
 	// copy the response back
 	w.Header().Set("Content-Type", "application/json")
-	_, _ = io.Copy(w, reader)
+	copyResponse(ctx, w, reader)
+}
+
+// PublishHTTPNPM handles "npm publish" by adding the
+// package to the hosted remote of the refraction.
+func (g *Gateway) PublishHTTPNPM(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(r.Context(), "gateway_npm_publish")
+	defer span.End()
+	vars := mux.Vars(r)
+	bucket, scope, pkg := vars["bucket"], vars["scope"], vars["package"]
+	// npm escapes the slash in scoped packages
+	// (e.g. "@scope%2fpackage"), however the router
+	// matches against the decoded path
+	if scope != "" {
+		pkg = fmt.Sprintf("@%s/%s", scope, pkg)
+	}
+	span.SetAttributes(
+		attribute.String("bucket", bucket),
+		attribute.String("package", pkg),
+		attribute.String("type", "npm"),
+	)
+	log := logr.FromContextOrDiscard(ctx).WithValues("Bucket", bucket, "Package", pkg)
+	log.V(2).Info("publishing on npm gateway")
+	req := g.npmPool.Get().(*resolver.NPMRequest)
+	req.New(bucket, pkg, "")
+	defer g.npmPool.Put(req)
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	if err := g.resolver.PublishNPM(ctx, req, r.Body); err != nil {
+		_ = problem.MustWrite(w, uploadError(err, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte(`{"ok":true}`))
 }
@@ -0,0 +1,96 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package v1
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/gorilla/mux"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/impl/ociapi"
+	"gitlab.com/go-prism/prism3/core/internal/resolver"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+const headerAPIVersion = "Docker-Distribution-API-Version"
+
+// ServeOCIBase responds to the version check that
+// clients make before talking to the registry.
+//
+// https://github.com/opencontainers/distribution-spec/blob/main/spec.md#determining-support
+func (*Gateway) ServeOCIBase(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set(headerAPIVersion, "registry/2.0")
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte("{}"))
+}
+
+func (g *Gateway) ServeHTTPOCI(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(r.Context(), "gateway_oci_serve")
+	defer span.End()
+	vars := mux.Vars(r)
+	bucket, path := vars["bucket"], vars["path"]
+	attributes := []attribute.KeyValue{
+		attribute.String("bucket", bucket),
+		attribute.String("url", r.URL.String()),
+		attribute.String("type", "oci"),
+	}
+	span.SetAttributes(attributes...)
+	log := logr.FromContextOrDiscard(ctx).WithValues("Bucket", bucket, "Path", path)
+	log.V(2).Info("serving on oci gateway")
+	req := g.pool.Get().(*resolver.Request)
+	req.New(bucket, path, r.Method)
+	defer g.pool.Put(req)
+
+	// collect metrics
+	metricCount.Add(ctx, 1, attributes...)
+
+	// serve
+	reader, err := g.resolver.ResolveOCI(ctx, req, GetRequestContext(ctx, r))
+	if err != nil {
+		_ = problem.MustWrite(w, err)
+		return
+	}
+	defer closeReader(reader)
+
+	metricCountResolved.Add(ctx, 1, attributes...)
+
+	w.Header().Set(headerAPIVersion, "registry/2.0")
+	// blobs can be streamed since the
+	// client already knows their digest
+	if digest, ok := remote.BlobDigest(path); ok {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Docker-Content-Digest", digest)
+		copyResponse(ctx, w, reader)
+		return
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		log.Error(err, "failed to read manifest")
+		_ = problem.MustWrite(w, problem.New(http.StatusInternalServerError))
+		return
+	}
+	w.Header().Set("Content-Type", ociapi.MediaType(data))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("Docker-Content-Digest", ociapi.Digest(data))
+	_, _ = w.Write(data)
+}
@@ -21,12 +21,12 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/gorilla/mux"
 	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/impl/pypiapi"
 	"gitlab.com/go-prism/prism3/core/internal/resolver"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"io"
 	"net/http"
 )
 
@@ -56,10 +56,61 @@ func (g *Gateway) ServePyPi(w http.ResponseWriter, r *http.Request) {
 		_ = problem.MustWrite(w, err)
 		return
 	}
+	defer closeReader(reader)
 
 	metricCountResolved.Add(ctx, 1, attributes...)
+	setWarning(ctx, w, reader, attributes...)
 
 	// copy the response back
 	w.Header().Set("Content-Type", "text/html")
-	_, _ = io.Copy(w, reader)
+	copyResponse(ctx, w, reader)
+}
+
+// UploadPyPi handles the legacy upload API used by twine.
+//
+// https://warehouse.pypa.io/api-reference/legacy.html#upload-api
+func (g *Gateway) UploadPyPi(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(r.Context(), "gateway_pypi_upload")
+	defer span.End()
+	bucket := mux.Vars(r)["bucket"]
+	span.SetAttributes(
+		attribute.String("bucket", bucket),
+		attribute.String("type", "pypi"),
+	)
+	log := logr.FromContextOrDiscard(ctx).WithValues("Bucket", bucket)
+	log.V(2).Info("uploading on pypi gateway")
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	if err := r.ParseMultipartForm(maxMemory); err != nil {
+		log.V(1).Error(err, "failed to parse multipart form")
+		_ = problem.MustWrite(w, uploadError(err, problem.New(http.StatusBadRequest).Errorf("malformed upload request")))
+		return
+	}
+	if action := r.FormValue(":action"); action != "file_upload" {
+		log.V(1).Info("rejecting unsupported action", "Action", action)
+		_ = problem.MustWrite(w, problem.New(http.StatusBadRequest).Errorf("unsupported action: %s", action))
+		return
+	}
+	f, hdr, err := r.FormFile("content")
+	if err != nil {
+		log.V(1).Error(err, "failed to retrieve file from upload")
+		_ = problem.MustWrite(w, problem.New(http.StatusBadRequest).Errorf("upload does not contain a file"))
+		return
+	}
+	defer f.Close()
+	req := g.pool.Get().(*resolver.Request)
+	req.New(bucket, "", r.Method)
+	defer g.pool.Put(req)
+
+	if err := g.resolver.UploadPyPi(ctx, req, &pypiapi.Upload{
+		Name:           r.FormValue("name"),
+		Version:        r.FormValue("version"),
+		Filename:       hdr.Filename,
+		SHA256:         r.FormValue("sha256_digest"),
+		RequiresPython: r.FormValue("requires_python"),
+		Content:        f,
+	}); err != nil {
+		_ = problem.MustWrite(w, uploadError(err, err))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
 }
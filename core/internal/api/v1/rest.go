@@ -18,16 +18,22 @@
 package v1
 
 import (
+	"context"
 	"github.com/go-logr/logr"
 	"github.com/gorilla/mux"
 	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
 	"gitlab.com/go-prism/prism3/core/internal/resolver"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
+	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -39,6 +45,26 @@ func (*Gateway) getPath(uri *url.URL) (string, bool) {
 	return paths[1], true
 }
 
+// closeReader releases whatever is behind a response
+// (e.g., the connection to the upstream or a file that
+// is being uploaded to the cache).
+func closeReader(r io.Reader) {
+	if c, ok := r.(io.Closer); ok {
+		_ = c.Close()
+	}
+}
+
+// setWarning tells the client that the response was
+// served from a stored copy rather than the remotes.
+func setWarning(ctx context.Context, w http.ResponseWriter, r io.Reader, attributes ...attribute.KeyValue) {
+	s, ok := r.(*refract.Stale)
+	if !ok {
+		return
+	}
+	metricCountStale.Add(ctx, 1, attributes...)
+	w.Header().Set("Warning", s.Warning)
+}
+
 func (g *Gateway) ServeHTTPGeneric(w http.ResponseWriter, r *http.Request) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(r.Context(), "gateway_generic_serve")
 	defer span.End()
@@ -72,9 +98,83 @@ func (g *Gateway) ServeHTTPGeneric(w http.ResponseWriter, r *http.Request) {
 		_ = problem.MustWrite(w, err)
 		return
 	}
+	defer closeReader(reader)
 
 	metricCountResolved.Add(ctx, 1, attributes...)
 
+	// files from storage know their metadata, so
+	// we can support ranges and conditional requests
+	if obj, ok := reader.(*storage.Object); ok {
+		serveObject(w, r, path, obj)
+		return
+	}
+
+	// generated documents know their size, which
+	// lets HEAD requests report it
+	if l, ok := reader.(interface{ Len() int }); ok {
+		w.Header().Set("Content-Length", strconv.Itoa(l.Len()))
+	}
+
 	// copy the response back
-	_, _ = io.Copy(w, reader)
+	copyResponse(ctx, w, reader)
+}
+
+// copyResponse streams the file to the client. If the file
+// can't be read to the end (e.g., it doesn't match the
+// digest that upstream declared), the connection is
+// aborted so that the client sees a failed download
+// rather than a truncated or tampered file.
+func copyResponse(ctx context.Context, w http.ResponseWriter, r io.Reader) {
+	if _, err := io.Copy(w, r); err != nil {
+		logr.FromContextOrDiscard(ctx).Info("aborting response as the file could not be sent", "Error", err.Error())
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// serveObject writes a file from storage, handling
+// Range, If-None-Match and If-Modified-Since headers.
+func serveObject(w http.ResponseWriter, r *http.Request, path string, obj *storage.Object) {
+	info := obj.Info()
+	if info.ETag != "" {
+		w.Header().Set("ETag", info.ETag)
+	}
+	// set the content type ourselves, otherwise
+	// http.ServeContent reads the start of the
+	// file to guess it
+	ctype := mime.TypeByExtension(filepath.Ext(path))
+	if ctype == "" {
+		ctype = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", ctype)
+	http.ServeContent(w, r, path, info.ModTime, obj)
+}
+
+// ServeHTTPGenericUpload saves the request body
+// to the hosted remote of the refraction.
+func (g *Gateway) ServeHTTPGenericUpload(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(r.Context(), "gateway_generic_upload")
+	defer span.End()
+	bucket := mux.Vars(r)["bucket"]
+	span.SetAttributes(
+		attribute.String("bucket", bucket),
+		attribute.String("url", r.URL.String()),
+		attribute.String("type", "generic"),
+	)
+	log := logr.FromContextOrDiscard(ctx).WithValues("Bucket", bucket)
+	log.V(2).Info("uploading on generic gateway")
+	path, ok := g.getPath(r.URL)
+	if !ok || path == "" || strings.HasSuffix(path, "/") {
+		log.V(1).Info("failed to parse path", "Url", r.URL)
+		_ = problem.MustWrite(w, problem.New(http.StatusBadRequest).Errorf("malformed path"))
+		return
+	}
+	req := g.pool.Get().(*resolver.Request)
+	req.New(bucket, path, r.Method)
+	defer g.pool.Put(req)
+
+	if err := g.resolver.Upload(ctx, req, r.Body); err != nil {
+		_ = problem.MustWrite(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
 }
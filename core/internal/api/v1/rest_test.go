@@ -3,20 +3,39 @@ package v1
 import (
 	"context"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/impl/pypiapi"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
 	"gitlab.com/go-prism/prism3/core/internal/resolver"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 type testResolver struct{}
 
+func (*testResolver) Authorise(context.Context, string) error {
+	return nil
+}
+
 func (t *testResolver) Resolve(context.Context, *resolver.Request, *schemas.RequestContext) (io.Reader, error) {
 	return strings.NewReader("Resolve"), nil
 }
 
+func (*testResolver) ResolveCargo(context.Context, *resolver.Request, *schemas.RequestContext) (io.Reader, error) {
+	return strings.NewReader("ResolveCargo"), nil
+}
+
+func (*testResolver) ResolveDebian(context.Context, *resolver.Request, *schemas.RequestContext) (io.Reader, error) {
+	return strings.NewReader("ResolveDebian"), nil
+}
+
 func (t *testResolver) ResolveHelm(context.Context, *resolver.Request, *schemas.RequestContext) (io.Reader, error) {
 	return strings.NewReader("ResolveHelm"), nil
 }
@@ -25,10 +44,70 @@ func (t *testResolver) ResolveNPM(context.Context, *resolver.NPMRequest, *schema
 	return strings.NewReader("ResolveNPM"), nil
 }
 
+func (*testResolver) ResolveOCI(context.Context, *resolver.Request, *schemas.RequestContext) (io.Reader, error) {
+	return strings.NewReader("ResolveOCI"), nil
+}
+
 func (*testResolver) ResolvePyPi(context.Context, *resolver.Request, *schemas.RequestContext) (io.Reader, error) {
 	return strings.NewReader("ResolvePyPi"), nil
 }
 
+func (*testResolver) Upload(context.Context, *resolver.Request, io.Reader) error {
+	return nil
+}
+
+func (*testResolver) PublishNPM(context.Context, *resolver.NPMRequest, io.Reader) error {
+	return nil
+}
+
+func (*testResolver) PushHelm(context.Context, *resolver.Request, io.Reader) error {
+	return nil
+}
+
+func (*testResolver) UploadPyPi(context.Context, *resolver.Request, *pypiapi.Upload) error {
+	return nil
+}
+
+// staleResolver serves metadata that
+// couldn't be revalidated.
+type staleResolver struct {
+	testResolver
+}
+
+func (*staleResolver) ResolveNPM(context.Context, *resolver.NPMRequest, *schemas.RequestContext) (io.Reader, error) {
+	return &refract.Stale{Reader: strings.NewReader("ResolveNPM"), Warning: refract.WarningRevalidationFailed}, nil
+}
+
+func (*staleResolver) ResolvePyPi(context.Context, *resolver.Request, *schemas.RequestContext) (io.Reader, error) {
+	return &refract.Stale{Reader: strings.NewReader("ResolvePyPi"), Warning: refract.WarningDisconnected}, nil
+}
+
+// mismatchResolver serves a file that doesn't
+// match the digest that upstream declared.
+type mismatchResolver struct {
+	testResolver
+}
+
+func (*mismatchResolver) Resolve(context.Context, *resolver.Request, *schemas.RequestContext) (io.Reader, error) {
+	return io.MultiReader(strings.NewReader(strings.Repeat("prism", 100_000)), iotest.ErrReader(remote.ErrDigestMismatch)), nil
+}
+
+func TestGateway_DigestMismatch(t *testing.T) {
+	g := NewGateway(&mismatchResolver{}, nil, nil, nil)
+	ts := httptest.NewServer(http.HandlerFunc(g.ServeHTTPGeneric))
+	defer ts.Close()
+
+	// the client must not be able to
+	// download the file successfully
+	resp, err := ts.Client().Get(ts.URL + "/api/v1/alpine/-/v3.14/main/x86_64/APKINDEX.tar.gz")
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	_, err = io.ReadAll(resp.Body)
+	assert.Error(t, err)
+}
+
 func TestGateway_ServeHTTP(t *testing.T) {
 	var cases = []struct {
 		target string
@@ -45,3 +124,192 @@ func TestGateway_ServeHTTP(t *testing.T) {
 		})
 	}
 }
+
+func TestGateway_ServeHTTPGenericLength(t *testing.T) {
+	g := NewGateway(&testResolver{}, nil, nil, nil)
+
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		t.Run(method, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			g.ServeHTTPGeneric(w, httptest.NewRequest(method, "https://prism.devel/api/v1/maven/-/com/example/widget/maven-metadata.xml", nil))
+			assert.EqualValues(t, http.StatusOK, w.Code)
+			assert.EqualValues(t, "7", w.Header().Get("Content-Length"))
+		})
+	}
+}
+
+func TestGateway_ServeHTTPGenericUpload(t *testing.T) {
+	var cases = []struct {
+		target string
+		code   int
+	}{
+		{
+			"https://prism.devel/api/v1/generic/-/foo/bar.txt",
+			http.StatusCreated,
+		},
+		{
+			"https://prism.devel/api/v1/generic/-/foo/",
+			http.StatusBadRequest,
+		},
+		{
+			"https://prism.devel/api/v1/generic/foo/bar.txt",
+			http.StatusBadRequest,
+		},
+	}
+	g := NewGateway(&testResolver{}, nil, nil, nil)
+
+	for _, tt := range cases {
+		t.Run(tt.target, func(t *testing.T) {
+			assert.HTTPStatusCode(t, g.ServeHTTPGenericUpload, http.MethodPut, tt.target, nil, tt.code)
+		})
+	}
+}
+
+func TestServeObject(t *testing.T) {
+	store := storage.NewNoOp()
+	store.Data["foo/bar.txt"] = []byte("hello world")
+	info, err := store.Stat(context.TODO(), "foo/bar.txt")
+	require.NoError(t, err)
+
+	var cases = []struct {
+		name    string
+		headers map[string]string
+		code    int
+		body    string
+	}{
+		{
+			"full content",
+			nil,
+			http.StatusOK,
+			"hello world",
+		},
+		{
+			"partial content",
+			map[string]string{"Range": "bytes=6-"},
+			http.StatusPartialContent,
+			"world",
+		},
+		{
+			"unsatisfiable range",
+			map[string]string{"Range": "bytes=20-"},
+			http.StatusRequestedRangeNotSatisfiable,
+			"",
+		},
+		{
+			"matching etag",
+			map[string]string{"If-None-Match": info.ETag},
+			http.StatusNotModified,
+			"",
+		},
+		{
+			"stale etag",
+			map[string]string{"If-None-Match": `"foo"`},
+			http.StatusOK,
+			"hello world",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, err := storage.Open(context.TODO(), store, "foo/bar.txt")
+			require.NoError(t, err)
+			defer obj.Close()
+
+			req := httptest.NewRequest(http.MethodGet, "https://prism.devel/api/v1/generic/-/foo/bar.txt", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			serveObject(w, req, "foo/bar.txt", obj)
+
+			assert.EqualValues(t, tt.code, w.Code)
+			assert.EqualValues(t, info.ETag, w.Header().Get("ETag"))
+			if tt.body != "" {
+				assert.EqualValues(t, tt.body, w.Body.String())
+				assert.EqualValues(t, "bytes", w.Header().Get("Accept-Ranges"))
+			}
+		})
+	}
+}
+
+func TestGateway_StaleWarning(t *testing.T) {
+	var cases = []struct {
+		name     string
+		resolver resolver.IResolver
+		handler  func(g *Gateway) http.HandlerFunc
+		warning  string
+	}{
+		{
+			"npm fresh",
+			&testResolver{},
+			func(g *Gateway) http.HandlerFunc { return g.ServeHTTPNPM },
+			"",
+		},
+		{
+			"npm stale",
+			&staleResolver{},
+			func(g *Gateway) http.HandlerFunc { return g.ServeHTTPNPM },
+			refract.WarningRevalidationFailed,
+		},
+		{
+			"pypi fresh",
+			&testResolver{},
+			func(g *Gateway) http.HandlerFunc { return g.ServePyPi },
+			"",
+		},
+		{
+			"pypi offline",
+			&staleResolver{},
+			func(g *Gateway) http.HandlerFunc { return g.ServePyPi },
+			refract.WarningDisconnected,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGateway(tt.resolver, nil, nil, nil)
+			w := httptest.NewRecorder()
+			tt.handler(g)(w, httptest.NewRequest(http.MethodGet, "https://prism.devel/api/v1/foo/-/bar", nil))
+			assert.EqualValues(t, http.StatusOK, w.Code)
+			assert.EqualValues(t, tt.warning, w.Header().Get("Warning"))
+		})
+	}
+}
+
+// discardResolver reads the entire upload.
+type discardResolver struct {
+	testResolver
+}
+
+func (*discardResolver) PushHelm(_ context.Context, _ *resolver.Request, body io.Reader) error {
+	_, err := io.Copy(io.Discard, body)
+	return err
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+func TestGateway_PushHTTPHelm(t *testing.T) {
+	g := NewGateway(&discardResolver{}, nil, nil, nil)
+
+	var cases = []struct {
+		name string
+		size int64
+		code int
+	}{
+		{"within limit", 1024, http.StatusCreated},
+		{"too large", maxUploadSize + 1, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "https://prism.devel/api/v1/helm/-/api/charts", io.LimitReader(zeroReader{}, tt.size))
+			g.PushHTTPHelm(w, r)
+			assert.EqualValues(t, tt.code, w.Code)
+		})
+	}
+}
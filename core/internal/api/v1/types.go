@@ -8,6 +8,15 @@ import (
 	"sync"
 )
 
+// maxMemory is how much of a multipart upload is kept
+// in memory before the rest is written to disk.
+const maxMemory = 32 << 20
+
+// maxUploadSize is the largest request body that
+// npm, PyPi and Helm uploads can have, since they
+// are read into memory.
+const maxUploadSize = 512 << 20
+
 type Gateway struct {
 	resolver resolver.IResolver
 	pool     *sync.Pool
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"errors"
+	"github.com/lpar/problem"
+	"net/http"
+)
+
+// uploadError returns a 413 if err was caused by the
+// request body being larger than maxUploadSize.
+// Otherwise, fallback is returned.
+func uploadError(err, fallback error) error {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return problem.New(http.StatusRequestEntityTooLarge).Errorf("upload must not be larger than %d bytes", maxErr.Limit)
+	}
+	return fallback
+}
@@ -0,0 +1,206 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	"gitlab.com/av1o/cap10/pkg/client"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/permissions"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// anonymous is recorded as the actor
+// when there is no user in the context.
+const anonymous = "anonymous"
+
+func NewRecorder(record repo.RecordAuditFunc) *Recorder {
+	return &Recorder{
+		record: record,
+	}
+}
+
+// Record appends an action taken by the current user to the
+// audit log. before and after are the state of the resource
+// either side of the action and may be nil.
+//
+// Failing to record an event is logged rather than returned,
+// since the action has already taken place by this point.
+func (r *Recorder) Record(ctx context.Context, action, resource string, before, after any) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "audit_record", trace.WithAttributes(
+		attribute.String("action", action),
+		attribute.String("resource", resource),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Action", action, "Resource", resource)
+	log.V(1).Info("recording audit event")
+	diff, err := Diff(before, after)
+	if err != nil {
+		metricRecord.Add(ctx, 1, attribute.Bool("success", false))
+		log.Error(err, "failed to compute audit diff")
+		return
+	}
+	event := &model.AuditEvent{
+		CreatedAt: time.Now().Unix(),
+		Actor:     Actor(ctx),
+		Action:    action,
+		Resource:  resource,
+		Diff:      diff,
+		SourceIP:  SourceIP(ctx),
+	}
+	if err := r.record(ctx, event); err != nil {
+		metricRecord.Add(ctx, 1, attribute.Bool("success", false))
+		log.Error(err, "failed to record audit event")
+		return
+	}
+	metricRecord.Add(ctx, 1, attribute.Bool("success", true))
+}
+
+// Actor returns the name of the current user.
+func Actor(ctx context.Context) string {
+	user, ok := client.GetContextUser(ctx)
+	if !ok {
+		return anonymous
+	}
+	return permissions.NormalUser(user.AsUsername())
+}
+
+// CanView checks whether the current
+// user can read the audit log.
+func CanView(ctx context.Context, authz Authoriser) error {
+	if err := authz.AmI(ctx, model.RoleAudit); err == nil {
+		return nil
+	}
+	return authz.AmI(ctx, model.RoleSuper)
+}
+
+// ParseProxies reads the addresses of trusted proxies,
+// which can either be an IP address or a CIDR range.
+func ParseProxies(proxies []string) ([]netip.Prefix, error) {
+	out := make([]netip.Prefix, 0, len(proxies))
+	for _, p := range proxies {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.Contains(p, "/") {
+			addr, err := netip.ParseAddr(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+			}
+			addr = addr.Unmap()
+			out = append(out, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		out = append(out, prefix.Masked())
+	}
+	return out, nil
+}
+
+// Middleware stores the address of the
+// client in the request context.
+func Middleware(proxies []netip.Prefix) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), sourceIPContextKey, sourceIP(r, proxies))))
+		})
+	}
+}
+
+// SourceIP returns the address of the client, as
+// stored in the context by Middleware.
+func SourceIP(ctx context.Context) string {
+	ip, _ := ctx.Value(sourceIPContextKey).(string)
+	return ip
+}
+
+// sourceIP retrieves the address of the client.
+//
+// The headers set by a reverse proxy are only used if the
+// request came from a trusted proxy, since anyone else
+// can set them to whatever they like. Each proxy appends
+// the address that it received the request from to
+// X-Forwarded-For, so the client is the right-most
+// address that wasn't added by a trusted proxy.
+func sourceIP(r *http.Request, proxies []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !trusted(host, proxies) {
+		return host
+	}
+	var hops []string
+	for _, v := range r.Header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(v, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		if !trusted(hops[i], proxies) {
+			return hops[i]
+		}
+	}
+	// every hop was a trusted proxy
+	if len(hops) > 0 {
+		return hops[0]
+	}
+	if v := strings.TrimSpace(r.Header.Get("X-Real-Ip")); v != "" {
+		return v
+	}
+	return host
+}
+
+// trusted returns true if an address
+// belongs to a trusted proxy.
+func trusted(s string, proxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range proxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource formats the name of a
+// resource in the same way as RBAC.
+func Resource(kind, id string) string {
+	return fmt.Sprintf("%s::%s", kind, id)
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	proxies, err := ParseProxies([]string{"192.0.2.1", "10.0.0.0/8"})
+	require.NoError(t, err)
+	var cases = []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		out        string
+	}{
+		{"remote address", "198.51.100.3:1234", nil, "198.51.100.3"},
+		{"untrusted forwarded", "198.51.100.3:1234", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.3"},
+		{"untrusted real ip", "198.51.100.3:1234", map[string]string{"X-Real-Ip": "198.51.100.2"}, "198.51.100.3"},
+		{"forwarded", "192.0.2.1:1234", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+		{"forwarded through proxies", "192.0.2.1:1234", map[string]string{"X-Forwarded-For": "198.51.100.1, 10.0.0.1"}, "198.51.100.1"},
+		{"spoofed forwarded", "192.0.2.1:1234", map[string]string{"X-Forwarded-For": "203.0.113.1, 198.51.100.1, 10.0.0.1"}, "198.51.100.1"},
+		{"only proxies", "192.0.2.1:1234", map[string]string{"X-Forwarded-For": "10.0.0.2, 10.0.0.1"}, "10.0.0.2"},
+		{"real ip", "192.0.2.1:1234", map[string]string{"X-Real-Ip": "198.51.100.2"}, "198.51.100.2"},
+		{"no proxy headers", "192.0.2.1:1234", nil, "192.0.2.1"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			var ip string
+			Middleware(proxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ip = SourceIP(r.Context())
+			})).ServeHTTP(httptest.NewRecorder(), req)
+			assert.EqualValues(t, tt.out, ip)
+		})
+	}
+}
+
+func TestMiddleware_NoProxies(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	req.Header.Set("X-Real-Ip", "198.51.100.2")
+	var ip string
+	Middleware(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip = SourceIP(r.Context())
+	})).ServeHTTP(httptest.NewRecorder(), req)
+	assert.EqualValues(t, "192.0.2.1", ip)
+}
+
+func TestParseProxies(t *testing.T) {
+	proxies, err := ParseProxies([]string{"192.0.2.1", " 10.0.0.1/8 ", "", "2001:db8::/32"})
+	require.NoError(t, err)
+	assert.EqualValues(t, []string{"192.0.2.1/32", "10.0.0.0/8", "2001:db8::/32"}, []string{proxies[0].String(), proxies[1].String(), proxies[2].String()})
+
+	_, err = ParseProxies([]string{"not-an-ip"})
+	assert.Error(t, err)
+	_, err = ParseProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,153 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// secrets are the (lowercase) names of fields
+// whose values must never be written to the log.
+var secrets = []string{
+	"key",
+	"token",
+	"password",
+	"secret",
+	"hash",
+}
+
+// Diff returns the fields that differ between two
+// versions of a resource as JSON, in the form
+// {"field": {"before": ..., "after": ...}}.
+//
+// Either version may be nil, for example when a
+// resource is created or deleted. Secrets are
+// redacted, but still show up as changed.
+func Diff(before, after any) (string, error) {
+	b, err := toMap(before)
+	if err != nil {
+		return "", err
+	}
+	a, err := toMap(after)
+	if err != nil {
+		return "", err
+	}
+	changes := map[string]any{}
+	for k, bv := range b {
+		if av, ok := a[k]; !ok || !reflect.DeepEqual(bv, av) {
+			changes[k] = change(k, bv, av)
+		}
+	}
+	for k, av := range a {
+		if _, ok := b[k]; !ok {
+			changes[k] = change(k, nil, av)
+		}
+	}
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func change(k string, before, after any) map[string]any {
+	return map[string]any{
+		"before": redact(k, before),
+		"after":  redact(k, after),
+	}
+}
+
+// toMap converts a value into its JSON representation
+// so that it can be compared field by field.
+func toMap(v any) (map[string]any, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Pointer && reflect.ValueOf(v).IsNil()) {
+		return map[string]any{}, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		// not an object (e.g., a bool), so
+		// record it as a single value
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		return map[string]any{"value": value}, nil
+	}
+	return out, nil
+}
+
+// redact replaces secrets in a value, including
+// any that are nested inside of it. Credentials in
+// URLs (e.g., a proxy) are redacted wherever
+// they appear.
+func redact(k string, v any) any {
+	if isSecret(k) {
+		if v == nil || v == "" {
+			return v
+		}
+		return Redacted
+	}
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for nk, nv := range val {
+			out[nk] = redact(nk, nv)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i := range val {
+			out[i] = redact("", val[i])
+		}
+		return out
+	case string:
+		return redactURL(val)
+	}
+	return v
+}
+
+// redactURL removes the userinfo
+// from a URL, if it has any.
+func redactURL(s string) string {
+	if !strings.Contains(s, "@") {
+		return s
+	}
+	uri, err := url.Parse(s)
+	if err != nil || uri.User == nil {
+		return s
+	}
+	uri.User = nil
+	return strings.Replace(uri.String(), "://", "://"+Redacted+"@", 1)
+}
+
+func isSecret(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range secrets {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
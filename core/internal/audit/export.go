@@ -0,0 +1,107 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+func NewExporter(stream StreamFunc, authz Authoriser, audit *Recorder) *Exporter {
+	return &Exporter{
+		stream: stream,
+		authz:  authz,
+		audit:  audit,
+	}
+}
+
+// ServeHTTP writes every audit event that matches the
+// filter in the query string as JSON Lines, oldest first.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(r.Context(), "audit_export")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	if err := CanView(ctx, e.authz); err != nil {
+		log.Info("rejecting audit export", "Error", err.Error())
+		_ = problem.MustWrite(w, problem.New(http.StatusForbidden).Errorf("you do not have permission to export the audit log"))
+		return
+	}
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		_ = problem.MustWrite(w, err)
+		return
+	}
+	e.audit.Record(ctx, "exportAuditEvents", "audit::", nil, filter)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", "attachment; filename=audit.jsonl")
+	w.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(w)
+	if err := e.stream(ctx, filter, func(event *model.AuditEvent) error {
+		return enc.Encode(line{
+			ID:        event.ID,
+			CreatedAt: event.CreatedAt,
+			Actor:     event.Actor,
+			Action:    event.Action,
+			Resource:  event.Resource,
+			Diff:      json.RawMessage(event.Diff),
+			SourceIP:  event.SourceIP,
+		})
+	}); err != nil {
+		// we've already started writing the response,
+		// so there's no way to tell the client
+		log.Error(err, "failed to export audit events")
+		return
+	}
+}
+
+// parseFilter reads an audit filter from the query string.
+func parseFilter(q url.Values) (*model.AuditFilter, error) {
+	filter := &model.AuditFilter{}
+	for k, v := range map[string]**string{
+		"actor":    &filter.Actor,
+		"action":   &filter.Action,
+		"resource": &filter.Resource,
+	} {
+		if s := q.Get(k); s != "" {
+			*v = &s
+		}
+	}
+	for k, v := range map[string]**int64{
+		"since": &filter.Since,
+		"until": &filter.Until,
+	} {
+		s := q.Get(k)
+		if s == "" {
+			continue
+		}
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, problem.New(http.StatusBadRequest).Errorf("%s must be a unix timestamp", k)
+		}
+		*v = &i
+	}
+	return filter, nil
+}
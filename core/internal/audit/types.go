@@ -0,0 +1,75 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+)
+
+type Options struct {
+	// TrustedProxies are the addresses (or CIDR ranges)
+	// of the reverse proxies in front of Prism. The
+	// X-Forwarded-For and X-Real-Ip headers are only
+	// read from requests that come through them.
+	TrustedProxies []string `split_words:"true"`
+}
+
+// Redacted replaces the value of
+// secrets in the audit log.
+const Redacted = "<redacted>"
+
+// Authoriser checks whether the
+// user holds a global role.
+type Authoriser interface {
+	AmI(ctx context.Context, role model.Role) error
+}
+
+// Recorder appends the changes that
+// users make to the audit log.
+type Recorder struct {
+	record repo.RecordAuditFunc
+}
+
+// StreamFunc calls f for each audit event that matches the filter.
+type StreamFunc = func(ctx context.Context, filter *model.AuditFilter, f func(event *model.AuditEvent) error) error
+
+// Exporter writes the audit log as JSON Lines.
+type Exporter struct {
+	stream StreamFunc
+	authz  Authoriser
+	audit  *Recorder
+}
+
+// line is a single entry in an export. The diff is
+// embedded as JSON rather than an escaped string.
+type line struct {
+	ID        string          `json:"id"`
+	CreatedAt int64           `json:"createdAt"`
+	Actor     string          `json:"actor"`
+	Action    string          `json:"action"`
+	Resource  string          `json:"resource"`
+	Diff      json.RawMessage `json:"diff"`
+	SourceIP  string          `json:"sourceIP"`
+}
+
+type contextKey int
+
+const sourceIPContextKey contextKey = iota
@@ -10,14 +10,65 @@ import (
 	"gitlab.com/go-prism/prism3/core/pkg/db/datatypes"
 )
 
+type AccessToken struct {
+	ID               string              `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
+	CreatedAt        int64               `json:"createdAt"`
+	Name             string              `json:"name"`
+	Iss              string              `json:"iss" gorm:"index:idx_access_token_owner"`
+	Sub              string              `json:"sub" gorm:"index:idx_access_token_owner"`
+	ServiceAccountID string              `json:"serviceAccountID" gorm:"index"`
+	Scopes           datatypes.JSONArray `json:"scopes" gorm:"not null;type:jsonb;default:'[]'::jsonb"`
+	ExpiresAt        int64               `json:"expiresAt" gorm:"not null"`
+	LastUsedAt       int64               `json:"lastUsedAt" gorm:"not null;default:0"`
+}
+
+type Advisory struct {
+	ID        string              `json:"id" gorm:"primaryKey;not null"`
+	Summary   string              `json:"summary"`
+	Aliases   datatypes.JSONArray `json:"aliases" gorm:"default:'[]'::jsonb"`
+	Severity  string              `json:"severity"`
+	Published int64               `json:"published"`
+	Modified  int64               `json:"modified"`
+}
+
+type AffectedArtifacts struct {
+	Advisory  *Advisory   `json:"advisory"`
+	Artifacts []*Artifact `json:"artifacts"`
+}
+
 type Artifact struct {
-	ID        string              `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
-	CreatedAt int64               `json:"createdAt"`
-	UpdatedAt int64               `json:"updatedAt"`
-	URI       string              `json:"uri" gorm:"index"`
-	Downloads int64               `json:"downloads"`
-	RemoteID  string              `json:"remoteID" gorm:"index"`
-	Slices    datatypes.JSONArray `json:"slices"`
+	ID         string              `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
+	CreatedAt  int64               `json:"createdAt"`
+	UpdatedAt  int64               `json:"updatedAt"`
+	URI        string              `json:"uri" gorm:"index"`
+	Downloads  int64               `json:"downloads"`
+	RemoteID   string              `json:"remoteID" gorm:"index"`
+	Slices     datatypes.JSONArray `json:"slices"`
+	Sha256     string              `json:"sha256" gorm:"not null;default:''"`
+	VerifiedAt int64               `json:"verifiedAt" gorm:"not null;default:0"`
+}
+
+type AuditEvent struct {
+	ID        string `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
+	CreatedAt int64  `json:"createdAt" gorm:"index"`
+	Actor     string `json:"actor" gorm:"index"`
+	Action    string `json:"action" gorm:"index"`
+	Resource  string `json:"resource" gorm:"index"`
+	Diff      string `json:"diff" gorm:"not null;type:jsonb;default:'{}'::jsonb"`
+	SourceIP  string `json:"sourceIP"`
+}
+
+type AuditEventPage struct {
+	Items []*AuditEvent `json:"items"`
+	Total int64         `json:"total"`
+}
+
+type AuditFilter struct {
+	Actor    *string `json:"actor"`
+	Action   *string `json:"action"`
+	Resource *string `json:"resource"`
+	Since    *int64  `json:"since"`
+	Until    *int64  `json:"until"`
 }
 
 type BandwidthUsage struct {
@@ -29,10 +80,40 @@ type BandwidthUsage struct {
 	Type     BandwidthType `json:"type"`
 }
 
+type CreatedAccessToken struct {
+	Token       string       `json:"token"`
+	AccessToken *AccessToken `json:"accessToken"`
+}
+
+type HealthEvent struct {
+	Time   int64        `json:"time"`
+	State  BreakerState `json:"state"`
+	Reason string       `json:"reason"`
+}
+
+type NewAccessToken struct {
+	Name             string   `json:"name"`
+	ServiceAccountID *string  `json:"serviceAccountID"`
+	Scopes           []string `json:"scopes"`
+	ExpiresAt        int64    `json:"expiresAt"`
+}
+
+type NewQuarantineExemption struct {
+	RefractionID string `json:"refractionID"`
+	Package      string `json:"package"`
+	Version      string `json:"version"`
+}
+
 type NewRefract struct {
-	Name      string    `json:"name"`
-	Archetype Archetype `json:"archetype"`
-	Remotes   []string  `json:"remotes"`
+	Name                string              `json:"name"`
+	Archetype           Archetype           `json:"archetype"`
+	Remotes             []string            `json:"remotes"`
+	Strategy            ResolutionStrategy  `json:"strategy"`
+	Pins                datatypes.JSONMap   `json:"pins"`
+	Offline             bool                `json:"offline"`
+	QuarantineDays      int64               `json:"quarantineDays"`
+	VulnerabilityAction VulnerabilityAction `json:"vulnerabilityAction"`
+	Private             bool                `json:"private"`
 }
 
 type NewRemote struct {
@@ -41,6 +122,7 @@ type NewRemote struct {
 	Archetype Archetype `json:"archetype"`
 	Transport string    `json:"transport"`
 	AuthMode  AuthMode  `json:"authMode"`
+	Hosted    bool      `json:"hosted"`
 }
 
 type NewRoleBinding struct {
@@ -49,6 +131,11 @@ type NewRoleBinding struct {
 	Verb     Verb   `json:"verb"`
 }
 
+type NewServiceAccount struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
 type NewTransportProfile struct {
 	Name          string `json:"name"`
 	Ca            string `json:"ca"`
@@ -78,41 +165,111 @@ type Overview struct {
 }
 
 type PatchRefract struct {
-	Name    string   `json:"name"`
-	Remotes []string `json:"remotes"`
+	Name                string              `json:"name"`
+	Remotes             []string            `json:"remotes"`
+	Strategy            ResolutionStrategy  `json:"strategy"`
+	Pins                datatypes.JSONMap   `json:"pins"`
+	Offline             bool                `json:"offline"`
+	QuarantineDays      int64               `json:"quarantineDays"`
+	VulnerabilityAction VulnerabilityAction `json:"vulnerabilityAction"`
+	Private             bool                `json:"private"`
 }
 
 type PatchRemote struct {
-	TransportID  string   `json:"transportID"`
-	Allowed      []string `json:"allowed"`
-	Blocked      []string `json:"blocked"`
-	AuthHeaders  []string `json:"authHeaders"`
-	DirectHeader string   `json:"directHeader"`
-	DirectToken  string   `json:"directToken"`
-	AuthMode     AuthMode `json:"authMode"`
+	TransportID         string     `json:"transportID"`
+	Allowed             []string   `json:"allowed"`
+	Blocked             []string   `json:"blocked"`
+	Rules               []string   `json:"rules"`
+	PolicyMode          PolicyMode `json:"policyMode"`
+	AuthHeaders         []string   `json:"authHeaders"`
+	DirectHeader        string     `json:"directHeader"`
+	DirectToken         string     `json:"directToken"`
+	AuthMode            AuthMode   `json:"authMode"`
+	Timeout             int64      `json:"timeout"`
+	BreakerThreshold    int64      `json:"breakerThreshold"`
+	BreakerCooldown     int64      `json:"breakerCooldown"`
+	RetentionMaxAge     int64      `json:"retentionMaxAge"`
+	RetentionMaxSize    int64      `json:"retentionMaxSize"`
+	RetentionKeepLatest int64      `json:"retentionKeepLatest"`
+}
+
+type QuarantineExemption struct {
+	ID           string `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
+	CreatedAt    int64  `json:"createdAt"`
+	RefractionID string `json:"refractionID" gorm:"index"`
+	Package      string `json:"package"`
+	Version      string `json:"version"`
+	CreatedBy    string `json:"createdBy"`
+}
+
+type Quota struct {
+	ID       string        `json:"id" gorm:"primaryKey;not null"`
+	Resource string        `json:"resource" gorm:"index"`
+	Type     BandwidthType `json:"type"`
+	Limit    int64         `json:"limit"`
+	Action   QuotaAction   `json:"action" gorm:"not null;default:TOO_MANY_REQUESTS"`
+}
+
+type QuotaEvent struct {
+	ID        string        `json:"id" gorm:"primaryKey;not null"`
+	CreatedAt int64         `json:"createdAt"`
+	Date      string        `json:"date" gorm:"index:idx_quota_event"`
+	Resource  string        `json:"resource" gorm:"index:idx_quota_event"`
+	Type      BandwidthType `json:"type"`
+	Threshold int64         `json:"threshold"`
+	Usage     int64         `json:"usage"`
+	Limit     int64         `json:"limit"`
 }
 
 type Refraction struct {
-	ID        string    `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
-	CreatedAt int64     `json:"createdAt"`
-	UpdatedAt int64     `json:"updatedAt"`
-	Name      string    `json:"name" gorm:"unique"`
-	Archetype Archetype `json:"archetype"`
-	Remotes   []*Remote `json:"remotes" gorm:"many2many:ref_remotes;"`
+	ID                  string              `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
+	CreatedAt           int64               `json:"createdAt"`
+	UpdatedAt           int64               `json:"updatedAt"`
+	Name                string              `json:"name" gorm:"unique"`
+	Archetype           Archetype           `json:"archetype"`
+	Remotes             []*Remote           `json:"remotes" gorm:"many2many:ref_remotes;"`
+	Strategy            ResolutionStrategy  `json:"strategy" gorm:"not null;default:FASTEST"`
+	Pins                datatypes.JSONMap   `json:"pins" gorm:"not null;type:jsonb;default:'{}'::jsonb"`
+	Offline             bool                `json:"offline" gorm:"not null;default:false"`
+	QuarantineDays      int64               `json:"quarantineDays" gorm:"not null;default:0"`
+	VulnerabilityAction VulnerabilityAction `json:"vulnerabilityAction" gorm:"not null;default:NONE"`
+	Private             bool                `json:"private" gorm:"not null;default:false"`
 }
 
 type Remote struct {
-	ID          string             `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
-	CreatedAt   int64              `json:"createdAt"`
-	UpdatedAt   int64              `json:"updatedAt"`
-	Name        string             `json:"name" gorm:"unique"`
-	URI         string             `json:"uri"`
-	Archetype   Archetype          `json:"archetype" gorm:"index"`
-	Enabled     bool               `json:"enabled" gorm:"index"`
-	SecurityID  string             `json:"securityID"`
-	Security    *RemoteSecurity    `json:"security"`
-	TransportID string             `json:"transportID"`
-	Transport   *TransportSecurity `json:"transport"`
+	ID                  string             `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
+	CreatedAt           int64              `json:"createdAt"`
+	UpdatedAt           int64              `json:"updatedAt"`
+	Name                string             `json:"name" gorm:"unique"`
+	URI                 string             `json:"uri"`
+	Archetype           Archetype          `json:"archetype" gorm:"index"`
+	Enabled             bool               `json:"enabled" gorm:"index"`
+	Hosted              bool               `json:"hosted" gorm:"not null;default:false"`
+	SecurityID          string             `json:"securityID"`
+	Security            *RemoteSecurity    `json:"security"`
+	TransportID         string             `json:"transportID"`
+	Transport           *TransportSecurity `json:"transport"`
+	Timeout             int64              `json:"timeout" gorm:"not null;default:10"`
+	BreakerThreshold    int64              `json:"breakerThreshold" gorm:"not null;default:5"`
+	BreakerCooldown     int64              `json:"breakerCooldown" gorm:"not null;default:30"`
+	RetentionMaxAge     int64              `json:"retentionMaxAge" gorm:"not null;default:0"`
+	RetentionMaxSize    int64              `json:"retentionMaxSize" gorm:"not null;default:0"`
+	RetentionKeepLatest int64              `json:"retentionKeepLatest" gorm:"not null;default:0"`
+}
+
+type RemoteHealth struct {
+	ID                  string         `json:"id"`
+	Replica             string         `json:"replica"`
+	State               BreakerState   `json:"state"`
+	Requests            int64          `json:"requests"`
+	Failures            int64          `json:"failures"`
+	ErrorRate           float64        `json:"errorRate"`
+	Latency             int64          `json:"latency"`
+	LatencyMax          int64          `json:"latencyMax"`
+	ConsecutiveFailures int64          `json:"consecutiveFailures"`
+	LastError           string         `json:"lastError"`
+	LastFailure         int64          `json:"lastFailure"`
+	History             []*HealthEvent `json:"history"`
 }
 
 type RemoteOverview struct {
@@ -124,18 +281,51 @@ type RemoteSecurity struct {
 	ID           string              `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
 	Allowed      datatypes.JSONArray `json:"allowed" gorm:"default:'[]'::jsonb"`
 	Blocked      datatypes.JSONArray `json:"blocked" gorm:"default:'[]'::jsonb"`
+	Rules        datatypes.JSONArray `json:"rules" gorm:"default:'[]'::jsonb"`
+	PolicyMode   PolicyMode          `json:"policyMode" gorm:"default:ENFORCE"`
 	AuthHeaders  datatypes.JSONArray `json:"authHeaders" gorm:"default:'[]'::jsonb"`
 	DirectHeader string              `json:"directHeader"`
 	DirectToken  string              `json:"directToken"`
 	AuthMode     AuthMode            `json:"authMode" gorm:"default:NONE"`
 }
 
+type RetentionCandidate struct {
+	ID        string         `json:"id"`
+	URI       string         `json:"uri"`
+	Reason    EvictionReason `json:"reason"`
+	Size      int64          `json:"size"`
+	Downloads int64          `json:"downloads"`
+	UpdatedAt int64          `json:"updatedAt"`
+}
+
+type RetentionReport struct {
+	RemoteID   string                `json:"remoteID"`
+	Count      int64                 `json:"count"`
+	Size       int64                 `json:"size"`
+	Candidates []*RetentionCandidate `json:"candidates"`
+}
+
 type RoleBinding struct {
 	Subject  string `json:"subject"`
 	Resource string `json:"resource"`
 	Verb     Verb   `json:"verb"`
 }
 
+type ServiceAccount struct {
+	ID          string `json:"id" gorm:"primaryKey;type:uuid;not null;default:gen_random_uuid()"`
+	CreatedAt   int64  `json:"createdAt"`
+	Name        string `json:"name" gorm:"unique"`
+	Description string `json:"description"`
+	CreatedBy   string `json:"createdBy"`
+}
+
+type SetQuota struct {
+	Resource string        `json:"resource"`
+	Type     BandwidthType `json:"type"`
+	Limit    int64         `json:"limit"`
+	Action   QuotaAction   `json:"action"`
+}
+
 type StoredUser struct {
 	ID          string            `json:"id" gorm:"primaryKey;not null"`
 	Sub         string            `json:"sub"`
@@ -173,6 +363,8 @@ const (
 	ArchetypeRust    Archetype = "RUST"
 	ArchetypeDebian  Archetype = "DEBIAN"
 	ArchetypePip     Archetype = "PIP"
+	ArchetypeRpm     Archetype = "RPM"
+	ArchetypeOci     Archetype = "OCI"
 )
 
 var AllArchetype = []Archetype{
@@ -185,11 +377,13 @@ var AllArchetype = []Archetype{
 	ArchetypeRust,
 	ArchetypeDebian,
 	ArchetypePip,
+	ArchetypeRpm,
+	ArchetypeOci,
 }
 
 func (e Archetype) IsValid() bool {
 	switch e {
-	case ArchetypeGeneric, ArchetypeMaven, ArchetypeGo, ArchetypeNpm, ArchetypeAlpine, ArchetypeHelm, ArchetypeRust, ArchetypeDebian, ArchetypePip:
+	case ArchetypeGeneric, ArchetypeMaven, ArchetypeGo, ArchetypeNpm, ArchetypeAlpine, ArchetypeHelm, ArchetypeRust, ArchetypeDebian, ArchetypePip, ArchetypeRpm, ArchetypeOci:
 		return true
 	}
 	return false
@@ -302,6 +496,219 @@ func (e BandwidthType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type BreakerState string
+
+const (
+	BreakerStateClosed   BreakerState = "CLOSED"
+	BreakerStateOpen     BreakerState = "OPEN"
+	BreakerStateHalfOpen BreakerState = "HALF_OPEN"
+)
+
+var AllBreakerState = []BreakerState{
+	BreakerStateClosed,
+	BreakerStateOpen,
+	BreakerStateHalfOpen,
+}
+
+func (e BreakerState) IsValid() bool {
+	switch e {
+	case BreakerStateClosed, BreakerStateOpen, BreakerStateHalfOpen:
+		return true
+	}
+	return false
+}
+
+func (e BreakerState) String() string {
+	return string(e)
+}
+
+func (e *BreakerState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BreakerState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BreakerState", str)
+	}
+	return nil
+}
+
+func (e BreakerState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type EvictionReason string
+
+const (
+	EvictionReasonMaxAge     EvictionReason = "MAX_AGE"
+	EvictionReasonMaxSize    EvictionReason = "MAX_SIZE"
+	EvictionReasonKeepLatest EvictionReason = "KEEP_LATEST"
+)
+
+var AllEvictionReason = []EvictionReason{
+	EvictionReasonMaxAge,
+	EvictionReasonMaxSize,
+	EvictionReasonKeepLatest,
+}
+
+func (e EvictionReason) IsValid() bool {
+	switch e {
+	case EvictionReasonMaxAge, EvictionReasonMaxSize, EvictionReasonKeepLatest:
+		return true
+	}
+	return false
+}
+
+func (e EvictionReason) String() string {
+	return string(e)
+}
+
+func (e *EvictionReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EvictionReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EvictionReason", str)
+	}
+	return nil
+}
+
+func (e EvictionReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PolicyMode string
+
+const (
+	PolicyModeEnforce PolicyMode = "ENFORCE"
+	PolicyModeAudit   PolicyMode = "AUDIT"
+)
+
+var AllPolicyMode = []PolicyMode{
+	PolicyModeEnforce,
+	PolicyModeAudit,
+}
+
+func (e PolicyMode) IsValid() bool {
+	switch e {
+	case PolicyModeEnforce, PolicyModeAudit:
+		return true
+	}
+	return false
+}
+
+func (e PolicyMode) String() string {
+	return string(e)
+}
+
+func (e *PolicyMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyMode", str)
+	}
+	return nil
+}
+
+func (e PolicyMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type QuotaAction string
+
+const (
+	QuotaActionTooManyRequests     QuotaAction = "TOO_MANY_REQUESTS"
+	QuotaActionInsufficientStorage QuotaAction = "INSUFFICIENT_STORAGE"
+	QuotaActionCacheOnly           QuotaAction = "CACHE_ONLY"
+)
+
+var AllQuotaAction = []QuotaAction{
+	QuotaActionTooManyRequests,
+	QuotaActionInsufficientStorage,
+	QuotaActionCacheOnly,
+}
+
+func (e QuotaAction) IsValid() bool {
+	switch e {
+	case QuotaActionTooManyRequests, QuotaActionInsufficientStorage, QuotaActionCacheOnly:
+		return true
+	}
+	return false
+}
+
+func (e QuotaAction) String() string {
+	return string(e)
+}
+
+func (e *QuotaAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = QuotaAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid QuotaAction", str)
+	}
+	return nil
+}
+
+func (e QuotaAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ResolutionStrategy string
+
+const (
+	ResolutionStrategyFastest ResolutionStrategy = "FASTEST"
+	ResolutionStrategyOrdered ResolutionStrategy = "ORDERED"
+	ResolutionStrategyPinned  ResolutionStrategy = "PINNED"
+)
+
+var AllResolutionStrategy = []ResolutionStrategy{
+	ResolutionStrategyFastest,
+	ResolutionStrategyOrdered,
+	ResolutionStrategyPinned,
+}
+
+func (e ResolutionStrategy) IsValid() bool {
+	switch e {
+	case ResolutionStrategyFastest, ResolutionStrategyOrdered, ResolutionStrategyPinned:
+		return true
+	}
+	return false
+}
+
+func (e ResolutionStrategy) String() string {
+	return string(e)
+}
+
+func (e *ResolutionStrategy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ResolutionStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ResolutionStrategy", str)
+	}
+	return nil
+}
+
+func (e ResolutionStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Role string
 
 const (
@@ -389,3 +796,48 @@ func (e *Verb) UnmarshalGQL(v interface{}) error {
 func (e Verb) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type VulnerabilityAction string
+
+const (
+	VulnerabilityActionNone  VulnerabilityAction = "NONE"
+	VulnerabilityActionWarn  VulnerabilityAction = "WARN"
+	VulnerabilityActionHide  VulnerabilityAction = "HIDE"
+	VulnerabilityActionBlock VulnerabilityAction = "BLOCK"
+)
+
+var AllVulnerabilityAction = []VulnerabilityAction{
+	VulnerabilityActionNone,
+	VulnerabilityActionWarn,
+	VulnerabilityActionHide,
+	VulnerabilityActionBlock,
+}
+
+func (e VulnerabilityAction) IsValid() bool {
+	switch e {
+	case VulnerabilityActionNone, VulnerabilityActionWarn, VulnerabilityActionHide, VulnerabilityActionBlock:
+		return true
+	}
+	return false
+}
+
+func (e VulnerabilityAction) String() string {
+	return string(e)
+}
+
+func (e *VulnerabilityAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VulnerabilityAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VulnerabilityAction", str)
+	}
+	return nil
+}
+
+func (e VulnerabilityAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -23,15 +23,23 @@ import (
 	"github.com/djcass44/go-utils/utilities/sliceutils"
 	"github.com/go-logr/logr"
 	"github.com/hibiken/asynq"
+	"github.com/lpar/problem"
 	"gitlab.com/av1o/cap10/pkg/client"
 	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/audit"
+	"gitlab.com/go-prism/prism3/core/internal/errs"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/internal/permissions"
 	"gitlab.com/go-prism/prism3/core/pkg/db/notify"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/retention"
 	"gitlab.com/go-prism/prism3/core/pkg/storage"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
 	"strings"
 	"time"
 )
@@ -51,6 +59,9 @@ type Resolver struct {
 	store    storage.Reader
 	authz    *permissions.Manager
 	notifier *notify.Notifier
+	health   *remote.Health
+	gc       *retention.Collector
+	audit    *audit.Recorder
 
 	client *asynq.Client
 
@@ -58,12 +69,15 @@ type Resolver struct {
 	storeSizeCache gcache.Cache
 }
 
-func NewResolver(repos *repo.Repos, store storage.Reader, client *asynq.Client, notifier *notify.Notifier, authz *permissions.Manager) *Resolver {
+func NewResolver(repos *repo.Repos, store storage.Reader, client *asynq.Client, notifier *notify.Notifier, health *remote.Health, authz *permissions.Manager) *Resolver {
 	r := &Resolver{
 		repos:    repos,
 		store:    store,
 		authz:    authz,
 		notifier: notifier,
+		health:   health,
+		gc:       retention.NewCollector(repos, store),
+		audit:    audit.NewRecorder(repos.AuditRepo.CreateEvent),
 		client:   client,
 	}
 	r.storeSizeCache = gcache.New(10).ARC().LoaderFunc(r.getStoreSize).Expiration(time.Minute * 5).Build()
@@ -115,17 +129,49 @@ func (r *Resolver) stream(ctx context.Context, tables []string, f func(msg *noti
 	}()
 }
 
-func (r *Resolver) createRoleBinding(ctx context.Context, resourceOrRole string, action rbac.Verb) error {
-	user, _ := client.GetContextUser(ctx)
-	log := logr.FromContextOrDiscard(ctx)
-	subject := permissions.NormalUser(user.AsUsername())
-	// if no resource is declared,
-	// create a global role
+// canBind checks that the user is allowed to manage the
+// role bindings of a resource or global role.
+func (r *Resolver) canBind(ctx context.Context, resourceOrRole string) error {
 	res, id, ok := strings.Cut(resourceOrRole, "::")
 	if !ok {
-		if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
-			return err
+		return r.authz.AmI(ctx, model.RoleSuper)
+	}
+	// allow owners of a resource
+	// to manage role bindings for it
+	return r.authz.CanI(ctx, repo.Resource(res), id, rbac.Verb_SUDO)
+}
+
+// roleSubject normalises the subject of a role binding
+// so that it matches the subject used during checks.
+//
+// An empty subject refers to the current user.
+func roleSubject(ctx context.Context, subject string) (string, error) {
+	subject = strings.TrimSpace(subject)
+	if group, ok := strings.CutPrefix(subject, permissions.GroupPrefix); ok {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			return "", problem.New(http.StatusBadRequest).Errorf("group name must be set")
 		}
+		return permissions.GroupSubject(group), nil
+	}
+	if subject == "" {
+		user, ok := client.GetContextUser(ctx)
+		if !ok {
+			return "", errs.ErrUnauthorised
+		}
+		subject = user.AsUsername()
+	}
+	return permissions.NormalUser(subject), nil
+}
+
+func (r *Resolver) createRoleBinding(ctx context.Context, subject, resourceOrRole string, action rbac.Verb) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Subject", subject, "Resource", resourceOrRole)
+	if err := r.canBind(ctx, resourceOrRole); err != nil {
+		return err
+	}
+	// if no resource is declared,
+	// create a global role
+	if !strings.Contains(resourceOrRole, "::") {
 		_, err := r.authz.RBAC.AddGlobalRole(ctx, &rbac.AddGlobalRoleRequest{
 			Subject: subject,
 			Role:    resourceOrRole,
@@ -134,22 +180,49 @@ func (r *Resolver) createRoleBinding(ctx context.Context, resourceOrRole string,
 			log.Error(err, "failed to create global role binding")
 			return err
 		}
+		return nil
+	}
+	// create a standard role
+	_, err := r.authz.RBAC.AddRole(ctx, &rbac.AddRoleRequest{
+		Subject:  subject,
+		Resource: resourceOrRole,
+		Action:   action,
+	})
+	if err != nil {
+		log.Error(err, "failed to create role binding")
+		return err
+	}
+	return nil
+}
+
+func (r *Resolver) deleteRoleBinding(ctx context.Context, subject, resourceOrRole string, action rbac.Verb) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Subject", subject, "Resource", resourceOrRole)
+	if err := r.canBind(ctx, resourceOrRole); err != nil {
+		return err
+	}
+	var err error
+	// if no resource is declared,
+	// remove a global role
+	if !strings.Contains(resourceOrRole, "::") {
+		_, err = r.authz.RBAC.RemoveGlobalRole(ctx, &rbac.AddGlobalRoleRequest{
+			Subject: subject,
+			Role:    resourceOrRole,
+		})
 	} else {
-		// allow owners of a resource
-		// to create role bindings for it
-		if err := r.authz.CanI(ctx, repo.Resource(res), id, rbac.Verb_SUDO); err != nil {
-			return err
-		}
-		// create a standard role
-		_, err := r.authz.RBAC.AddRole(ctx, &rbac.AddRoleRequest{
+		_, err = r.authz.RBAC.RemoveRole(ctx, &rbac.AddRoleRequest{
 			Subject:  subject,
 			Resource: resourceOrRole,
 			Action:   action,
 		})
-		if err != nil {
-			log.Error(err, "failed to create global role binding")
-			return err
+	}
+	if err != nil {
+		log.Error(err, "failed to delete role binding")
+		// older versions of the rbac sidecar
+		// can't remove role bindings
+		if status.Code(err) == codes.Unimplemented {
+			return problem.New(http.StatusNotImplemented).Errorf("the rbac sidecar does not support removing role bindings")
 		}
+		return err
 	}
 	return nil
 }
@@ -0,0 +1,118 @@
+package graph
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/av1o/cap10/pkg/client"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/audit"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/permissions"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+type binding struct {
+	subject  string
+	resource string
+	action   rbac.Verb
+}
+
+// memoryAuthority keeps role bindings in memory
+// like the rbac sidecar. Super users are able to
+// do anything, as set by the chart's config.
+type memoryAuthority struct {
+	permissions.Authority
+	bindings map[binding]struct{}
+}
+
+func (m *memoryAuthority) Can(_ context.Context, in *rbac.AccessRequest, _ ...grpc.CallOption) (*rbac.GenericResponse, error) {
+	_, ok := m.bindings[binding{in.Subject, in.Resource, in.Action}]
+	if !ok {
+		_, ok = m.bindings[binding{in.Subject, in.Resource, rbac.Verb_SUDO}]
+	}
+	if !ok {
+		_, ok = m.bindings[binding{subject: in.Subject, resource: string(model.RoleSuper)}]
+	}
+	return &rbac.GenericResponse{Ok: ok}, nil
+}
+
+func (m *memoryAuthority) AddRole(_ context.Context, in *rbac.AddRoleRequest, _ ...grpc.CallOption) (*rbac.GenericResponse, error) {
+	m.bindings[binding{in.Subject, in.Resource, in.Action}] = struct{}{}
+	return &rbac.GenericResponse{Ok: true}, nil
+}
+
+func (m *memoryAuthority) AddGlobalRole(_ context.Context, in *rbac.AddGlobalRoleRequest, _ ...grpc.CallOption) (*rbac.GenericResponse, error) {
+	m.bindings[binding{subject: in.Subject, resource: in.Role}] = struct{}{}
+	return &rbac.GenericResponse{Ok: true}, nil
+}
+
+func (m *memoryAuthority) RemoveRole(_ context.Context, in *rbac.AddRoleRequest, _ ...grpc.CallOption) (*rbac.GenericResponse, error) {
+	delete(m.bindings, binding{in.Subject, in.Resource, in.Action})
+	return &rbac.GenericResponse{Ok: true}, nil
+}
+
+func (m *memoryAuthority) RemoveGlobalRole(_ context.Context, in *rbac.AddGlobalRoleRequest, _ ...grpc.CallOption) (*rbac.GenericResponse, error) {
+	delete(m.bindings, binding{subject: in.Subject, resource: in.Role})
+	return &rbac.GenericResponse{Ok: true}, nil
+}
+
+func (m *memoryAuthority) List(context.Context, *emptypb.Empty, ...grpc.CallOption) (*rbac.ListResponse, error) {
+	resp := &rbac.ListResponse{}
+	for b := range m.bindings {
+		resp.Results = append(resp.Results, &rbac.RoleBinding{Subject: b.subject, Resource: b.resource, Action: b.action})
+	}
+	return resp, nil
+}
+
+func TestMutationResolver_DeleteRoleBinding(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	admin := &client.UserClaim{Iss: "CN=Prism CA", Sub: "CN=Admin"}
+	authority := &memoryAuthority{bindings: map[binding]struct{}{
+		{subject: permissions.NormalUser(admin.AsUsername()), resource: string(model.RoleSuper)}: {},
+	}}
+	r := &Resolver{
+		authz: &permissions.Manager{RBAC: authority},
+		audit: audit.NewRecorder(func(context.Context, *model.AuditEvent) error {
+			return nil
+		}),
+	}
+	ctx = client.PersistUserCtx(ctx, nil, admin)
+	input := model.NewRoleBinding{
+		Subject:  "CN=Prism CA/CN=John Smith",
+		Resource: "refraction::foo",
+		Verb:     model.VerbRead,
+	}
+	contains := func() bool {
+		bindings, err := r.Query().GetRoleBindings(ctx, "")
+		require.NoError(t, err)
+		for _, b := range bindings {
+			if b.Subject == permissions.NormalUser(input.Subject) && b.Resource == input.Resource && b.Verb == input.Verb {
+				return true
+			}
+		}
+		return false
+	}
+
+	_, err := r.Mutation().CreateRoleBinding(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, contains())
+
+	ok, err := r.Mutation().DeleteRoleBinding(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, contains())
+
+	t.Run("unauthorised users cannot delete bindings", func(t *testing.T) {
+		_, err := r.Mutation().CreateRoleBinding(ctx, input)
+		require.NoError(t, err)
+		userCtx := client.PersistUserCtx(context.TODO(), nil, &client.UserClaim{Iss: "CN=Prism CA", Sub: "CN=Jane Doe"})
+		_, err = r.Mutation().DeleteRoleBinding(userCtx, input)
+		assert.Error(t, err)
+		assert.True(t, contains())
+	})
+}
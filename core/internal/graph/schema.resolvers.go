@@ -23,17 +23,24 @@ package graph
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"runtime"
 	"runtime/debug"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
 	"gitlab.com/av1o/cap10/pkg/client"
 	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/audit"
 	"gitlab.com/go-prism/prism3/core/internal/errs"
 	"gitlab.com/go-prism/prism3/core/internal/graph/generated"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/internal/permissions"
+	"gitlab.com/go-prism/prism3/core/internal/policy"
+	"gitlab.com/go-prism/prism3/core/internal/tokens"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db/notify"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
@@ -50,7 +57,11 @@ func (r *mutationResolver) CreateRemote(ctx context.Context, input model.NewRemo
 	}
 	// make sure that the creator has full
 	// access to the remote
-	if err := r.createRoleBinding(ctx, fmt.Sprintf("%s::%s", repo.ResourceRemote, input.Name), rbac.Verb_SUDO); err != nil {
+	subject, err := roleSubject(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.createRoleBinding(ctx, subject, fmt.Sprintf("%s::%s", repo.ResourceRemote, input.Name), rbac.Verb_SUDO); err != nil {
 		return nil, err
 	}
 	rem, err := r.repos.RemoteRepo.CreateRemote(ctx, &input)
@@ -62,6 +73,7 @@ func (r *mutationResolver) CreateRemote(ctx context.Context, input model.NewRemo
 		return nil, err
 	}
 	_, _ = r.client.Enqueue(task)
+	r.audit.Record(ctx, "createRemote", audit.Resource(string(repo.ResourceRemote), rem.ID), nil, input)
 	return rem, err
 }
 
@@ -69,16 +81,49 @@ func (r *mutationResolver) PatchRemote(ctx context.Context, id string, input mod
 	if err := r.authz.CanI(ctx, repo.ResourceRemote, id, rbac.Verb_UPDATE); err != nil {
 		return nil, err
 	}
-	return r.repos.RemoteRepo.PatchRemote(ctx, id, &input)
+	if err := policy.Validate(input.Allowed, input.Blocked, input.Rules); err != nil {
+		return nil, err
+	}
+	before, err := r.repos.RemoteRepo.GetRemote(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	rem, err := r.repos.RemoteRepo.PatchRemote(ctx, id, &input)
+	if err != nil {
+		return nil, err
+	}
+	r.audit.Record(ctx, "patchRemote", audit.Resource(string(repo.ResourceRemote), id), before, rem)
+	return rem, nil
 }
 
 func (r *mutationResolver) DeleteRemote(ctx context.Context, id string) (bool, error) {
 	if err := r.authz.CanI(ctx, repo.ResourceRemote, id, rbac.Verb_DELETE); err != nil {
 		return false, err
 	}
+	before, err := r.repos.RemoteRepo.GetRemote(ctx, id, true)
+	if err != nil {
+		return false, err
+	}
 	if err := r.repos.RemoteRepo.DeleteRemote(ctx, id); err != nil {
 		return false, err
 	}
+	r.audit.Record(ctx, "deleteRemote", audit.Resource(string(repo.ResourceRemote), id), before, nil)
+	return true, nil
+}
+
+func (r *mutationResolver) CollectGarbage(ctx context.Context, id string) (bool, error) {
+	if err := r.authz.CanI(ctx, repo.ResourceRemote, id, rbac.Verb_SUDO); err != nil {
+		return false, err
+	}
+	task, err := tasks.NewTask[tasks.CollectGarbagePayload](ctx, tasks.TypeCollectGarbage, &tasks.CollectGarbagePayload{RemoteID: id})
+	if err != nil {
+		return false, err
+	}
+	if _, err := r.client.Enqueue(task); err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err, "failed to enqueue garbage collection")
+		return false, err
+	}
+	r.audit.Record(ctx, "collectGarbage", audit.Resource(string(repo.ResourceRemote), id), nil, nil)
 	return true, nil
 }
 
@@ -88,59 +133,280 @@ func (r *mutationResolver) CreateRefraction(ctx context.Context, input model.New
 	}
 	// make sure that the creator has full
 	// access to the remote
-	if err := r.createRoleBinding(ctx, fmt.Sprintf("%s::%s", repo.ResourceRefraction, input.Name), rbac.Verb_SUDO); err != nil {
+	subject, err := roleSubject(ctx, "")
+	if err != nil {
 		return nil, err
 	}
-	return r.repos.RefractRepo.CreateRefraction(ctx, &input)
+	if err := r.createRoleBinding(ctx, subject, fmt.Sprintf("%s::%s", repo.ResourceRefraction, input.Name), rbac.Verb_SUDO); err != nil {
+		return nil, err
+	}
+	ref, err := r.repos.RefractRepo.CreateRefraction(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+	r.audit.Record(ctx, "createRefraction", audit.Resource(string(repo.ResourceRefraction), ref.ID), nil, input)
+	return ref, nil
 }
 
 func (r *mutationResolver) PatchRefraction(ctx context.Context, id string, input model.PatchRefract) (*model.Refraction, error) {
 	if err := r.authz.CanI(ctx, repo.ResourceRefraction, id, rbac.Verb_UPDATE); err != nil {
 		return nil, err
 	}
-	return r.repos.RefractRepo.PatchRefraction(ctx, id, &input)
+	before, err := r.repos.RefractRepo.GetRefraction(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := r.repos.RefractRepo.PatchRefraction(ctx, id, &input)
+	if err != nil {
+		return nil, err
+	}
+	r.audit.Record(ctx, "patchRefraction", audit.Resource(string(repo.ResourceRefraction), id), before, ref)
+	return ref, nil
 }
 
 func (r *mutationResolver) DeleteRefraction(ctx context.Context, id string) (bool, error) {
 	if err := r.authz.CanI(ctx, repo.ResourceRefraction, id, rbac.Verb_DELETE); err != nil {
 		return false, err
 	}
+	before, err := r.repos.RefractRepo.GetRefraction(ctx, id)
+	if err != nil {
+		return false, err
+	}
 	if err := r.repos.RefractRepo.DeleteRefraction(ctx, id); err != nil {
 		return false, err
 	}
+	r.audit.Record(ctx, "deleteRefraction", audit.Resource(string(repo.ResourceRefraction), id), before, nil)
 	return true, nil
 }
 
 func (r *mutationResolver) CreateRoleBinding(ctx context.Context, input model.NewRoleBinding) (*model.RoleBinding, error) {
-	user, _ := client.GetContextUser(ctx)
-	subject := permissions.NormalUser(user.AsUsername())
-	if err := r.createRoleBinding(ctx, input.Resource, rbac.Verb(rbac.Verb_value[input.Verb.String()])); err != nil {
+	subject, err := roleSubject(ctx, input.Subject)
+	if err != nil {
 		return nil, err
 	}
-	return &model.RoleBinding{
+	if err := r.createRoleBinding(ctx, subject, input.Resource, rbac.Verb(rbac.Verb_value[input.Verb.String()])); err != nil {
+		return nil, err
+	}
+	binding := &model.RoleBinding{
 		Subject:  subject,
 		Resource: input.Resource,
 		Verb:     input.Verb,
+	}
+	r.audit.Record(ctx, "createRoleBinding", input.Resource, nil, binding)
+	return binding, nil
+}
+
+func (r *mutationResolver) DeleteRoleBinding(ctx context.Context, input model.NewRoleBinding) (bool, error) {
+	subject, err := roleSubject(ctx, input.Subject)
+	if err != nil {
+		return false, err
+	}
+	if err := r.deleteRoleBinding(ctx, subject, input.Resource, rbac.Verb(rbac.Verb_value[input.Verb.String()])); err != nil {
+		return false, err
+	}
+	binding := &model.RoleBinding{
+		Subject:  subject,
+		Resource: input.Resource,
+		Verb:     input.Verb,
+	}
+	r.audit.Record(ctx, "deleteRoleBinding", input.Resource, binding, nil)
+	return true, nil
+}
+
+func (r *mutationResolver) CreateServiceAccount(ctx context.Context, input model.NewServiceAccount) (*model.ServiceAccount, error) {
+	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
+		return nil, err
+	}
+	user, _ := client.GetContextUser(ctx)
+	sa, err := r.repos.TokenRepo.CreateServiceAccount(ctx, &input, permissions.NormalUser(user.AsUsername()))
+	if err != nil {
+		return nil, err
+	}
+	r.audit.Record(ctx, "createServiceAccount", audit.Resource("serviceAccount", sa.ID), nil, sa)
+	return sa, nil
+}
+
+func (r *mutationResolver) DeleteServiceAccount(ctx context.Context, id string) (bool, error) {
+	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
+		return false, err
+	}
+	before, err := r.repos.TokenRepo.GetServiceAccount(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if err := r.repos.TokenRepo.DeleteServiceAccount(ctx, id); err != nil {
+		return false, err
+	}
+	r.audit.Record(ctx, "deleteServiceAccount", audit.Resource("serviceAccount", id), before, nil)
+	return true, nil
+}
+
+func (r *mutationResolver) CreateAccessToken(ctx context.Context, input model.NewAccessToken) (*model.CreatedAccessToken, error) {
+	user, ok := client.GetContextUser(ctx)
+	if !ok {
+		return nil, errs.ErrUnauthorised
+	}
+	iss, sub := user.Iss, user.Sub
+	var serviceAccountID string
+	// tokens for a service account authenticate
+	// as the service account, not the caller
+	if input.ServiceAccountID != nil && *input.ServiceAccountID != "" {
+		if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
+			return nil, err
+		}
+		sa, err := r.repos.TokenRepo.GetServiceAccount(ctx, *input.ServiceAccountID)
+		if err != nil {
+			return nil, err
+		}
+		iss, sub, serviceAccountID = tokens.IssuerServiceAccount, sa.Name, sa.ID
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, problem.New(http.StatusBadRequest).Errorf("name must be set")
+	}
+	for _, s := range input.Scopes {
+		if err := tokens.ParseScope(s); err != nil {
+			return nil, err
+		}
+	}
+	if input.ExpiresAt <= time.Now().Unix() {
+		return nil, problem.New(http.StatusBadRequest).Errorf("expiry must be in the future")
+	}
+	secret, hash, err := tokens.New()
+	if err != nil {
+		return nil, err
+	}
+	t := &schemas.AccessToken{
+		AccessToken: model.AccessToken{
+			CreatedAt:        time.Now().Unix(),
+			Name:             strings.TrimSpace(input.Name),
+			Iss:              iss,
+			Sub:              sub,
+			ServiceAccountID: serviceAccountID,
+			Scopes:           input.Scopes,
+			ExpiresAt:        input.ExpiresAt,
+		},
+		Hash: hash,
+	}
+	if err := r.repos.TokenRepo.CreateToken(ctx, t); err != nil {
+		return nil, err
+	}
+	r.audit.Record(ctx, "createAccessToken", audit.Resource("token", t.ID), nil, t.AccessToken)
+	return &model.CreatedAccessToken{
+		Token:       secret,
+		AccessToken: &t.AccessToken,
 	}, nil
 }
 
+func (r *mutationResolver) RevokeAccessToken(ctx context.Context, id string) (bool, error) {
+	user, ok := client.GetContextUser(ctx)
+	if !ok {
+		return false, errs.ErrUnauthorised
+	}
+	t, err := r.repos.TokenRepo.GetToken(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	// users can revoke their own tokens, but
+	// anything else needs a superuser
+	if t.ServiceAccountID != "" || t.Iss != user.Iss || t.Sub != user.Sub {
+		if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
+			return false, err
+		}
+	}
+	if err := r.repos.TokenRepo.DeleteToken(ctx, id); err != nil {
+		return false, err
+	}
+	r.audit.Record(ctx, "revokeAccessToken", audit.Resource("token", id), t.AccessToken, nil)
+	return true, nil
+}
+
 func (r *mutationResolver) CreateTransportProfile(ctx context.Context, input model.NewTransportProfile) (*model.TransportSecurity, error) {
 	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
 		return nil, err
 	}
-	return r.repos.TransportRepo.CreateTransport(ctx, &input)
+	tp, err := r.repos.TransportRepo.CreateTransport(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+	r.audit.Record(ctx, "createTransportProfile", audit.Resource(string(repo.ResourceTransport), tp.ID), nil, tp)
+	return tp, nil
 }
 
 func (r *mutationResolver) SetPreference(ctx context.Context, key string, value string) (bool, error) {
 	return true, r.repos.UserRepo.SetPreference(ctx, key, value)
 }
 
+func (r *mutationResolver) SetQuota(ctx context.Context, input model.SetQuota) (*model.Quota, error) {
+	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
+		return nil, err
+	}
+	q, err := r.repos.BandwidthRepo.SetQuota(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+	r.audit.Record(ctx, "setQuota", audit.Resource("quota", input.Resource), nil, q)
+	return q, nil
+}
+
+func (r *mutationResolver) DeleteQuota(ctx context.Context, resource string, typeArg model.BandwidthType) (bool, error) {
+	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
+		return false, err
+	}
+	if err := r.repos.BandwidthRepo.DeleteQuota(ctx, resource, typeArg); err != nil {
+		return false, err
+	}
+	r.audit.Record(ctx, "deleteQuota", audit.Resource("quota", resource), map[string]any{"type": typeArg}, nil)
+	return true, nil
+}
+
+func (r *mutationResolver) CreateQuarantineExemption(ctx context.Context, input model.NewQuarantineExemption) (*model.QuarantineExemption, error) {
+	if err := r.authz.CanI(ctx, repo.ResourceRefraction, input.RefractionID, rbac.Verb_SUDO); err != nil {
+		return nil, err
+	}
+	user, _ := client.GetContextUser(ctx)
+	e, err := r.repos.QuarantineRepo.CreateExemption(ctx, &input, permissions.NormalUser(user.AsUsername()))
+	if err != nil {
+		return nil, err
+	}
+	r.audit.Record(ctx, "createQuarantineExemption", audit.Resource(string(repo.ResourceRefraction), input.RefractionID), nil, e)
+	// regenerate the PyPi index so that
+	// the exemption is picked up
+	_ = r.repos.PyPackageRepo.ExpireIndex(ctx, e.Package)
+	return e, nil
+}
+
+func (r *mutationResolver) DeleteQuarantineExemption(ctx context.Context, id string) (bool, error) {
+	e, err := r.repos.QuarantineRepo.GetExemption(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if err := r.authz.CanI(ctx, repo.ResourceRefraction, e.RefractionID, rbac.Verb_SUDO); err != nil {
+		return false, err
+	}
+	if err := r.repos.QuarantineRepo.DeleteExemption(ctx, id); err != nil {
+		return false, err
+	}
+	r.audit.Record(ctx, "deleteQuarantineExemption", audit.Resource(string(repo.ResourceRefraction), e.RefractionID), e, nil)
+	_ = r.repos.PyPackageRepo.ExpireIndex(ctx, e.Package)
+	return true, nil
+}
+
 func (r *queryResolver) ListRemotes(ctx context.Context, arch string) ([]*model.Remote, error) {
 	return r.repos.RemoteRepo.ListRemotes(ctx, model.Archetype(arch), r.authz.AmI(ctx, model.RoleSuper) == nil)
 }
 
 func (r *queryResolver) GetRemote(ctx context.Context, id string) (*model.Remote, error) {
-	return r.repos.RemoteRepo.GetRemote(ctx, id, r.authz.AmI(ctx, model.RoleSuper) == nil)
+	sensitive := r.authz.AmI(ctx, model.RoleSuper) == nil
+	rem, err := r.repos.RemoteRepo.GetRemote(ctx, id, sensitive)
+	if err != nil {
+		return nil, err
+	}
+	// superusers can see secrets (e.g., the direct
+	// token), so we keep track of who reads them
+	if sensitive {
+		r.audit.Record(ctx, "getRemote", audit.Resource(string(repo.ResourceRemote), id), nil, nil)
+	}
+	return rem, nil
 }
 
 func (r *queryResolver) ListRefractions(ctx context.Context) ([]*model.Refraction, error) {
@@ -155,7 +421,13 @@ func (r *queryResolver) ListTransports(ctx context.Context) ([]*model.TransportS
 	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
 		return nil, err
 	}
-	return r.repos.TransportRepo.ListTransports(ctx)
+	results, err := r.repos.TransportRepo.ListTransports(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// transport profiles contain private keys
+	r.audit.Record(ctx, "listTransports", audit.Resource(string(repo.ResourceTransport), ""), nil, nil)
+	return results, nil
 }
 
 func (r *queryResolver) ListArtifacts(ctx context.Context, remote string) ([]*model.Artifact, error) {
@@ -255,6 +527,22 @@ func (r *queryResolver) GetRemoteOverview(ctx context.Context, id string) (*mode
 	}, nil
 }
 
+func (r *queryResolver) GetRemoteHealth(ctx context.Context, id string) (*model.RemoteHealth, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "graph_query_getRemoteHealth")
+	defer span.End()
+	if err := r.authz.CanI(ctx, repo.ResourceRemote, id, rbac.Verb_READ); err != nil {
+		return nil, err
+	}
+	return r.health.Get(id), nil
+}
+
+func (r *queryResolver) GetRetentionReport(ctx context.Context, id string) (*model.RetentionReport, error) {
+	if err := r.authz.CanI(ctx, repo.ResourceRemote, id, rbac.Verb_SUDO); err != nil {
+		return nil, err
+	}
+	return r.gc.Collect(ctx, id, true)
+}
+
 func (r *queryResolver) GetRoleBindings(ctx context.Context, user string) ([]*model.RoleBinding, error) {
 	log := logr.FromContextOrDiscard(ctx)
 	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
@@ -324,6 +612,54 @@ func (r *queryResolver) GetTotalBandwidthUsage(ctx context.Context, resource str
 	return r.repos.BandwidthRepo.GetTotal(ctx, resource)
 }
 
+func (r *queryResolver) ListQuotas(ctx context.Context, resource string) ([]*model.Quota, error) {
+	res, id, _ := strings.Cut(resource, "::")
+	if err := r.authz.CanI(ctx, repo.Resource(res), id, rbac.Verb_SUDO); err != nil {
+		return nil, err
+	}
+	return r.repos.BandwidthRepo.ListQuotas(ctx, resource)
+}
+
+func (r *queryResolver) ListQuotaEvents(ctx context.Context, resource string) ([]*model.QuotaEvent, error) {
+	res, id, _ := strings.Cut(resource, "::")
+	if err := r.authz.CanI(ctx, repo.Resource(res), id, rbac.Verb_SUDO); err != nil {
+		return nil, err
+	}
+	return r.repos.BandwidthRepo.ListQuotaEvents(ctx, resource)
+}
+
+func (r *queryResolver) ListQuarantineExemptions(ctx context.Context, refract string) ([]*model.QuarantineExemption, error) {
+	if err := r.authz.CanI(ctx, repo.ResourceRefraction, refract, rbac.Verb_SUDO); err != nil {
+		return nil, err
+	}
+	return r.repos.QuarantineRepo.ListExemptions(ctx, refract)
+}
+
+func (r *queryResolver) ListAffectedArtifacts(ctx context.Context, refract *string) ([]*model.AffectedArtifacts, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "graph_query_listAffectedArtifacts")
+	defer span.End()
+	if _, ok := client.GetContextUser(ctx); !ok {
+		return nil, errs.ErrUnauthorised
+	}
+	// scan the remotes of a single refraction,
+	// or everything if one isn't given
+	var remotes []*model.Remote
+	if refract != nil {
+		ref, err := r.repos.RefractRepo.GetRefraction(ctx, *refract)
+		if err != nil {
+			return nil, err
+		}
+		remotes = ref.Remotes
+	} else {
+		var err error
+		remotes, err = r.repos.RemoteRepo.ListRemotes(ctx, "", false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return advisory.Scan(ctx, r.repos, remotes)
+}
+
 func (r *queryResolver) ListUsers(ctx context.Context) ([]*model.StoredUser, error) {
 	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
 		return nil, err
@@ -331,6 +667,47 @@ func (r *queryResolver) ListUsers(ctx context.Context) ([]*model.StoredUser, err
 	return r.repos.UserRepo.List(ctx)
 }
 
+func (r *queryResolver) ListServiceAccounts(ctx context.Context) ([]*model.ServiceAccount, error) {
+	if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
+		return nil, err
+	}
+	return r.repos.TokenRepo.ListServiceAccounts(ctx)
+}
+
+func (r *queryResolver) ListAccessTokens(ctx context.Context, serviceAccount *string) ([]*model.AccessToken, error) {
+	user, ok := client.GetContextUser(ctx)
+	if !ok {
+		return nil, errs.ErrUnauthorised
+	}
+	iss, sub := user.Iss, user.Sub
+	if serviceAccount != nil && *serviceAccount != "" {
+		if err := r.authz.AmI(ctx, model.RoleSuper); err != nil {
+			return nil, err
+		}
+		sa, err := r.repos.TokenRepo.GetServiceAccount(ctx, *serviceAccount)
+		if err != nil {
+			return nil, err
+		}
+		iss, sub = tokens.IssuerServiceAccount, sa.Name
+	}
+	results, err := r.repos.TokenRepo.ListTokens(ctx, iss, sub)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*model.AccessToken, len(results))
+	for i := range results {
+		items[i] = &results[i].AccessToken
+	}
+	return items, nil
+}
+
+func (r *queryResolver) ListAuditEvents(ctx context.Context, filter *model.AuditFilter, offset int64, limit int64) (*model.AuditEventPage, error) {
+	if err := audit.CanView(ctx, r.authz); err != nil {
+		return nil, err
+	}
+	return r.repos.AuditRepo.ListEvents(ctx, filter, int(offset), int(limit))
+}
+
 func (r *queryResolver) GetCurrentUser(ctx context.Context) (*model.StoredUser, error) {
 	user, ok := client.GetContextUser(ctx)
 	if !ok {
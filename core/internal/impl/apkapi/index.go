@@ -0,0 +1,317 @@
+package apkapi
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	IndexName = "APKINDEX.tar.gz"
+
+	fileIndex       = "APKINDEX"
+	fileDescription = "DESCRIPTION"
+
+	// storePrefix is where we keep the last-known-good
+	// copy of each index in storage
+	storePrefix = ".refractions"
+)
+
+func NewProvider(ctx context.Context, repos *repo.Repos, store storage.Reader, opt Options) *Provider {
+	var secret []byte
+	if opt.KeySecret != "" {
+		secret = []byte(opt.KeySecret)
+	}
+	return &Provider{
+		repos:      repos,
+		store:      store,
+		secret:     secret,
+		trusted:    loadKeys(ctx, opt.TrustedKeys),
+		indexCache: ttlcache.New[string, []byte](ttlcache.WithCapacity[string, []byte](100), ttlcache.WithTTL[string, []byte](time.Minute*5)),
+	}
+}
+
+// IsIndex returns true if the path
+// refers to an APKINDEX.tar.gz
+func IsIndex(path string) bool {
+	return filepath.Base(path) == IndexName
+}
+
+// IsKey returns true if the path refers
+// to the public signing key.
+func IsKey(path string) bool {
+	return strings.TrimPrefix(path, "/") == KeyName
+}
+
+// Get returns the value of the given field.
+func (e *Entry) Get(key string) string {
+	for _, f := range e.Fields {
+		if f.Key == key {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// Bytes returns the APKINDEX file.
+func (idx *Index) Bytes() []byte {
+	buf := bytes.NewBuffer(nil)
+	for _, e := range idx.Entries {
+		for _, f := range e.Fields {
+			buf.WriteString(f.Key + ":" + f.Value + "\n")
+		}
+		buf.WriteString("\n")
+	}
+	return buf.Bytes()
+}
+
+// PublicKey returns the PEM-encoded public key
+// that clients use to verify the index.
+func (p *Provider) PublicKey(ctx context.Context) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_apk_publicKey")
+	defer span.End()
+	_, pub, err := p.getKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(pub), nil
+}
+
+// Index returns the APKINDEX.tar.gz at the given path after
+// merging it from every remote in the refraction. If none of
+// the remotes are reachable, the last-known-good copy is used.
+func (p *Provider) Index(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_apk_index", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("apk").WithValues("Path", path, "Refraction", ref.String())
+	log.Info("retrieving APKINDEX")
+	path = strings.TrimPrefix(path, "/")
+	// the merged index differs depending
+	// on what the user is able to see
+	key := filepath.Join(ref.String(), path)
+	if rctx != nil {
+		key += rctx.Token
+	}
+	if item := p.indexCache.Get(key); item != nil {
+		log.Info("found APKINDEX in cache")
+		return bytes.NewReader(item.Value()), nil
+	}
+	// only keep a copy of indices that
+	// every user is allowed to see
+	canStore := rctx == nil || rctx.Token == ""
+	storePath := filepath.Join(storePrefix, ref.String(), path)
+	data, err := p.fetch(ctx, ref, path, rctx)
+	if err != nil {
+		if !canStore {
+			return nil, err
+		}
+		log.Info("falling back to last-known-good APKINDEX")
+		r, _, serr := p.store.Get(ctx, storePath)
+		if serr != nil {
+			log.V(1).Info("unable to retrieve last-known-good APKINDEX", "Error", serr.Error())
+			return nil, err
+		}
+		span.SetAttributes(attribute.Bool("stale", true))
+		return r, nil
+	}
+	if canStore {
+		if err := p.store.Put(ctx, storePath, bytes.NewReader(data)); err != nil {
+			log.Error(err, "failed to save last-known-good APKINDEX")
+		}
+	}
+	p.indexCache.Set(key, data, ttlcache.DefaultTTL)
+	return bytes.NewReader(data), nil
+}
+
+func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) ([]byte, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_apk_fetch", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("apk").WithValues("Path", path, "Refraction", ref.String())
+	remotes := ref.Remotes()
+	// collect indices by position so that
+	// the merge is deterministic
+	indices := make([]*Index, len(remotes))
+
+	wg := sync.WaitGroup{}
+	log.Info("fetching APKINDEX from remotes", "Count", len(remotes))
+	for i := range remotes {
+		wg.Add(1)
+		j := i
+		go func() {
+			defer wg.Done()
+			// make sure to clone the request context
+			// otherwise remotes will overwrite each other
+			resp, err := remotes[j].Download(ctx, path, rctx.Clone())
+			if err != nil {
+				log.V(1).Info("skipping remote as APKINDEX could not be retrieved", "Remote", remotes[j].String(), "Error", err.Error())
+				return
+			}
+			defer resp.Close()
+			data, err := io.ReadAll(resp)
+			if err != nil {
+				log.Error(err, "failed to read APKINDEX", "Remote", remotes[j].String())
+				return
+			}
+			// we're about to re-sign the index, so we
+			// need to make sure that it can be trusted
+			if err := verify(p.trusted, data); err != nil {
+				log.Error(err, "skipping remote as APKINDEX could not be verified", "Remote", remotes[j].String())
+				return
+			}
+			idx, err := parseIndex(bytes.NewReader(data))
+			if err != nil {
+				log.Error(err, "failed to parse APKINDEX", "Remote", remotes[j].String())
+				return
+			}
+			indices[j] = idx
+		}()
+	}
+	// wait for all responses
+	wg.Wait()
+
+	merged := merge(indices)
+	if merged == nil {
+		log.Info("unable to locate APKINDEX in any remote")
+		return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+	}
+	span.SetAttributes(attribute.Int("packages", len(merged.Entries)))
+	key, _, err := p.getKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := sign(key, merged, time.Now())
+	if err != nil {
+		log.Error(err, "failed to sign APKINDEX")
+		return nil, err
+	}
+	return data, nil
+}
+
+// parseIndex reads the DESCRIPTION and APKINDEX files
+// from an APKINDEX.tar.gz. The signature is ignored since
+// it must already have been checked by verify.
+func parseIndex(r io.Reader) (*Index, error) {
+	// the index is made up of multiple gzip streams,
+	// which the reader handles transparently
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+	idx := &Index{}
+	found := false
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch hdr.Name {
+		case fileDescription:
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			idx.Description = string(data)
+		case fileIndex:
+			idx.Entries, err = parseEntries(tr)
+			if err != nil {
+				return nil, err
+			}
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("archive does not contain %s", fileIndex)
+	}
+	return idx, nil
+}
+
+func parseEntries(r io.Reader) ([]*Entry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var entries []*Entry
+	var current *Entry
+	for scanner.Scan() {
+		line := scanner.Text()
+		// a blank line ends the entry
+		if line == "" {
+			if current != nil {
+				entries = append(entries, current)
+				current = nil
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("malformed line: %q", line)
+		}
+		if current == nil {
+			current = &Entry{}
+		}
+		current.Fields = append(current.Fields, Field{Key: key, Value: value})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if current != nil {
+		entries = append(entries, current)
+	}
+	return entries, nil
+}
+
+// merge combines multiple indices. If more than one
+// remote provides the same version of a package, the
+// first remote wins. Returns nil if there are no
+// indices to merge.
+func merge(indices []*Index) *Index {
+	var result *Index
+	seen := map[string]struct{}{}
+	for _, idx := range indices {
+		if idx == nil {
+			continue
+		}
+		if result == nil {
+			result = &Index{Description: idx.Description}
+		}
+		for _, e := range idx.Entries {
+			key := fmt.Sprintf("%s/%s/%s", e.Get("P"), e.Get("V"), e.Get("A"))
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			result.Entries = append(result.Entries, e)
+		}
+	}
+	return result
+}
@@ -0,0 +1,168 @@
+package apkapi
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+const indexA = `C:Q1p78yvTLG094tHE1+dToJGbmYzQE=
+P:busybox
+V:1.36.1-r0
+A:x86_64
+S:505000
+T:Size optimized toolbox of many common UNIX utilities
+
+C:Q1c9xnC3bjmDkUq8MqaP5shXsmclg=
+P:curl
+V:8.1.2-r0
+A:x86_64
+S:155000
+D:ca-certificates-bundle so:libc.musl-x86_64.so.1
+
+`
+
+const indexB = `C:Q1c9xnC3bjmDkUq8MqaP5shXsmclg=
+P:curl
+V:8.1.2-r0
+A:x86_64
+S:155000
+D:ca-certificates-bundle so:libc.musl-x86_64.so.1
+
+C:Q1AAAAAAAAAAAAAAAAAAAAAAAAAAA=
+P:internal-tool
+V:1.0.0-r0
+A:x86_64
+S:1000
+
+`
+
+func TestMerge(t *testing.T) {
+	a, err := parseEntries(strings.NewReader(indexA))
+	assert.NoError(t, err)
+	b, err := parseEntries(strings.NewReader(indexB))
+	assert.NoError(t, err)
+
+	out := merge([]*Index{nil, {Description: "v3.18", Entries: a}, {Entries: b}})
+	assert.EqualValues(t, "v3.18", out.Description)
+	assert.Len(t, out.Entries, 3)
+	assert.EqualValues(t, "internal-tool", out.Entries[2].Get("P"))
+	assert.EqualValues(t, indexA, string((&Index{Entries: a}).Bytes()))
+
+	assert.Nil(t, merge([]*Index{nil}))
+}
+
+func TestSign(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	entries, err := parseEntries(strings.NewReader(indexA))
+	assert.NoError(t, err)
+
+	data, err := sign(key, &Index{Description: "v3.18", Entries: entries}, time.Now())
+	assert.NoError(t, err)
+
+	// make sure that we can read it back
+	idx, err := parseIndex(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.EqualValues(t, "v3.18", idx.Description)
+	assert.Len(t, idx.Entries, 2)
+
+	// read the signature from the first gzip stream
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+	gr.Multistream(false)
+	tr := tar.NewReader(gr)
+	hdr, err := tr.Next()
+	assert.NoError(t, err)
+	assert.EqualValues(t, ".SIGN.RSA."+KeyName, hdr.Name)
+	sig, err := io.ReadAll(tr)
+	assert.NoError(t, err)
+	// the signature segment must not be terminated
+	_, err = tr.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+	_, _ = io.Copy(io.Discard, gr)
+
+	// the remainder is the signed content
+	content := data[len(data)-remaining(t, data):]
+	digest := sha1.Sum(content)
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, digest[:], sig))
+}
+
+// remaining returns the length of the second gzip stream
+func remaining(t *testing.T, data []byte) int {
+	r := bytes.NewReader(data)
+	gr, err := gzip.NewReader(r)
+	assert.NoError(t, err)
+	gr.Multistream(false)
+	_, err = io.Copy(io.Discard, gr)
+	assert.NoError(t, err)
+	return r.Len()
+}
+
+func TestVerify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	entries, err := parseEntries(strings.NewReader(indexA))
+	assert.NoError(t, err)
+	idx := &Index{Description: "v3.18", Entries: entries}
+
+	data, err := sign(key, idx, time.Now())
+	assert.NoError(t, err)
+	unsigned, err := writeIndex(idx, time.Now())
+	assert.NoError(t, err)
+
+	var cases = []struct {
+		name string
+		keys map[string]*rsa.PublicKey
+		data []byte
+		err  error
+	}{
+		{"trusted key", map[string]*rsa.PublicKey{KeyName: &key.PublicKey}, data, nil},
+		{"wrong key", map[string]*rsa.PublicKey{KeyName: &other.PublicKey}, data, ErrUntrusted},
+		{"unknown key", map[string]*rsa.PublicKey{"alpine.rsa.pub": &key.PublicKey}, data, ErrUntrusted},
+		{"no keys", nil, data, ErrUntrusted},
+		{"unsigned", map[string]*rsa.PublicKey{KeyName: &key.PublicKey}, unsigned, ErrUntrusted},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, verify(tt.keys, tt.data), tt.err)
+		})
+	}
+	t.Run("tampered", func(t *testing.T) {
+		tampered, err := sign(key, &Index{Description: "v3.19", Entries: entries}, time.Now())
+		assert.NoError(t, err)
+		// keep the signature but swap the
+		// content for that of another index
+		sig := data[:len(data)-remaining(t, data)]
+		content := tampered[len(tampered)-remaining(t, tampered):]
+		tampered = append(append([]byte{}, sig...), content...)
+		assert.ErrorIs(t, verify(map[string]*rsa.PublicKey{KeyName: &key.PublicKey}, tampered), ErrUntrusted)
+	})
+}
+
+func TestSealKey(t *testing.T) {
+	secret := []byte("hunter2")
+	sealed, err := sealKey(secret, []byte("my private key"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, prefixSealed))
+	assert.NotContains(t, sealed, "my private key")
+
+	out, err := openKey(secret, sealed)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "my private key", string(out))
+
+	_, err = openKey([]byte("password"), sealed)
+	assert.Error(t, err)
+}
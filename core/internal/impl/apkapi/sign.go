@@ -0,0 +1,236 @@
+package apkapi
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"strings"
+	"time"
+)
+
+const (
+	// KeyName is the name that clients must save the
+	// public key as (i.e., /etc/apk/keys/prism.rsa.pub)
+	KeyName = "prism.rsa.pub"
+
+	keyBits = 4096
+
+	// prefixSealed marks a private key that
+	// has been encrypted by sealKey
+	prefixSealed = "aes-gcm:"
+)
+
+// ErrNoSecret is returned when the signing key is needed
+// but there is no secret to encrypt it with.
+var ErrNoSecret = errors.New("signing key secret has not been configured")
+
+// generateKey creates a new RSA key pair suitable for
+// signing an APKINDEX. The private key is encrypted
+// with the secret.
+func generateKey(secret []byte) (*schemas.SigningKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, keyBits)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := sealKey(secret, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	if err != nil {
+		return nil, err
+	}
+	return &schemas.SigningKey{
+		PrivateKey: priv,
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub})),
+	}, nil
+}
+
+func newGCM(secret []byte) (cipher.AEAD, error) {
+	key := sha256.Sum256(secret)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealKey encrypts the PEM-encoded private key
+// so that it can be saved in the database.
+func sealKey(secret, data []byte) (string, error) {
+	gcm, err := newGCM(secret)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return prefixSealed + base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, data, nil)), nil
+}
+
+// openKey decrypts a private key
+// that was encrypted by sealKey.
+func openKey(secret []byte, value string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, prefixSealed))
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(secret)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("signing key is too short")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+// getKey retrieves the signing key, creating
+// it if it doesn't exist yet.
+func (p *Provider) getKey(ctx context.Context) (*rsa.PrivateKey, []byte, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_apk_getKey")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("apk")
+	p.keyLock.Lock()
+	defer p.keyLock.Unlock()
+	if p.key != nil {
+		return p.key, p.pubKey, nil
+	}
+	// never save the private key in plaintext
+	if len(p.secret) == 0 {
+		log.Error(ErrNoSecret, "unable to retrieve signing key")
+		return nil, nil, ErrNoSecret
+	}
+	sk, err := p.repos.SigningKeyRepo.GetOrCreate(ctx, KeyName, func() (*schemas.SigningKey, error) {
+		return generateKey(p.secret)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	// keys saved by older versions are not
+	// encrypted, so we need to fix them
+	if !strings.HasPrefix(sk.PrivateKey, prefixSealed) {
+		log.Info("encrypting existing signing key")
+		sk.PrivateKey, err = sealKey(p.secret, []byte(sk.PrivateKey))
+		if err != nil {
+			log.Error(err, "failed to encrypt signing key")
+			return nil, nil, err
+		}
+		if err := p.repos.SigningKeyRepo.Update(ctx, sk); err != nil {
+			return nil, nil, err
+		}
+	}
+	priv, err := openKey(p.secret, sk.PrivateKey)
+	if err != nil {
+		log.Error(err, "failed to decrypt signing key")
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(priv)
+	if block == nil {
+		err = errors.New("signing key is not PEM-encoded")
+		log.Error(err, "failed to decode signing key")
+		return nil, nil, err
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		log.Error(err, "failed to parse signing key")
+		return nil, nil, err
+	}
+	p.key = key
+	p.pubKey = []byte(sk.PublicKey)
+	return p.key, p.pubKey, nil
+}
+
+// sign generates a signed APKINDEX.tar.gz. The result is
+// two concatenated gzip streams: the first contains the
+// signature of the second, which contains the index.
+//
+// https://wiki.alpinelinux.org/wiki/Apk_spec#APKINDEX_Format
+func sign(key *rsa.PrivateKey, idx *Index, now time.Time) ([]byte, error) {
+	content, err := writeIndex(idx, now)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha1.Sum(content)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    ".SIGN.RSA." + KeyName,
+		Mode:    0644,
+		Size:    int64(len(sig)),
+		ModTime: now,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(sig); err != nil {
+		return nil, err
+	}
+	// the signature segment must not contain the
+	// end-of-archive marker, so we flush rather
+	// than close the tar writer
+	if err := tw.Flush(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	buf.Write(content)
+	return buf.Bytes(), nil
+}
+
+// writeIndex generates the gzip-compressed tar
+// containing the DESCRIPTION and APKINDEX files.
+func writeIndex(idx *Index, now time.Time) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{fileDescription, []byte(idx.Description)},
+		{fileIndex, idx.Bytes()},
+	}
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    f.name,
+			Mode:    0644,
+			Size:    int64(len(f.data)),
+			ModTime: now,
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,53 @@
+package apkapi
+
+import (
+	"crypto/rsa"
+	"github.com/jellydator/ttlcache/v3"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"sync"
+)
+
+// Options configures how indices are verified and signed.
+type Options struct {
+	// KeySecret is used to encrypt the signing
+	// key before it is saved in the database.
+	KeySecret string `split_words:"true"`
+	// TrustedKeys is a directory containing the
+	// public keys that upstream indices must be
+	// signed with (e.g. /etc/apk/keys).
+	TrustedKeys string `split_words:"true"`
+}
+
+type Provider struct {
+	repos *repo.Repos
+	store storage.Reader
+
+	secret  []byte
+	trusted map[string]*rsa.PublicKey
+
+	// caches
+	indexCache *ttlcache.Cache[string, []byte]
+
+	keyLock sync.Mutex
+	key     *rsa.PrivateKey
+	pubKey  []byte
+}
+
+// Index is the content of an APKINDEX.tar.gz
+type Index struct {
+	Description string
+	Entries     []*Entry
+}
+
+// Entry is a single package in the APKINDEX.
+//
+// https://wiki.alpinelinux.org/wiki/Apk_spec#APKINDEX_Format
+type Entry struct {
+	Fields []Field
+}
+
+type Field struct {
+	Key   string
+	Value string
+}
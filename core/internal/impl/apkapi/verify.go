@@ -0,0 +1,147 @@
+package apkapi
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"github.com/go-logr/logr"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	prefixSignSHA1   = ".SIGN.RSA."
+	prefixSignSHA256 = ".SIGN.RSA256."
+)
+
+// ErrUntrusted is returned when an upstream index is not
+// signed by any of the trusted keys.
+var ErrUntrusted = errors.New("index is not signed by a trusted key")
+
+// loadKeys reads the PEM-encoded public keys in dir. Keys
+// are indexed by their file name since that is what the
+// signature refers to.
+func loadKeys(ctx context.Context, dir string) map[string]*rsa.PublicKey {
+	log := logr.FromContextOrDiscard(ctx).WithName("apk").WithValues("Dir", dir)
+	keys := map[string]*rsa.PublicKey{}
+	if dir == "" {
+		log.Info("no trusted keys have been configured, upstream indices will be rejected")
+		return keys
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		log.Error(err, "failed to read trusted keys")
+		return keys
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			log.Error(err, "failed to read trusted key", "Name", f.Name())
+			continue
+		}
+		key, err := parsePublicKey(data)
+		if err != nil {
+			log.Error(err, "failed to parse trusted key", "Name", f.Name())
+			continue
+		}
+		keys[f.Name()] = key
+	}
+	log.Info("loaded trusted keys", "Count", len(keys))
+	return keys
+}
+
+func parsePublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("public key is not PEM-encoded")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an RSA key")
+	}
+	return key, nil
+}
+
+// verify checks that the first gzip stream of an
+// APKINDEX.tar.gz contains a signature of the second
+// stream made by one of the trusted keys.
+func verify(keys map[string]*rsa.PublicKey, data []byte) error {
+	r := bytes.NewReader(data)
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	gr.Multistream(false)
+	type signature struct {
+		key  *rsa.PublicKey
+		hash crypto.Hash
+		sig  []byte
+	}
+	var sigs []signature
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		var name string
+		var hash crypto.Hash
+		switch {
+		case strings.HasPrefix(hdr.Name, prefixSignSHA256):
+			name, hash = strings.TrimPrefix(hdr.Name, prefixSignSHA256), crypto.SHA256
+		case strings.HasPrefix(hdr.Name, prefixSignSHA1):
+			name, hash = strings.TrimPrefix(hdr.Name, prefixSignSHA1), crypto.SHA1
+		default:
+			continue
+		}
+		key, ok := keys[name]
+		if !ok {
+			continue
+		}
+		sig, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		sigs = append(sigs, signature{key: key, hash: hash, sig: sig})
+	}
+	// consume the rest of the signature stream
+	// so that we know where the content starts
+	if _, err := io.Copy(io.Discard, gr); err != nil {
+		return err
+	}
+	content := data[len(data)-r.Len():]
+	for _, s := range sigs {
+		var digest []byte
+		if s.hash == crypto.SHA256 {
+			sum := sha256.Sum256(content)
+			digest = sum[:]
+		} else {
+			sum := sha1.Sum(content)
+			digest = sum[:]
+		}
+		if rsa.VerifyPKCS1v15(s.key, s.hash, digest, s.sig) == nil {
+			return nil
+		}
+	}
+	return ErrUntrusted
+}
@@ -0,0 +1,343 @@
+package cargoapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const ConfigName = "config.json"
+
+var (
+	regexIndex    = regexp.MustCompile(`^(1/[^/]+|2/[^/]+|3/[^/]/[^/]+|[^/]{2}/[^/]{2}/[^/]+)$`)
+	regexDownload = regexp.MustCompile(`^crates/([^/]+)/([^/]+)/download$`)
+	// dlMarkers are the template markers that
+	// can be used in the dl field of config.json
+	dlMarkers = []string{"{crate}", "{version}", "{prefix}", "{lowerprefix}", "{sha256-checksum}"}
+)
+
+func NewProvider(publicURL string) *Provider {
+	return &Provider{
+		publicURL:   publicURL,
+		configCache: ttlcache.New[string, *Config](ttlcache.WithCapacity[string, *Config](100), ttlcache.WithTTL[string, *Config](time.Minute*5)),
+		originCache: ttlcache.New[string, *Origin](ttlcache.WithCapacity[string, *Origin](10000), ttlcache.WithTTL[string, *Origin](time.Hour)),
+	}
+}
+
+// IsDownload returns true if the path refers
+// to a .crate download.
+func IsDownload(path string) bool {
+	return regexDownload.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// prefix returns the index directory of a crate
+//
+// https://doc.rust-lang.org/cargo/reference/registry-index.html#index-files
+func prefix(name string) string {
+	switch len(name) {
+	case 1:
+		return "1"
+	case 2:
+		return "2"
+	case 3:
+		return "3/" + name[:1]
+	default:
+		return name[:2] + "/" + name[2:4]
+	}
+}
+
+// downloadURL expands the dl template for
+// the given crate.
+func (c *Config) downloadURL(crate, version, cksum string) string {
+	hasMarker := false
+	for _, m := range dlMarkers {
+		if strings.Contains(c.DL, m) {
+			hasMarker = true
+			break
+		}
+	}
+	// if there are no markers, the path
+	// is appended to the URL
+	if !hasMarker {
+		return fmt.Sprintf("%s/%s/%s/download", strings.TrimSuffix(c.DL, "/"), crate, version)
+	}
+	return strings.NewReplacer(
+		"{crate}", crate,
+		"{version}", version,
+		"{prefix}", prefix(crate),
+		"{lowerprefix}", strings.ToLower(prefix(crate)),
+		"{sha256-checksum}", cksum,
+	).Replace(c.DL)
+}
+
+func originKey(ref *refract.Refraction, name, version string) string {
+	return filepath.Join(ref.String(), strings.ToLower(name), version)
+}
+
+// Config returns the config.json of the refraction
+// with the dl and api fields pointing to Prism.
+func (p *Provider) Config(ctx context.Context, ref *refract.Refraction, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_cargo_config", trace.WithAttributes(
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("cargo").WithValues("Refraction", ref.String())
+	log.Info("generating Cargo config")
+	remotes := ref.Remotes()
+	configs := make([]*Config, len(remotes))
+	wg := sync.WaitGroup{}
+	for i := range remotes {
+		wg.Add(1)
+		j := i
+		go func() {
+			defer wg.Done()
+			// make sure to clone the request context
+			// otherwise remotes will overwrite each other
+			cfg, err := p.getConfig(ctx, remotes[j], rctx.Clone())
+			if err != nil {
+				return
+			}
+			configs[j] = cfg
+		}()
+	}
+	wg.Wait()
+
+	url := fmt.Sprintf("%s/api/cargo/%s", strings.TrimSuffix(p.publicURL, "/"), ref.String())
+	cfg := &Config{
+		DL:  url + "/crates",
+		API: url,
+	}
+	// if any of the remotes require
+	// authentication, so do we
+	for _, c := range configs {
+		if c != nil && c.AuthRequired {
+			cfg.AuthRequired = true
+		}
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		log.Error(err, "failed to marshal config")
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// getConfig retrieves the config.json
+// of a remote.
+func (p *Provider) getConfig(ctx context.Context, rm remote.Remote, rctx *schemas.RequestContext) (*Config, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_cargo_getConfig")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("cargo").WithValues("Remote", rm.String())
+	item := p.configCache.Get(rm.String())
+	if item != nil {
+		log.V(1).Info("found remote config in cache")
+		return item.Value(), nil
+	}
+	resp, err := rm.Download(ctx, ConfigName, rctx)
+	if err != nil {
+		log.V(1).Info("failed to retrieve remote config", "Error", err.Error())
+		return nil, err
+	}
+	defer resp.Close()
+	var cfg Config
+	if err := json.NewDecoder(resp).Decode(&cfg); err != nil {
+		log.Error(err, "failed to parse remote config")
+		return nil, err
+	}
+	p.configCache.Set(rm.String(), &cfg, ttlcache.DefaultTTL)
+	return &cfg, nil
+}
+
+// Index returns the index file of a crate after
+// merging it from every remote in the refraction.
+func (p *Provider) Index(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_cargo_index", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("cargo").WithValues("Path", path, "Refraction", ref.String())
+	path = strings.TrimPrefix(path, "/")
+	if !regexIndex.MatchString(path) {
+		log.V(1).Info("rejecting request as path is not an index file")
+		return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+	}
+	log.Info("retrieving Cargo index")
+	remotes := ref.Remotes()
+	// collect responses by index so that
+	// the merge is deterministic
+	files := make([][]byte, len(remotes))
+	found := false
+
+	s := &sync.Mutex{}
+	wg := sync.WaitGroup{}
+	log.Info("fetching Cargo index from remotes", "Count", len(remotes))
+	for i := range remotes {
+		wg.Add(1)
+		j := i
+		go func() {
+			defer wg.Done()
+			// make sure to clone the request context
+			// otherwise remotes will overwrite each other
+			resp, err := remotes[j].Download(ctx, path, rctx.Clone())
+			if err != nil {
+				log.V(1).Info("skipping remote as index could not be retrieved", "Remote", remotes[j].String(), "Error", err.Error())
+				return
+			}
+			defer resp.Close()
+			data, err := io.ReadAll(resp)
+			if err != nil {
+				log.Error(err, "failed to read index", "Remote", remotes[j].String())
+				return
+			}
+			s.Lock()
+			files[j] = data
+			found = true
+			s.Unlock()
+		}()
+	}
+	// wait for all responses
+	wg.Wait()
+
+	if !found {
+		log.Info("unable to locate crate in any remote")
+		return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+	}
+	entries, origins := mergeIndex(remotes, files)
+	for _, o := range origins {
+		p.originCache.Set(originKey(ref, o.name, o.version), o.origin, ttlcache.DefaultTTL)
+	}
+	span.SetAttributes(attribute.Int("versions", len(entries)))
+	buf := bytes.NewBuffer(nil)
+	for _, e := range entries {
+		buf.Write(e)
+		buf.WriteString("\n")
+	}
+	return bytes.NewReader(buf.Bytes()), nil
+}
+
+type mergedOrigin struct {
+	name    string
+	version string
+	origin  *Origin
+}
+
+// mergeIndex combines the index files of multiple
+// remotes. If more than one remote provides the same
+// version of a crate, the first remote wins.
+func mergeIndex(remotes []remote.Remote, files [][]byte) ([][]byte, []mergedOrigin) {
+	var entries [][]byte
+	var origins []mergedOrigin
+	seen := map[string]struct{}{}
+	for i, data := range files {
+		if data == nil {
+			continue
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		// lines with many features or
+		// dependencies can be quite long
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+			var e Entry
+			if err := json.Unmarshal(line, &e); err != nil || e.Vers == "" {
+				continue
+			}
+			if _, ok := seen[e.Vers]; ok {
+				continue
+			}
+			seen[e.Vers] = struct{}{}
+			entries = append(entries, append([]byte(nil), line...))
+			origins = append(origins, mergedOrigin{
+				name:    e.Name,
+				version: e.Vers,
+				origin: &Origin{
+					Remote: remotes[i].String(),
+					Cksum:  e.Cksum,
+				},
+			})
+		}
+	}
+	return entries, origins
+}
+
+// Download retrieves a .crate file from the remote
+// that provided it in the index.
+func (p *Provider) Download(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_cargo_download", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("cargo").WithValues("Path", path, "Refraction", ref.String())
+	match := regexDownload.FindStringSubmatch(strings.TrimPrefix(path, "/"))
+	if match == nil {
+		return nil, problem.Errorf(http.StatusBadRequest, "malformed path")
+	}
+	crate, version := match[1], match[2]
+	log = log.WithValues("Crate", crate, "Version", version)
+
+	// if we know where the crate came from, only
+	// download it from there. Otherwise, the checksum
+	// recorded in the index may not match.
+	candidates := ref.Remotes()
+	var cksum string
+	if item := p.originCache.Get(originKey(ref, crate, version)); item != nil {
+		origin := item.Value()
+		cksum = origin.Cksum
+		for _, rm := range candidates {
+			if rm.String() == origin.Remote {
+				log.V(1).Info("located origin of crate", "Remote", origin.Remote)
+				candidates = []remote.Remote{rm}
+				break
+			}
+		}
+	}
+	for _, rm := range candidates {
+		cfg, err := p.getConfig(ctx, rm, rctx.Clone())
+		if err != nil {
+			continue
+		}
+		if cksum == "" && strings.Contains(cfg.DL, "{sha256-checksum}") {
+			log.V(1).Info("skipping remote as the checksum is required but unknown", "Remote", rm.String())
+			continue
+		}
+		target := cfg.downloadURL(crate, version, cksum)
+		// make sure that the firewall and advisories
+		// of the remote are applied to the crate
+		if _, err := rm.Exists(ctx, target, rctx.Clone()); err != nil {
+			log.V(1).Info("crate was rejected by remote", "Remote", rm.String(), "Error", err.Error())
+			continue
+		}
+		log.V(1).Info("downloading crate from remote", "Remote", rm.String(), "Target", target)
+		r, err := rm.Download(ctx, target, rctx.Clone())
+		if err != nil {
+			continue
+		}
+		return r, nil
+	}
+	log.Info("unable to locate crate in any remote")
+	return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+}
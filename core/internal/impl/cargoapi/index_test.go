@@ -0,0 +1,70 @@
+package cargoapi
+
+import (
+	"context"
+	_ "embed"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"testing"
+)
+
+//go:embed testdata/serde-crates.io
+var cratesIndex []byte
+
+//go:embed testdata/serde-internal
+var internalIndex []byte
+
+func TestConfig_downloadURL(t *testing.T) {
+	var cases = []struct {
+		dl    string
+		crate string
+		out   string
+	}{
+		{
+			"https://static.crates.io/crates",
+			"serde",
+			"https://static.crates.io/crates/serde/1.0.0/download",
+		},
+		{
+			"https://example.org/api/v1/crates/{crate}/{version}/download",
+			"serde",
+			"https://example.org/api/v1/crates/serde/1.0.0/download",
+		},
+		{
+			"https://example.org/{lowerprefix}/{crate}/{sha256-checksum}.crate",
+			"Serde",
+			"https://example.org/se/rd/Serde/abc.crate",
+		},
+		{
+			"https://example.org/{prefix}/{crate}",
+			"syn",
+			"https://example.org/3/s/syn",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.dl, func(t *testing.T) {
+			cfg := &Config{DL: tt.dl}
+			assert.EqualValues(t, tt.out, cfg.downloadURL(tt.crate, "1.0.0", "abc"))
+		})
+	}
+}
+
+func TestIsDownload(t *testing.T) {
+	assert.True(t, IsDownload("crates/serde/1.0.0/download"))
+	assert.False(t, IsDownload("se/rd/serde"))
+	assert.False(t, IsDownload(ConfigName))
+}
+
+func TestMergeIndex(t *testing.T) {
+	remotes := []remote.Remote{
+		remote.NewEphemeralRemote(context.TODO(), "https://index.crates.io", nil),
+		remote.NewEphemeralRemote(context.TODO(), "https://cargo.example.org", nil),
+	}
+	entries, origins := mergeIndex(remotes, [][]byte{cratesIndex, internalIndex})
+	assert.Len(t, entries, 3)
+	assert.Len(t, origins, 3)
+	// the first remote wins
+	assert.EqualValues(t, "1.0.1", origins[1].version)
+	assert.EqualValues(t, "https://index.crates.io", origins[1].origin.Remote)
+	assert.EqualValues(t, "https://cargo.example.org", origins[2].origin.Remote)
+}
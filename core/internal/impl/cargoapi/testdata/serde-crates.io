@@ -0,0 +1,2 @@
+{"name":"serde","vers":"1.0.0","deps":[],"cksum":"c4a8af2b6ea6f0b0f2ab8b8a0b9f4a3b1c2c1b5b1f9a2b4f8c6a3d1e0f9b8a7c","features":{},"yanked":false}
+{"name":"serde","vers":"1.0.1","deps":[],"cksum":"a1c2b3d4e5f60718293a4b5c6d7e8f90a1c2b3d4e5f60718293a4b5c6d7e8f90","features":{},"yanked":false}
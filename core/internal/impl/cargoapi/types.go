@@ -0,0 +1,40 @@
+package cargoapi
+
+import (
+	"github.com/jellydator/ttlcache/v3"
+)
+
+type Provider struct {
+	publicURL string
+
+	// caches
+	configCache *ttlcache.Cache[string, *Config]
+	originCache *ttlcache.Cache[string, *Origin]
+}
+
+// Config is the config.json file at the
+// root of a sparse index.
+//
+// https://doc.rust-lang.org/cargo/reference/registry-index.html#index-configuration
+type Config struct {
+	DL           string `json:"dl"`
+	API          string `json:"api,omitempty"`
+	AuthRequired bool   `json:"auth-required,omitempty"`
+}
+
+// Entry contains the fields of an index
+// line that we need in order to merge
+// and download crates.
+type Entry struct {
+	Name  string `json:"name"`
+	Vers  string `json:"vers"`
+	Cksum string `json:"cksum"`
+}
+
+// Origin records which remote provided
+// a specific version of a crate.
+type Origin struct {
+	// Remote is the URI of the remote
+	Remote string
+	Cksum  string
+}
@@ -0,0 +1,101 @@
+package debapi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Get returns the value of the named field.
+// Field names are case-insensitive.
+func (p *Paragraph) Get(name string) string {
+	for _, f := range p.Fields {
+		if strings.EqualFold(f.Name, name) {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// Set replaces the value of the named field,
+// or appends it if it doesn't exist.
+func (p *Paragraph) Set(name, value string) {
+	for i := range p.Fields {
+		if strings.EqualFold(p.Fields[i].Name, name) {
+			p.Fields[i].Value = value
+			return
+		}
+	}
+	p.Fields = append(p.Fields, Field{Name: name, Value: value})
+}
+
+// ParseControl reads all paragraphs from
+// a deb822-style control file (e.g., Release
+// or Packages).
+func ParseControl(r io.Reader) ([]*Paragraph, error) {
+	scanner := bufio.NewScanner(r)
+	// some fields (e.g., Depends) can be
+	// much longer than the default buffer
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var paragraphs []*Paragraph
+	var current *Paragraph
+	for scanner.Scan() {
+		line := scanner.Text()
+		// a blank line ends the paragraph
+		if strings.TrimSpace(line) == "" {
+			if current != nil {
+				paragraphs = append(paragraphs, current)
+				current = nil
+			}
+			continue
+		}
+		// continuation of the previous field
+		if line[0] == ' ' || line[0] == '\t' {
+			if current == nil || len(current.Fields) == 0 {
+				return nil, fmt.Errorf("unexpected continuation line: %q", line)
+			}
+			f := &current.Fields[len(current.Fields)-1]
+			f.Value += "\n" + line
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("malformed field: %q", line)
+		}
+		if current == nil {
+			current = &Paragraph{}
+		}
+		current.Fields = append(current.Fields, Field{
+			Name:  name,
+			Value: strings.TrimSpace(value),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if current != nil {
+		paragraphs = append(paragraphs, current)
+	}
+	return paragraphs, nil
+}
+
+// WriteControl writes the paragraphs in
+// the deb822 format.
+func WriteControl(w io.Writer, paragraphs []*Paragraph) error {
+	bw := bufio.NewWriter(w)
+	for i, p := range paragraphs {
+		if i > 0 {
+			_, _ = bw.WriteString("\n")
+		}
+		for _, f := range p.Fields {
+			_, _ = bw.WriteString(f.Name + ":")
+			if !strings.HasPrefix(f.Value, "\n") {
+				_, _ = bw.WriteString(" ")
+			}
+			_, _ = bw.WriteString(f.Value + "\n")
+		}
+	}
+	return bw.Flush()
+}
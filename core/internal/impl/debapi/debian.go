@@ -0,0 +1,510 @@
+package debapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/djcass44/go-utils/utilities/sliceutils"
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"github.com/xi2/xz"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// PoolPrefix is prepended to the Filename of
+	// every package along with the remote and suite
+	// that listed it, so that downloads are routed
+	// back to the remote and can be checked against
+	// the index.
+	PoolPrefix = "prism"
+
+	fileRelease    = "Release"
+	fileInRelease  = "InRelease"
+	fileReleaseGPG = "Release.gpg"
+	filePackages   = "Packages"
+)
+
+// ErrChecksum is returned when an index from upstream
+// doesn't match the hashes in its Release file.
+var ErrChecksum = errors.New("index does not match checksum")
+
+var regexPackages = regexp.MustCompile(`^(.+/binary-[^/]+)/Packages(\.gz|\.xz)?$`)
+
+// releaseFields are copied from the upstream
+// Release file into the one that we generate.
+var releaseFields = []string{"Origin", "Label", "Suite", "Version", "Codename", "Description"}
+
+func NewProvider() *Provider {
+	return &Provider{
+		suiteCache: ttlcache.New[string, *Suite](ttlcache.WithCapacity[string, *Suite](100), ttlcache.WithTTL[string, *Suite](time.Minute*5)),
+	}
+}
+
+// IsIndex returns true if the path refers to
+// one of the index files that Prism generates.
+func IsIndex(path string) bool {
+	_, file, ok := splitSuite(path)
+	if !ok {
+		return false
+	}
+	switch file {
+	case fileRelease, fileInRelease, fileReleaseGPG:
+		return true
+	}
+	return regexPackages.MatchString(file)
+}
+
+// IsPool returns true if the path refers to a
+// package that we rewrote the Filename of.
+func IsPool(path string) bool {
+	return strings.HasPrefix(strings.TrimPrefix(path, "/"), PoolPrefix+"/")
+}
+
+// splitSuite extracts the suite and the path
+// relative to it from dists/<suite>/<file>
+func splitSuite(path string) (string, string, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(parts) != 3 || parts[0] != "dists" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// remoteID returns a short, URL-safe identifier
+// for the remote.
+func remoteID(r remote.Remote) string {
+	sum := sha256.Sum256([]byte(r.String()))
+	return hex.EncodeToString(sum[:4])
+}
+
+// Index serves the Release and Packages indices of
+// a suite after merging them from every remote in
+// the refraction.
+func (p *Provider) Index(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_debian_index", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("debian").WithValues("Path", path, "Refraction", ref.String())
+	suite, file, ok := splitSuite(path)
+	if !ok {
+		return nil, problem.Errorf(http.StatusBadRequest, "malformed path")
+	}
+	switch file {
+	case fileInRelease, fileReleaseGPG:
+		// the indices are rewritten, so the
+		// upstream signatures no longer match
+		log.V(1).Info("rejecting request for signed index")
+		return nil, problem.Errorf(http.StatusNotFound, "signed indices are not available")
+	}
+	s, err := p.getSuite(ctx, ref, suite, rctx)
+	if err != nil {
+		return nil, err
+	}
+	if file == fileRelease {
+		return bytes.NewReader(s.Release), nil
+	}
+	data, ok := s.Files[file]
+	if !ok {
+		log.V(1).Info("requested index is not part of the suite")
+		return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+	}
+	return bytes.NewReader(data), nil
+}
+
+// Pool downloads a package from the remote
+// that listed it in its Packages index.
+//
+// The package is checked against the SHA256 sum
+// from the index of the suite that listed it. The
+// suite is regenerated from the upstream indices if
+// it isn't cached, so any replica is able to verify
+// the package.
+func (p *Provider) Pool(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_debian_pool", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("debian").WithValues("Path", path, "Refraction", ref.String())
+	// the path is formatted as: prism/<remote>/<suite>/<filename>
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimPrefix(path, "/"), PoolPrefix+"/"), "/", 3)
+	if len(parts) != 3 {
+		return nil, problem.Errorf(http.StatusBadRequest, "malformed path")
+	}
+	for _, rm := range ref.Remotes() {
+		if remoteID(rm) != parts[0] {
+			continue
+		}
+		// make sure that the firewall and advisories
+		// of the remote are applied to the package
+		if _, err := rm.Exists(ctx, parts[2], rctx.Clone()); err != nil {
+			log.V(1).Info("package was rejected by remote", "Remote", rm.String(), "Error", err.Error())
+			return nil, err
+		}
+		target := parts[2]
+		if sum := p.digest(ctx, ref, parts[1], strings.TrimPrefix(path, "/"), rctx); sum != "" {
+			target += "#sha256=" + sum
+		} else {
+			log.V(1).Info("unable to verify package as its digest is unknown")
+		}
+		log.V(1).Info("downloading package from remote", "Remote", rm.String())
+		return rm.Download(ctx, target, rctx)
+	}
+	log.Info("unable to locate remote for package", "Id", parts[0])
+	return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+}
+
+// digest returns the SHA256 sum of a package
+// from the index of the suite that listed it.
+func (p *Provider) digest(ctx context.Context, ref *refract.Refraction, suite, filename string, rctx *schemas.RequestContext) string {
+	s, err := p.getSuite(ctx, ref, suite, rctx)
+	if err != nil {
+		return ""
+	}
+	return s.Digests[filename]
+}
+
+// getSuite retrieves the suite from the cache
+// or generates it if it doesn't exist. All indices
+// are generated at once so that the hashes in the
+// Release file always match the Packages files.
+func (p *Provider) getSuite(ctx context.Context, ref *refract.Refraction, suite string, rctx *schemas.RequestContext) (*Suite, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Suite", suite)
+	// the generated suite differs depending
+	// on what the user is able to see
+	key := ref.String() + "/" + suite
+	if rctx != nil {
+		key += rctx.Token
+	}
+	item := p.suiteCache.Get(key)
+	if item != nil {
+		log.V(1).Info("found suite in cache")
+		return item.Value(), nil
+	}
+	s, err := p.fetch(ctx, ref, suite, rctx)
+	if err != nil {
+		return nil, err
+	}
+	p.suiteCache.Set(key, s, ttlcache.DefaultTTL)
+	return s, nil
+}
+
+func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, suite string, rctx *schemas.RequestContext) (*Suite, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_debian_fetch", trace.WithAttributes(
+		attribute.String("suite", suite),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("debian").WithValues("Suite", suite, "Refraction", ref.String())
+	remotes := ref.Remotes()
+
+	// collect the Release file of each remote
+	releases := make([]*Paragraph, len(remotes))
+	wg := sync.WaitGroup{}
+	log.Info("fetching Release files from remotes", "Count", len(remotes))
+	for i := range remotes {
+		wg.Add(1)
+		j := i
+		go func() {
+			defer wg.Done()
+			// make sure to clone the request context
+			// otherwise remotes will overwrite each other
+			resp, err := remotes[j].Download(ctx, fmt.Sprintf("dists/%s/%s", suite, fileRelease), rctx.Clone())
+			if err != nil {
+				log.V(1).Info("skipping remote as Release could not be retrieved", "Remote", remotes[j].String(), "Error", err.Error())
+				return
+			}
+			defer resp.Close()
+			paragraphs, err := ParseControl(resp)
+			if err != nil || len(paragraphs) == 0 {
+				log.V(1).Info("skipping remote as Release could not be parsed", "Remote", remotes[j].String())
+				return
+			}
+			releases[j] = paragraphs[0]
+		}()
+	}
+	wg.Wait()
+
+	// figure out which Packages indices
+	// each remote provides
+	var dirs []string
+	owners := map[string][]int{}
+	for i, r := range releases {
+		if r == nil {
+			continue
+		}
+		for _, dir := range packageDirs(r) {
+			if _, ok := owners[dir]; !ok {
+				dirs = append(dirs, dir)
+			}
+			owners[dir] = append(owners[dir], i)
+		}
+	}
+	if len(owners) == 0 {
+		log.Info("unable to locate suite in any remote")
+		return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+	}
+	span.SetAttributes(attribute.Int("indices", len(dirs)))
+
+	// collect the Packages indices
+	s := &sync.Mutex{}
+	packages := map[string][][]*Paragraph{}
+	digests := map[string]string{}
+	for _, dir := range dirs {
+		packages[dir] = make([][]*Paragraph, len(remotes))
+		for _, i := range owners[dir] {
+			wg.Add(1)
+			d, j := dir, i
+			go func() {
+				defer wg.Done()
+				items, err := p.fetchPackages(ctx, remotes[j], suite, d, releaseHashes(releases[j]), rctx.Clone())
+				if err != nil {
+					log.V(1).Info("skipping remote as Packages could not be retrieved", "Remote", remotes[j].String(), "Dir", d, "Error", err.Error())
+					return
+				}
+				// rewrite the Filename so that we know
+				// where to download the package from
+				id := remoteID(remotes[j])
+				for _, item := range items {
+					if filename := item.Get("Filename"); filename != "" {
+						filename = fmt.Sprintf("%s/%s/%s/%s", PoolPrefix, id, suite, strings.TrimPrefix(filename, "/"))
+						item.Set("Filename", filename)
+					}
+				}
+				s.Lock()
+				packages[d][j] = items
+				for _, item := range items {
+					if sum := item.Get("SHA256"); sum != "" {
+						digests[item.Get("Filename")] = strings.ToLower(sum)
+					}
+				}
+				s.Unlock()
+			}()
+		}
+	}
+	wg.Wait()
+
+	// generate the indices
+	files := map[string][]byte{}
+	for _, dir := range dirs {
+		buf := bytes.NewBuffer(nil)
+		if err := WriteControl(buf, mergePackages(packages[dir])); err != nil {
+			log.Error(err, "failed to write Packages index", "Dir", dir)
+			return nil, err
+		}
+		compressed := bytes.NewBuffer(nil)
+		gw := gzip.NewWriter(compressed)
+		_, _ = gw.Write(buf.Bytes())
+		if err := gw.Close(); err != nil {
+			log.Error(err, "failed to compress Packages index", "Dir", dir)
+			return nil, err
+		}
+		files[dir+"/"+filePackages] = buf.Bytes()
+		files[dir+"/"+filePackages+".gz"] = compressed.Bytes()
+		files[dir+"/"+filePackages+".xz"] = writeXZ(buf.Bytes())
+	}
+	release := bytes.NewBuffer(nil)
+	if err := WriteControl(release, []*Paragraph{mergeRelease(releases, files, time.Now())}); err != nil {
+		log.Error(err, "failed to write Release")
+		return nil, err
+	}
+	return &Suite{
+		Release: release.Bytes(),
+		Files:   files,
+		Digests: digests,
+	}, nil
+}
+
+// fetchPackages downloads the Packages index from the given
+// directory, preferring compressed variants. Only variants
+// whose SHA256 sum is listed in the Release file are used,
+// and they must match it.
+func (*Provider) fetchPackages(ctx context.Context, rm remote.Remote, suite, dir string, sums map[string]string, rctx *schemas.RequestContext) ([]*Paragraph, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_debian_fetchPackages", trace.WithAttributes(
+		attribute.String("dir", dir),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Dir", dir, "Remote", rm.String())
+	lastErr := fmt.Errorf("%w: %s is not listed in Release", ErrChecksum, dir)
+	for _, ext := range []string{".xz", ".gz", ""} {
+		name := dir + "/" + filePackages + ext
+		want, ok := sums[name]
+		if !ok {
+			continue
+		}
+		data, err := download(ctx, rm, fmt.Sprintf("dists/%s/%s", suite, name), rctx)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != want {
+			log.Info("rejecting Packages index as it does not match the Release file", "File", name)
+			return nil, fmt.Errorf("%w: %s", ErrChecksum, name)
+		}
+		var r io.Reader
+		switch ext {
+		case ".xz":
+			r, err = xz.NewReader(bytes.NewReader(data), 0)
+		case ".gz":
+			r, err = gzip.NewReader(bytes.NewReader(data))
+		default:
+			r = bytes.NewReader(data)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ParseControl(r)
+	}
+	return nil, lastErr
+}
+
+func download(ctx context.Context, rm remote.Remote, path string, rctx *schemas.RequestContext) ([]byte, error) {
+	resp, err := rm.Download(ctx, path, rctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	return io.ReadAll(resp)
+}
+
+// releaseHashes returns the SHA256 sums listed
+// in a Release file, keyed by their path.
+func releaseHashes(release *Paragraph) map[string]string {
+	sums := map[string]string{}
+	for _, line := range strings.Split(release.Get("SHA256"), "\n") {
+		// each line is formatted as: <hash> <size> <path>
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		sums[fields[2]] = strings.ToLower(fields[0])
+	}
+	return sums
+}
+
+// packageDirs returns the directories containing
+// Packages indices listed in the Release file.
+func packageDirs(release *Paragraph) []string {
+	hashes := release.Get("SHA256")
+	if hashes == "" {
+		hashes = release.Get("MD5Sum")
+	}
+	var dirs []string
+	seen := map[string]struct{}{}
+	for _, line := range strings.Split(hashes, "\n") {
+		// each line is formatted as: <hash> <size> <path>
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		match := regexPackages.FindStringSubmatch(fields[2])
+		if match == nil {
+			continue
+		}
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+		seen[match[1]] = struct{}{}
+		dirs = append(dirs, match[1])
+	}
+	return dirs
+}
+
+// mergePackages combines the Packages indices of
+// multiple remotes. If more than one remote provides
+// the same version of a package, the first remote wins.
+func mergePackages(indices [][]*Paragraph) []*Paragraph {
+	var result []*Paragraph
+	seen := map[string]struct{}{}
+	for _, items := range indices {
+		for _, item := range items {
+			key := fmt.Sprintf("%s/%s/%s", item.Get("Package"), item.Get("Version"), item.Get("Architecture"))
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// mergeRelease generates a Release file that describes
+// the given indices. Descriptive fields are taken from the
+// first remote that provides them, and the architectures
+// and components are the union of all remotes.
+func mergeRelease(releases []*Paragraph, files map[string][]byte, now time.Time) *Paragraph {
+	result := &Paragraph{}
+	for _, name := range releaseFields {
+		for _, r := range releases {
+			if r == nil {
+				continue
+			}
+			if val := r.Get(name); val != "" {
+				result.Set(name, val)
+				break
+			}
+		}
+	}
+	result.Set("Date", now.UTC().Format(time.RFC1123))
+	var archs, components []string
+	for _, r := range releases {
+		if r == nil {
+			continue
+		}
+		archs = appendUnique(archs, strings.Fields(r.Get("Architectures"))...)
+		components = appendUnique(components, strings.Fields(r.Get("Components"))...)
+	}
+	result.Set("Architectures", strings.Join(archs, " "))
+	result.Set("Components", strings.Join(components, " "))
+
+	names := make([]string, 0, len(files))
+	for k := range files {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	md5Sums := strings.Builder{}
+	sha256Sums := strings.Builder{}
+	for _, name := range names {
+		data := files[name]
+		m := md5.Sum(data)
+		s := sha256.Sum256(data)
+		_, _ = fmt.Fprintf(&md5Sums, "\n %s %d %s", hex.EncodeToString(m[:]), len(data), name)
+		_, _ = fmt.Fprintf(&sha256Sums, "\n %s %d %s", hex.EncodeToString(s[:]), len(data), name)
+	}
+	result.Set("MD5Sum", md5Sums.String())
+	result.Set("SHA256", sha256Sums.String())
+	return result
+}
+
+func appendUnique(s []string, items ...string) []string {
+	for _, item := range items {
+		if !sliceutils.Includes(s, item) {
+			s = append(s, item)
+		}
+	}
+	return s
+}
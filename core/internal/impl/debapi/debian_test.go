@@ -0,0 +1,221 @@
+package debapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/xi2/xz"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+//go:embed testdata/Release
+var releaseFile string
+
+//go:embed testdata/Packages
+var packagesFile string
+
+func TestIsIndex(t *testing.T) {
+	var cases = []struct {
+		path string
+		ok   bool
+	}{
+		{"dists/bookworm/Release", true},
+		{"dists/bookworm/InRelease", true},
+		{"dists/bookworm/main/binary-amd64/Packages", true},
+		{"dists/bookworm/main/binary-amd64/Packages.gz", true},
+		{"dists/bookworm/main/binary-amd64/Packages.xz", true},
+		{"dists/bookworm/main/i18n/Translation-en", false},
+		{"pool/main/h/hello/hello_2.10-3_amd64.deb", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.EqualValues(t, tt.ok, IsIndex(tt.path))
+		})
+	}
+}
+
+func TestControl(t *testing.T) {
+	paragraphs, err := ParseControl(strings.NewReader(packagesFile))
+	assert.NoError(t, err)
+	assert.Len(t, paragraphs, 2)
+	assert.EqualValues(t, "jq", paragraphs[1].Get("package"))
+	assert.Contains(t, paragraphs[1].Get("Description"), "\n jq is like sed for JSON data.")
+
+	// make sure that we write exactly
+	// what we read
+	buf := bytes.NewBuffer(nil)
+	assert.NoError(t, WriteControl(buf, paragraphs))
+	assert.EqualValues(t, packagesFile, buf.String())
+}
+
+func TestPackageDirs(t *testing.T) {
+	paragraphs, err := ParseControl(strings.NewReader(releaseFile))
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{"contrib/binary-amd64", "main/binary-amd64"}, packageDirs(paragraphs[0]))
+}
+
+func TestMergePackages(t *testing.T) {
+	a, err := ParseControl(strings.NewReader(packagesFile))
+	assert.NoError(t, err)
+	b, err := ParseControl(strings.NewReader(packagesFile))
+	assert.NoError(t, err)
+	b[0].Set("Version", "2.10-4")
+
+	out := mergePackages([][]*Paragraph{a, nil, b})
+	assert.Len(t, out, 3)
+	assert.EqualValues(t, "2.10-4", out[2].Get("Version"))
+}
+
+func TestMergeRelease(t *testing.T) {
+	paragraphs, err := ParseControl(strings.NewReader(releaseFile))
+	assert.NoError(t, err)
+	other := &Paragraph{}
+	other.Set("Architectures", "amd64 i386")
+	other.Set("Components", "main non-free")
+
+	out := mergeRelease([]*Paragraph{nil, paragraphs[0], other}, map[string][]byte{
+		"main/binary-amd64/Packages": []byte(packagesFile),
+	}, time.Date(2023, 6, 10, 8, 52, 54, 0, time.UTC))
+	assert.EqualValues(t, "bookworm", out.Get("Codename"))
+	assert.EqualValues(t, "Sat, 10 Jun 2023 08:52:54 UTC", out.Get("Date"))
+	assert.EqualValues(t, "amd64 arm64 i386", out.Get("Architectures"))
+	assert.EqualValues(t, "main contrib non-free", out.Get("Components"))
+	assert.Empty(t, out.Get("Acquire-By-Hash"))
+	assert.Contains(t, out.Get("SHA256"), "main/binary-amd64/Packages")
+}
+
+func TestWriteXZ(t *testing.T) {
+	var cases = []struct {
+		name string
+		size int
+	}{
+		{"empty", 0},
+		{"small", 13},
+		{"single chunk", xzChunkSize},
+		{"multiple chunks", xzChunkSize*2 + 7},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			data := bytes.Repeat([]byte("a"), tt.size)
+			r, err := xz.NewReader(bytes.NewReader(writeXZ(data)), 0)
+			assert.NoError(t, err)
+			out, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.EqualValues(t, data, out)
+		})
+	}
+}
+
+// fileRemote serves files from a map.
+type fileRemote map[string]string
+
+func (fileRemote) String() string {
+	return "test"
+}
+
+func (f fileRemote) Exists(_ context.Context, path string, _ *schemas.RequestContext) (string, error) {
+	if _, ok := f[path]; !ok {
+		return "", refract.ErrNotFound
+	}
+	return path, nil
+}
+
+func (f fileRemote) Download(_ context.Context, path string, _ *schemas.RequestContext) (io.ReadCloser, error) {
+	data, ok := f[path]
+	if !ok {
+		return nil, refract.ErrNotFound
+	}
+	return io.NopCloser(strings.NewReader(data)), nil
+}
+
+// recordingRemote remembers the paths
+// that were downloaded.
+type recordingRemote struct {
+	fileRemote
+	downloads []string
+}
+
+func (r *recordingRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
+	r.downloads = append(r.downloads, path)
+	return r.fileRemote.Download(ctx, strings.Split(path, "#")[0], rctx)
+}
+
+func TestProvider_Pool(t *testing.T) {
+	ctx := context.TODO()
+	sum := sha256.Sum256([]byte(packagesFile))
+	rm := &recordingRemote{fileRemote: fileRemote{
+		"dists/bookworm/Release":                    fmt.Sprintf("Codename: bookworm\nSHA256:\n %s %d main/binary-amd64/Packages\n", hex.EncodeToString(sum[:]), len(packagesFile)),
+		"dists/bookworm/main/binary-amd64/Packages": packagesFile,
+		"pool/main/h/hello/hello_2.10-3_amd64.deb":  "hello",
+		"pool/main/j/jq/jq_1.6-2.1_amd64.deb":       "jq",
+	}}
+	ref := refract.NewSimple(ctx, "test", []remote.Remote{rm})
+	filename := fmt.Sprintf("%s/%s/bookworm/pool/main/h/hello/hello_2.10-3_amd64.deb", PoolPrefix, remoteID(rm))
+
+	t.Run("index lists the suite", func(t *testing.T) {
+		r, err := NewProvider().Index(ctx, ref, "dists/bookworm/main/binary-amd64/Packages", nil)
+		assert.NoError(t, err)
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "Filename: "+filename+"\n")
+	})
+	// a fresh provider simulates a replica that
+	// didn't generate the index itself
+	t.Run("package is verified", func(t *testing.T) {
+		rm.downloads = nil
+		_, err := NewProvider().Pool(ctx, ref, "/"+filename, nil)
+		assert.NoError(t, err)
+		assert.Contains(t, rm.downloads, "pool/main/h/hello/hello_2.10-3_amd64.deb#sha256="+"6b0a1c4e2f3d5a7b9c1e3f5a7b9c1e3f5a7b9c1e3f5a7b9c1e3f5a7b9c1e3f5a")
+	})
+	t.Run("unknown package is not verified", func(t *testing.T) {
+		rm.downloads = nil
+		_, err := NewProvider().Pool(ctx, ref, fmt.Sprintf("%s/%s/bookworm/pool/main/j/jq/jq_1.6-2.1_amd64.deb", PoolPrefix, remoteID(rm)), nil)
+		assert.NoError(t, err)
+		assert.Contains(t, rm.downloads, "pool/main/j/jq/jq_1.6-2.1_amd64.deb")
+	})
+	t.Run("malformed path", func(t *testing.T) {
+		_, err := NewProvider().Pool(ctx, ref, PoolPrefix+"/"+remoteID(rm)+"/hello.deb", nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestProvider_fetchPackages(t *testing.T) {
+	sum := sha256.Sum256([]byte(packagesFile))
+	rm := fileRemote{"dists/bookworm/main/binary-amd64/Packages": packagesFile}
+
+	p := NewProvider()
+	t.Run("matching checksum", func(t *testing.T) {
+		items, err := p.fetchPackages(context.TODO(), rm, "bookworm", "main/binary-amd64", map[string]string{
+			"main/binary-amd64/Packages": hex.EncodeToString(sum[:]),
+		}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, items, 2)
+	})
+	t.Run("mismatched checksum", func(t *testing.T) {
+		_, err := p.fetchPackages(context.TODO(), rm, "bookworm", "main/binary-amd64", map[string]string{
+			"main/binary-amd64/Packages": strings.Repeat("0", 64),
+		}, nil)
+		assert.ErrorIs(t, err, ErrChecksum)
+	})
+	t.Run("not listed in Release", func(t *testing.T) {
+		_, err := p.fetchPackages(context.TODO(), rm, "bookworm", "main/binary-amd64", map[string]string{}, nil)
+		assert.ErrorIs(t, err, ErrChecksum)
+	})
+}
+
+func TestReleaseHashes(t *testing.T) {
+	paragraphs, err := ParseControl(strings.NewReader(releaseFile))
+	assert.NoError(t, err)
+	sums := releaseHashes(paragraphs[0])
+	assert.EqualValues(t, strings.Repeat("2", 64), sums["contrib/binary-amd64/Packages.gz"])
+}
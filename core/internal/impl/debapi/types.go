@@ -0,0 +1,37 @@
+package debapi
+
+import (
+	"github.com/jellydator/ttlcache/v3"
+)
+
+type Provider struct {
+	// caches
+	suiteCache *ttlcache.Cache[string, *Suite]
+}
+
+// Suite is a snapshot of the indices that
+// we generate for a single distribution.
+type Suite struct {
+	Release []byte
+	// Files contains the Packages indices
+	// keyed by their path relative to the suite
+	// (e.g., main/binary-amd64/Packages.gz)
+	Files map[string][]byte
+	// Digests contains the SHA256 sums of the
+	// packages keyed by their rewritten Filename
+	Digests map[string]string
+}
+
+// Paragraph is a single stanza of a
+// deb822-style control file.
+type Paragraph struct {
+	Fields []Field
+}
+
+type Field struct {
+	Name string
+	// Value contains the raw value of the field.
+	// Continuation lines are separated by a newline
+	// and retain their leading whitespace.
+	Value string
+}
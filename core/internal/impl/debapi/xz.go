@@ -0,0 +1,105 @@
+package debapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+)
+
+// xzChunkSize is the largest amount of data that
+// can be stored in a single LZMA2 chunk.
+const xzChunkSize = 1 << 16
+
+var xzMagic = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+
+// xzFlags selects CRC32 as the integrity check.
+var xzFlags = []byte{0x00, 0x01}
+
+// writeXZ wraps data in an xz container without compressing
+// it. The LZMA2 format allows data to be stored as-is, which
+// is enough for apt to accept the file while only needing
+// the standard library.
+func writeXZ(data []byte) []byte {
+	buf := bytes.NewBuffer(nil)
+	// stream header
+	buf.Write(xzMagic)
+	buf.Write(xzFlags)
+	writeCRC32(buf, xzFlags)
+
+	// block header: size, flags (one filter, no sizes),
+	// the LZMA2 filter with a 1-byte property and padding
+	header := []byte{0x02, 0x00, 0x21, 0x01, 0x00, 0x00, 0x00, 0x00}
+	buf.Write(header)
+	writeCRC32(buf, header)
+	headerSize := len(header) + 4
+
+	// compressed data, stored as uncompressed LZMA2 chunks
+	for i := 0; i < len(data); i += xzChunkSize {
+		end := i + xzChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk := data[i:end]
+		// the first chunk must reset the dictionary
+		control := byte(0x02)
+		if i == 0 {
+			control = 0x01
+		}
+		buf.WriteByte(control)
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(chunk)-1))
+		buf.Write(chunk)
+	}
+	buf.WriteByte(0x00)
+	// block padding
+	for i := headerSize + unpadded(data); i%4 != 0; i++ {
+		buf.WriteByte(0x00)
+	}
+	check := make([]byte, 4)
+	binary.LittleEndian.PutUint32(check, crc32.ChecksumIEEE(data))
+	buf.Write(check)
+
+	// index
+	index := bytes.NewBuffer(nil)
+	index.WriteByte(0x00)
+	writeVarint(index, 1)
+	// the unpadded size doesn't include the block padding
+	writeVarint(index, uint64(headerSize+unpadded(data)+len(check)))
+	writeVarint(index, uint64(len(data)))
+	for index.Len()%4 != 0 {
+		index.WriteByte(0x00)
+	}
+	writeCRC32(index, index.Bytes())
+	buf.Write(index.Bytes())
+
+	// stream footer
+	footer := make([]byte, 4, 6)
+	binary.LittleEndian.PutUint32(footer, uint32(index.Len()/4-1))
+	footer = append(footer, xzFlags...)
+	writeCRC32(buf, footer)
+	buf.Write(footer)
+	buf.WriteString("YZ")
+	return buf.Bytes()
+}
+
+// unpadded returns the size of the LZMA2 data
+// that writeXZ generates for data.
+func unpadded(data []byte) int {
+	chunks := (len(data) + xzChunkSize - 1) / xzChunkSize
+	return len(data) + chunks*3 + 1
+}
+
+func writeCRC32(buf *bytes.Buffer, data []byte) {
+	sum := make([]byte, 4)
+	binary.LittleEndian.PutUint32(sum, crc32.ChecksumIEEE(data))
+	buf.Write(sum)
+}
+
+// writeVarint writes a multibyte integer
+// as defined by the xz file format.
+func writeVarint(buf *bytes.Buffer, v uint64) {
+	for v >= 0x80 {
+		buf.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	buf.WriteByte(byte(v))
+}
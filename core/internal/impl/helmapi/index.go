@@ -6,8 +6,13 @@ import (
 	"fmt"
 	jsonyaml "github.com/ghodss/yaml"
 	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/quarantine"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -16,14 +21,19 @@ import (
 	"io"
 )
 
-func NewIndex(repos *repo.Repos, publicURL string) *Index {
+func NewIndex(repos *repo.Repos, publicURL string, locker remote.Locker) *Index {
 	return &Index{
 		repos:     repos,
 		publicURL: publicURL,
+		locker:    locker,
 	}
 }
 
-func (svc *Index) Serve(ctx context.Context, ref *refract.BackedRefraction) (io.Reader, error) {
+// Serve generates the index of every chart in the remotes
+// of a refraction. Versions that are hidden by the
+// quarantine policy or affected by a known
+// vulnerability are left out.
+func (svc *Index) Serve(ctx context.Context, ref *refract.BackedRefraction, pol *quarantine.Policy, adv *advisory.Policy) (io.Reader, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_helm_serve")
 	defer span.End()
 	index := helmrepo.NewIndexFile()
@@ -44,7 +54,22 @@ func (svc *Index) Serve(ctx context.Context, ref *refract.BackedRefraction) (io.
 		return nil, err
 	}
 	log.Info("collected helm packages", "Count", len(packages))
+	matches, err := svc.advisories(ctx, adv, packages)
+	if err != nil {
+		log.Error(err, "failed to retrieve advisories of helm packages")
+		return nil, err
+	}
+	hidden := map[string]int{}
+	vulnerable := map[string]int{}
 	for _, p := range packages {
+		if !pol.Allow(ctx, model.ArchetypeHelm, p.Name, p.Version, p.Created) {
+			hidden[p.Name]++
+			continue
+		}
+		if matches != nil && len(matches.Affects(p.Name, p.Version)) > 0 {
+			vulnerable[p.Name]++
+			continue
+		}
 		if err := index.MustAdd(&chart.Metadata{
 			Name:        p.Name,
 			Version:     p.Version,
@@ -57,6 +82,18 @@ func (svc *Index) Serve(ctx context.Context, ref *refract.BackedRefraction) (io.
 			log.Error(err, "failed to add chart")
 			continue
 		}
+		// keep the time that the chart was created
+		// rather than when the index was generated
+		if !p.Created.IsZero() {
+			versions := index.Entries[p.Name]
+			versions[len(versions)-1].Created = p.Created
+		}
+	}
+	for name, n := range hidden {
+		pol.Hide(ctx, model.ArchetypeHelm, name, n)
+	}
+	for name, n := range vulnerable {
+		adv.Hide(ctx, name, n)
 	}
 	data, err := jsonyaml.Marshal(index)
 	if err != nil {
@@ -65,3 +102,17 @@ func (svc *Index) Serve(ctx context.Context, ref *refract.BackedRefraction) (io.
 	}
 	return bytes.NewReader(data), nil
 }
+
+// advisories retrieves the advisories of every chart
+// in the index. It returns nil if vulnerable
+// versions don't need to be hidden.
+func (*Index) advisories(ctx context.Context, adv *advisory.Policy, packages []*schemas.HelmPackage) (*advisory.Matches, error) {
+	if !adv.Hides() {
+		return nil, nil
+	}
+	names := make([]string, len(packages))
+	for i := range packages {
+		names[i] = packages[i].Name
+	}
+	return adv.Match(ctx, names...)
+}
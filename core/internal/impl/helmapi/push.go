@@ -0,0 +1,82 @@
+package helmapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Push saves a packaged chart to a hosted remote and
+// adds it to the index. Charts that have already been
+// pushed cannot be replaced.
+func (svc *Index) Push(ctx context.Context, rm *remote.BackedRemote, r io.Reader) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_helm_push")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("helm").WithValues("Remote", rm.Model().Name)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		log.Error(err, "failed to read chart")
+		return err
+	}
+	ch, err := loader.LoadArchive(bytes.NewReader(data))
+	if err != nil {
+		log.Error(err, "failed to load chart")
+		return problem.New(http.StatusBadRequest).Errorf("malformed chart archive")
+	}
+	if err := ch.Validate(); err != nil {
+		log.Error(err, "failed to validate chart")
+		return problem.New(http.StatusBadRequest).Errorf("invalid chart: %s", err)
+	}
+	meta := ch.Metadata
+	filename := fmt.Sprintf("%s-%s.tgz", meta.Name, meta.Version)
+	span.SetAttributes(attribute.String("filename", filename))
+	log = log.WithValues("Filename", filename)
+	log.Info("pushing helm chart")
+
+	// make sure that nobody else pushes the same
+	// chart between checking for it and saving it
+	unlock, err := svc.locker.Lock(ctx, fmt.Sprintf("helm/push/%s/%s", rm.Model().ID, filename))
+	if err != nil {
+		log.Error(err, "failed to lock chart")
+		return err
+	}
+	defer unlock()
+
+	if _, err := rm.Exists(ctx, filename, &schemas.RequestContext{}); err == nil {
+		log.Info("rejecting attempt to replace an existing chart")
+		return problem.New(http.StatusConflict).Errorf("chart already exists")
+	}
+	if err := rm.Upload(ctx, filename, bytes.NewReader(data)); err != nil {
+		return err
+	}
+	h := sha256.Sum256(data)
+	return svc.repos.HelmPackageRepo.BatchInsert(ctx, []*schemas.HelmPackage{
+		{
+			Filename:    filename,
+			URL:         fmt.Sprintf("%s/%s", rm.String(), filename),
+			Name:        meta.Name,
+			Version:     meta.Version,
+			Digest:      hex.EncodeToString(h[:]),
+			Icon:        meta.Icon,
+			APIVersion:  meta.APIVersion,
+			AppVersion:  meta.AppVersion,
+			KubeVersion: meta.KubeVersion,
+			Type:        meta.Type,
+			Created:     time.Now(),
+			RemoteID:    rm.Model().ID,
+		},
+	})
+}
@@ -2,9 +2,13 @@ package helmapi
 
 import (
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
 )
 
 type Index struct {
 	publicURL string
 	repos     *repo.Repos
+	// locker makes sure that only one push
+	// saves a given chart at a time
+	locker remote.Locker
 }
@@ -0,0 +1,257 @@
+package mavenapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	MetadataName = "maven-metadata.xml"
+
+	checksumSHA1 = ".sha1"
+	checksumMD5  = ".md5"
+)
+
+func NewProvider() *Provider {
+	return &Provider{
+		metadataCache: ttlcache.New[string, []byte](ttlcache.WithCapacity[string, []byte](1000), ttlcache.WithTTL[string, []byte](time.Minute*5)),
+	}
+}
+
+// IsMetadata returns true if the given path
+// points to a maven-metadata.xml file or
+// one of its checksums.
+func IsMetadata(path string) bool {
+	name, _ := splitChecksum(path)
+	return filepath.Base(name) == MetadataName
+}
+
+// splitChecksum separates the checksum extension
+// (if any) from the path of the metadata document.
+func splitChecksum(path string) (string, string) {
+	for _, ext := range []string{checksumSHA1, checksumMD5} {
+		if strings.HasSuffix(path, ext) {
+			return strings.TrimSuffix(path, ext), ext
+		}
+	}
+	return path, ""
+}
+
+// Metadata returns the maven-metadata.xml document at the given
+// path after merging it from every remote in the refraction. Requests
+// for the .sha1 or .md5 sidecars are computed from the merged document.
+func (p *Provider) Metadata(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_maven_metadata", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("maven").WithValues("Path", path, "Refraction", ref.String())
+	log.Info("retrieving Maven metadata")
+	docPath, ext := splitChecksum(path)
+	key := cacheKey(ref.String(), docPath, rctx)
+	var data []byte
+	// check the cache.
+	// this is also what keeps the checksum
+	// in sync with the document that the client
+	// downloaded immediately prior
+	item := p.metadataCache.Get(key)
+	if item != nil {
+		log.Info("found Maven metadata in cache")
+		data = item.Value()
+	} else {
+		var err error
+		data, err = p.fetch(ctx, ref, docPath, rctx)
+		if err != nil {
+			return nil, err
+		}
+		p.metadataCache.Set(key, data, ttlcache.DefaultTTL)
+	}
+	switch ext {
+	case checksumSHA1:
+		sum := sha1.Sum(data)
+		return strings.NewReader(hex.EncodeToString(sum[:])), nil
+	case checksumMD5:
+		sum := md5.Sum(data)
+		return strings.NewReader(hex.EncodeToString(sum[:])), nil
+	}
+	return bytes.NewReader(data), nil
+}
+
+// cacheKey returns the key of a merged document. The
+// document differs depending on what the user is able
+// to see, so it is partitioned in the same way as the
+// files of a remote.
+func cacheKey(ref, path string, rctx *schemas.RequestContext) string {
+	key := filepath.Join(ref, path)
+	if rctx == nil || rctx.Token == "" {
+		return key
+	}
+	partition := rctx.PartitionID
+	if partition == "" {
+		partition = rctx.Token
+	}
+	sum := sha256.Sum256([]byte(partition))
+	return key + "#" + hex.EncodeToString(sum[:])
+}
+
+func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) ([]byte, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_maven_fetch", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("maven").WithValues("Path", path, "Refraction", ref.String())
+	remotes := ref.Remotes()
+	// collect documents by index so that
+	// the merge is deterministic
+	docs := make([]*Metadata, len(remotes))
+
+	wg := sync.WaitGroup{}
+	log.Info("fetching Maven metadata from remotes", "Count", len(remotes))
+	for i := range remotes {
+		wg.Add(1)
+		j := i
+		// download the metadata
+		go func() {
+			defer wg.Done()
+			// make sure to clone the request context
+			// otherwise remotes will overwrite each other
+			resp, err := remotes[j].Download(ctx, path, rctx.Clone())
+			if err != nil {
+				log.V(1).Info("skipping remote as metadata could not be retrieved", "Remote", remotes[j].String(), "Error", err.Error())
+				return
+			}
+			defer resp.Close()
+			doc, err := p.parse(ctx, resp)
+			if err != nil {
+				return
+			}
+			docs[j] = doc
+		}()
+	}
+	// wait for all responses
+	wg.Wait()
+
+	var found []*Metadata
+	for _, d := range docs {
+		if d != nil {
+			found = append(found, d)
+		}
+	}
+	span.SetAttributes(attribute.Int("documents", len(found)))
+	if len(found) == 0 {
+		log.Info("unable to locate Maven metadata in any remote")
+		return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+	}
+	log.V(1).Info("merging Maven metadata", "Count", len(found))
+	data, err := xml.MarshalIndent(merge(found), "", "  ")
+	if err != nil {
+		log.Error(err, "failed to marshal merged metadata")
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+func (*Provider) parse(ctx context.Context, r io.Reader) (*Metadata, error) {
+	_, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_maven_parse")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("maven")
+	var doc Metadata
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		log.Error(err, "failed to parse maven-metadata.xml")
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// merge combines multiple metadata documents into one.
+//
+// Versions and plugins are the union of all documents, and
+// the lastUpdated timestamp is the most recent. Values that can
+// only have one answer (e.g., latest, release, snapshot) are taken
+// from the most recently updated document that provides them.
+func merge(docs []*Metadata) *Metadata {
+	// order documents from newest to oldest
+	sorted := make([]*Metadata, len(docs))
+	copy(sorted, docs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return lastUpdated(sorted[i]) > lastUpdated(sorted[j])
+	})
+	result := &Metadata{}
+	seenVersions := map[string]struct{}{}
+	seenPlugins := map[string]struct{}{}
+	for _, d := range sorted {
+		result.ModelVersion = firstNonEmpty(result.ModelVersion, d.ModelVersion)
+		result.GroupID = firstNonEmpty(result.GroupID, d.GroupID)
+		result.ArtifactID = firstNonEmpty(result.ArtifactID, d.ArtifactID)
+		result.Version = firstNonEmpty(result.Version, d.Version)
+		for _, pl := range d.Plugins {
+			if _, ok := seenPlugins[pl.Prefix]; ok {
+				continue
+			}
+			seenPlugins[pl.Prefix] = struct{}{}
+			result.Plugins = append(result.Plugins, pl)
+		}
+		if d.Versioning == nil {
+			continue
+		}
+		if result.Versioning == nil {
+			result.Versioning = &Versioning{}
+		}
+		v := result.Versioning
+		v.Latest = firstNonEmpty(v.Latest, d.Versioning.Latest)
+		v.Release = firstNonEmpty(v.Release, d.Versioning.Release)
+		v.LastUpdated = firstNonEmpty(v.LastUpdated, d.Versioning.LastUpdated)
+		if v.Snapshot == nil && d.Versioning.Snapshot != nil {
+			v.Snapshot = d.Versioning.Snapshot
+			v.SnapshotVersions = d.Versioning.SnapshotVersions
+		}
+		for _, ver := range d.Versioning.Versions {
+			if _, ok := seenVersions[ver]; ok {
+				continue
+			}
+			seenVersions[ver] = struct{}{}
+			v.Versions = append(v.Versions, ver)
+		}
+	}
+	return result
+}
+
+// lastUpdated returns the versioning timestamp of the
+// document. The timestamp uses the yyyyMMddHHmmss format,
+// so it can be compared lexicographically.
+func lastUpdated(m *Metadata) string {
+	if m.Versioning == nil {
+		return ""
+	}
+	return m.Versioning.LastUpdated
+}
+
+func firstNonEmpty(current, next string) string {
+	if current != "" {
+		return current
+	}
+	return next
+}
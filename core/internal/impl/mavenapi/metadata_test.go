@@ -0,0 +1,67 @@
+package mavenapi
+
+import (
+	"context"
+	_ "embed"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/pkg/httpclient"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"strings"
+	"testing"
+)
+
+//go:embed testdata/central.xml
+var centralMetadata string
+
+//go:embed testdata/internal.xml
+var internalMetadata string
+
+func TestIsMetadata(t *testing.T) {
+	var cases = []struct {
+		path string
+		ok   bool
+	}{
+		{"com/example/widget/maven-metadata.xml", true},
+		{"com/example/widget/maven-metadata.xml.sha1", true},
+		{"com/example/widget/maven-metadata.xml.md5", true},
+		{"com/example/widget/1.0.0/widget-1.0.0.jar", false},
+		{"com/example/widget/1.0.0/widget-1.0.0.jar.sha1", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.EqualValues(t, tt.ok, IsMetadata(tt.path))
+		})
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	const path = "com/example/widget/maven-metadata.xml"
+	anon := cacheKey("maven", path, nil)
+	assert.EqualValues(t, "maven/"+path, anon)
+	assert.EqualValues(t, anon, cacheKey("maven", path, &schemas.RequestContext{}))
+
+	alice := cacheKey("maven", path, &schemas.RequestContext{AuthOpts: httpclient.AuthOpts{Token: "alice"}})
+	bob := cacheKey("maven", path, &schemas.RequestContext{AuthOpts: httpclient.AuthOpts{Token: "bob"}})
+	assert.NotEqualValues(t, alice, bob)
+	assert.NotContains(t, alice, "alice")
+
+	// the partition ID is preferred over the token
+	shared := cacheKey("maven", path, &schemas.RequestContext{AuthOpts: httpclient.AuthOpts{Token: "bob"}, PartitionID: "alice"})
+	assert.EqualValues(t, alice, shared)
+}
+
+func TestMerge(t *testing.T) {
+	p := &Provider{}
+	central, err := p.parse(context.TODO(), strings.NewReader(centralMetadata))
+	assert.NoError(t, err)
+	internal, err := p.parse(context.TODO(), strings.NewReader(internalMetadata))
+	assert.NoError(t, err)
+
+	out := merge([]*Metadata{central, internal})
+	assert.EqualValues(t, "com.example", out.GroupID)
+	assert.EqualValues(t, "widget", out.ArtifactID)
+	assert.EqualValues(t, "1.3.0-internal", out.Versioning.Latest)
+	assert.EqualValues(t, "1.3.0-internal", out.Versioning.Release)
+	assert.EqualValues(t, "20230304050607", out.Versioning.LastUpdated)
+	assert.ElementsMatch(t, []string{"1.0.0", "1.1.0", "1.2.0", "1.3.0-internal"}, out.Versioning.Versions)
+}
@@ -0,0 +1,51 @@
+package mavenapi
+
+import (
+	"encoding/xml"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+type Provider struct {
+	// caches
+	metadataCache *ttlcache.Cache[string, []byte]
+}
+
+// Metadata is the maven-metadata.xml document
+// as described by https://maven.apache.org/ref/3.9.0/maven-repository-metadata/repository-metadata.html
+type Metadata struct {
+	XMLName      xml.Name    `xml:"metadata"`
+	ModelVersion string      `xml:"modelVersion,attr,omitempty"`
+	GroupID      string      `xml:"groupId,omitempty"`
+	ArtifactID   string      `xml:"artifactId,omitempty"`
+	Version      string      `xml:"version,omitempty"`
+	Versioning   *Versioning `xml:"versioning,omitempty"`
+	Plugins      []Plugin    `xml:"plugins>plugin,omitempty"`
+}
+
+type Versioning struct {
+	Latest           string            `xml:"latest,omitempty"`
+	Release          string            `xml:"release,omitempty"`
+	Versions         []string          `xml:"versions>version,omitempty"`
+	LastUpdated      string            `xml:"lastUpdated,omitempty"`
+	Snapshot         *Snapshot         `xml:"snapshot,omitempty"`
+	SnapshotVersions []SnapshotVersion `xml:"snapshotVersions>snapshotVersion,omitempty"`
+}
+
+type Snapshot struct {
+	Timestamp   string `xml:"timestamp,omitempty"`
+	BuildNumber int    `xml:"buildNumber,omitempty"`
+	LocalCopy   bool   `xml:"localCopy,omitempty"`
+}
+
+type SnapshotVersion struct {
+	Classifier string `xml:"classifier,omitempty"`
+	Extension  string `xml:"extension,omitempty"`
+	Value      string `xml:"value,omitempty"`
+	Updated    string `xml:"updated,omitempty"`
+}
+
+type Plugin struct {
+	Name       string `xml:"name,omitempty"`
+	Prefix     string `xml:"prefix"`
+	ArtifactID string `xml:"artifactId"`
+}
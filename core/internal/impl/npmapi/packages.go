@@ -5,30 +5,80 @@ import (
 	"fmt"
 	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/quarantine"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"io"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
-func NewProvider(repos *repo.Repos, publicURL string) *Provider {
+func NewProvider(repos *repo.Repos, publicURL string, locker remote.Locker) *Provider {
 	return &Provider{
 		publicURL:       publicURL,
 		repos:           repos,
-		pkgCache:        ttlcache.New[string, string](ttlcache.WithCapacity[string, string](1000), ttlcache.WithTTL[string, string](time.Minute*5)),
+		locker:          locker,
+		pkgCache:        ttlcache.New[string, string](ttlcache.WithCapacity[string, string](1000), ttlcache.WithTTL[string, string](maxAge)),
 		pkgVersionCache: ttlcache.New[string, string](ttlcache.WithCapacity[string, string](1000)),
 	}
 }
 
-func (p *Provider) Package(ctx context.Context, ref *refract.Refraction, pkg string) (io.Reader, error) {
+// packageKey identifies a package document. Documents are
+// merged from the remotes of a Refraction, so the same
+// package may differ between Refractions.
+func packageKey(ref, pkg string) string {
+	return ref + "/" + pkg
+}
+
+// Package returns the package document, without any
+// versions that are hidden by the quarantine policy
+// or affected by a known vulnerability.
+func (p *Provider) Package(ctx context.Context, ref *refract.Refraction, pkg string, pol *quarantine.Policy, adv *advisory.Policy) (io.Reader, error) {
+	r, err := p.pkg(ctx, ref, pkg)
+	if err != nil || (!pol.Enabled() && !adv.Hides()) {
+		return r, err
+	}
+	return p.filter(ctx, pkg, r, pol, adv)
+}
+
+// filter removes quarantined and vulnerable versions from
+// a package document while keeping any staleness warning.
+func (p *Provider) filter(ctx context.Context, pkg string, r io.Reader, pol *quarantine.Policy, adv *advisory.Policy) (io.Reader, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := pol.FilterNPM(ctx, pkg, string(data))
+	if err != nil {
+		log.Error(err, "failed to apply quarantine to NPM manifest", "Package", pkg)
+		return nil, err
+	}
+	doc, err = adv.FilterNPM(ctx, pkg, doc)
+	if err != nil {
+		log.Error(err, "failed to apply advisories to NPM manifest", "Package", pkg)
+		return nil, err
+	}
+	if s, ok := r.(*refract.Stale); ok {
+		return &refract.Stale{Reader: strings.NewReader(doc), Warning: s.Warning}, nil
+	}
+	return strings.NewReader(doc), nil
+}
+
+func (p *Provider) pkg(ctx context.Context, ref *refract.Refraction, pkg string) (io.Reader, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_npm_package", trace.WithAttributes(
 		attribute.String("package", pkg),
 		attribute.String("refraction", ref.String()),
@@ -37,24 +87,118 @@ func (p *Provider) Package(ctx context.Context, ref *refract.Refraction, pkg str
 	log := logr.FromContextOrDiscard(ctx).WithName("npm").WithValues("Package", pkg, "Refraction", ref.String())
 	log.Info("retrieving NPM package manifest")
 	// check the cache
-	item := p.pkgCache.Get(pkg)
+	key := packageKey(ref.String(), pkg)
+	item := p.pkgCache.Get(key)
 	if item != nil {
 		log.Info("found NPM manifest in cache")
 		return strings.NewReader(item.Value()), nil
 	}
-	p.fetch(ctx, ref, pkg)
-	// fetch the package since we know it's in the cache
-	data, err := p.repos.NPMPackageRepo.GetPackage(ctx, pkg)
+	if ref.Offline() {
+		log.Info("serving stored NPM manifest as the refraction is offline")
+		return p.stale(ctx, ref, pkg, refract.WarningDisconnected)
+	}
+	updated, err := p.repos.NPMPackageRepo.LastUpdated(ctx, ref.String(), pkg)
+	age := time.Since(updated)
+	switch {
+	case err == nil && age < maxAge:
+		log.V(1).Info("serving fresh NPM manifest from the database", "Age", age)
+	case err == nil && age < staleWhileRevalidate:
+		// serve what we have and refresh it in the
+		// background, so the client isn't kept waiting
+		log.Info("serving stale NPM manifest while it is refreshed", "Age", age)
+		p.revalidate(ctx, ref, pkg)
+		return p.read(ctx, ref, pkg)
+	default:
+		if !p.fetch(ctx, ref, pkg) {
+			if err == nil {
+				log.Info("serving stale NPM manifest as no remote could be reached", "Age", age)
+				return p.stale(ctx, ref, pkg, refract.WarningRevalidationFailed)
+			}
+			return nil, problem.New(http.StatusNotFound).Errorf("package could not be found in any remote")
+		}
+	}
+	data, err := p.repos.NPMPackageRepo.GetPackage(ctx, ref.String(), pkg)
 	if err != nil {
 		return nil, err
 	}
 	// we can only cache temporarily otherwise
 	// we'll miss new versions
-	p.pkgCache.Set(pkg, data, ttlcache.DefaultTTL)
+	p.pkgCache.Set(key, data, ttlcache.DefaultTTL)
 	return strings.NewReader(data), nil
 }
 
-func (p *Provider) PackageVersion(ctx context.Context, ref *refract.Refraction, pkg, version string) (io.Reader, error) {
+// read returns the package document that is
+// stored in the database for the Refraction.
+func (p *Provider) read(ctx context.Context, ref *refract.Refraction, pkg string) (io.Reader, error) {
+	data, err := p.repos.NPMPackageRepo.GetPackage(ctx, ref.String(), pkg)
+	if err != nil {
+		return nil, err
+	}
+	return strings.NewReader(data), nil
+}
+
+// stale returns the package document that is stored
+// in the database, marked so that the client knows
+// it may be out of date.
+func (p *Provider) stale(ctx context.Context, ref *refract.Refraction, pkg, warning string) (io.Reader, error) {
+	r, err := p.read(ctx, ref, pkg)
+	if err != nil {
+		return nil, err
+	}
+	return &refract.Stale{Reader: r, Warning: warning}, nil
+}
+
+// revalidate fetches the package from the remotes in the
+// background. Only one refresh of a package runs at a time.
+func (p *Provider) revalidate(ctx context.Context, ref *refract.Refraction, pkg string) {
+	key := packageKey(ref.String(), pkg)
+	if _, loaded := p.revalidating.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+	// the request context is cancelled once
+	// the response has been sent
+	log := logr.FromContextOrDiscard(ctx)
+	go func() {
+		defer p.revalidating.Delete(key)
+		if p.fetch(logr.NewContext(context.Background(), log), ref, pkg) {
+			p.pkgCache.Delete(key)
+		}
+	}()
+}
+
+// PackageVersion returns the manifest of a single version.
+// Versions that are hidden by the quarantine policy or
+// the vulnerability policy cannot be found.
+func (p *Provider) PackageVersion(ctx context.Context, ref *refract.Refraction, pkg, version string, pol *quarantine.Policy, adv *advisory.Policy) (io.Reader, error) {
+	r, err := p.pkgVersion(ctx, ref, pkg, version)
+	if err != nil {
+		return r, err
+	}
+	log := logr.FromContextOrDiscard(ctx)
+	if pol.Enabled() {
+		published, _ := p.repos.NPMPackageRepo.GetPublishTime(ctx, ref.String(), pkg, version)
+		if !pol.Allow(ctx, model.ArchetypeNpm, pkg, version, published) {
+			log.Info("refusing quarantined NPM version", "Package", pkg, "Version", version, "Published", published)
+			pol.Hide(ctx, model.ArchetypeNpm, pkg, 1)
+			return nil, problem.New(http.StatusNotFound).Errorf("version %s of %s is quarantined", version, pkg)
+		}
+	}
+	if adv.Hides() {
+		id, err := adv.Affected(ctx, pkg, version)
+		if err != nil {
+			log.Error(err, "failed to check advisories of NPM version", "Package", pkg, "Version", version)
+			return nil, err
+		}
+		if id != "" {
+			log.Info("refusing vulnerable NPM version", "Package", pkg, "Version", version, "Advisory", id)
+			adv.Hide(ctx, pkg, 1)
+			return nil, problem.New(http.StatusNotFound).Errorf("version %s of %s is affected by %s", version, pkg, id)
+		}
+	}
+	return r, nil
+}
+
+func (p *Provider) pkgVersion(ctx context.Context, ref *refract.Refraction, pkg, version string) (io.Reader, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_npm_packageVersion", trace.WithAttributes(
 		attribute.String("package", pkg),
 		attribute.String("version", version),
@@ -64,20 +208,24 @@ func (p *Provider) PackageVersion(ctx context.Context, ref *refract.Refraction,
 	log := logr.FromContextOrDiscard(ctx).WithName("npm").WithValues("Package", pkg, "Version", version, "Refraction", ref.String())
 	log.Info("retrieving NPM package manifest")
 	// check the cache
-	key := filepath.Join(pkg, version)
+	key := filepath.Join(ref.String(), pkg, version)
 	item := p.pkgVersionCache.Get(key)
 	if item != nil {
 		log.Info("found NPM manifest in cache")
 		return strings.NewReader(item.Value()), nil
 	}
 	// check the database
-	data, err := p.repos.NPMPackageRepo.GetPackageVersion(ctx, pkg, version)
+	data, err := p.repos.NPMPackageRepo.GetPackageVersion(ctx, ref.String(), pkg, version)
 	if err == nil {
 		return strings.NewReader(data), nil
 	}
+	if ref.Offline() {
+		log.Info("skipping remotes as the refraction is offline")
+		return nil, err
+	}
 	p.fetch(ctx, ref, pkg)
 	// fetch the package since we know it's in the cache
-	data, err = p.repos.NPMPackageRepo.GetPackageVersion(ctx, pkg, version)
+	data, err = p.repos.NPMPackageRepo.GetPackageVersion(ctx, ref.String(), pkg, version)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +233,10 @@ func (p *Provider) PackageVersion(ctx context.Context, ref *refract.Refraction,
 	return strings.NewReader(data), nil
 }
 
-func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, pkg string) {
+// fetch downloads the package document from the remotes
+// and merges it into the database. It returns true if
+// at least one remote had the package.
+func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, pkg string) bool {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_npm_fetch", trace.WithAttributes(
 		attribute.String("package", pkg),
 		attribute.String("refraction", ref.String()),
@@ -93,34 +244,62 @@ func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, pkg strin
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithName("npm").WithValues("Package", pkg, "Refraction", ref.String())
 	// download the package
-	remotes := ref.Remotes()
+	remotes, ordered := ref.Candidates(pkg)
 	roots := make([]string, len(remotes))
+	for i := range remotes {
+		roots[i] = remotes[i].String()
+	}
+	download := func(rem remote.Remote) error {
+		// todo support requestcontext
+		resp, err := rem.Download(ctx, fmt.Sprintf("/%s", pkg), &schemas.RequestContext{})
+		if err != nil {
+			return err
+		}
+		defer resp.Close()
+		body, err := io.ReadAll(resp)
+		if err != nil {
+			log.Error(err, "failed to read response")
+			return err
+		}
+		data := p.rewriteURLs(ctx, roots, ref.String(), string(body))
+		return p.repos.NPMPackageRepo.Insert(ctx, ref.String(), pkg, data)
+	}
+	// only use the first remote that has the package,
+	// so that a public registry can't shadow it
+	if ordered {
+		log.Info("fetching NPM metadata from the first remote that has it", "Count", len(remotes))
+		for i := range remotes {
+			err := download(remotes[i])
+			if err == nil {
+				return true
+			}
+			// we can only move on if the remote
+			// definitely doesn't have the package
+			if !refract.IsNotFound(err) {
+				log.Info("preferred remote could not be checked", "Remote", remotes[i].String(), "Error", err.Error())
+				return false
+			}
+		}
+		return false
+	}
 
+	var ok atomic.Bool
 	wg := sync.WaitGroup{}
 	log.Info("fetching NPM metadata from remotes", "Count", len(remotes))
 	for i := range remotes {
 		wg.Add(1)
-		roots[i] = remotes[i].String()
 		j := i
 		// download the metadata
 		go func() {
 			defer wg.Done()
-			// todo support requestcontext
-			resp, err := remotes[j].Download(ctx, fmt.Sprintf("/%s", pkg), &schemas.RequestContext{})
-			if err != nil {
-				return
-			}
-			body, err := io.ReadAll(resp)
-			if err != nil {
-				log.Error(err, "failed to read response")
-				return
+			if download(remotes[j]) == nil {
+				ok.Store(true)
 			}
-			data := p.rewriteURLs(ctx, roots, ref.String(), string(body))
-			_ = p.repos.NPMPackageRepo.Insert(ctx, pkg, data)
 		}()
 	}
 	// wait for all responses
 	wg.Wait()
+	return ok.Load()
 }
 
 func (p *Provider) rewriteURLs(ctx context.Context, roots []string, ref, data string) string {
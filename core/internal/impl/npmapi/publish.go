@@ -0,0 +1,200 @@
+package npmapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// Publish adds the versions in an "npm publish" request to the
+// package document in a hosted remote. Versions that have
+// already been published cannot be replaced.
+//
+// https://github.com/npm/registry/blob/master/docs/REGISTRY-API.md#publish-a-package
+func (p *Provider) Publish(ctx context.Context, rm *remote.BackedRemote, pkg string, r io.Reader) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_npm_publish", trace.WithAttributes(
+		attribute.String("package", pkg),
+		attribute.String("remote", rm.Model().Name),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("npm").WithValues("Package", pkg, "Remote", rm.Model().Name)
+	log.Info("publishing NPM package")
+
+	var doc map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		log.Error(err, "failed to decode publish request")
+		// let the gateway know that
+		// the request was too large
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return err
+		}
+		return problem.New(http.StatusBadRequest).Errorf("malformed publish request")
+	}
+	// the document must belong to the package
+	// that it is being published to, otherwise it
+	// could overwrite the versions of another one
+	var name string
+	if err := json.Unmarshal(doc["name"], &name); err != nil || name != pkg {
+		log.Info("rejecting publish request for a different package", "Name", name)
+		return problem.New(http.StatusBadRequest).Errorf("package name does not match the URL")
+	}
+	var versions map[string]map[string]any
+	var attachments map[string]Attachment
+	var distTags map[string]string
+	for k, v := range map[string]any{"versions": &versions, "_attachments": &attachments, "dist-tags": &distTags} {
+		if _, ok := doc[k]; !ok {
+			continue
+		}
+		if err := json.Unmarshal(doc[k], v); err != nil {
+			log.Error(err, "failed to decode publish request", "Field", k)
+			return problem.New(http.StatusBadRequest).Errorf("malformed publish request")
+		}
+	}
+	if len(versions) == 0 {
+		return problem.New(http.StatusBadRequest).Errorf("publish request does not contain any versions")
+	}
+	for version, manifest := range versions {
+		if manifest["name"] != pkg {
+			log.Info("rejecting version that belongs to a different package", "Version", version, "Name", manifest["name"])
+			return problem.New(http.StatusBadRequest).Errorf("package name of version %s does not match the URL", version)
+		}
+	}
+
+	// only one publish can update the package document
+	// at a time, regardless of which replica it's on
+	unlock, err := p.locker.Lock(ctx, fmt.Sprintf("npm/publish/%s/%s", rm.Model().ID, pkg))
+	if err != nil {
+		log.Error(err, "failed to lock package document")
+		return err
+	}
+	defer unlock()
+
+	packument, err := p.getHostedPackage(ctx, rm, pkg)
+	if err != nil {
+		return err
+	}
+	existing := packument["versions"].(map[string]any)
+	tags := packument["dist-tags"].(map[string]any)
+	times := packument["time"].(map[string]any)
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	for version, manifest := range versions {
+		if _, ok := existing[version]; ok {
+			log.Info("rejecting attempt to publish over an existing version", "Version", version)
+			return problem.New(http.StatusConflict).Errorf("cannot publish over previously published version %s", version)
+		}
+		att, ok := attachments[fmt.Sprintf("%s-%s.tgz", pkg, version)]
+		if !ok {
+			return problem.New(http.StatusBadRequest).Errorf("publish request does not contain a tarball for version %s", version)
+		}
+		data, err := base64.StdEncoding.DecodeString(att.Data)
+		if err != nil {
+			log.Error(err, "failed to decode tarball", "Version", version)
+			return problem.New(http.StatusBadRequest).Errorf("malformed tarball for version %s", version)
+		}
+		dist, _ := manifest["dist"].(map[string]any)
+		if dist == nil {
+			dist = map[string]any{}
+			manifest["dist"] = dist
+		}
+		if shasum, _ := dist["shasum"].(string); shasum != "" {
+			h := sha1.Sum(data)
+			if hex.EncodeToString(h[:]) != shasum {
+				log.Info("rejecting tarball with mismatched shasum", "Version", version)
+				return problem.New(http.StatusBadRequest).Errorf("tarball does not match shasum for version %s", version)
+			}
+		}
+		// scoped packages only use the
+		// package name in the filename
+		tarball := fmt.Sprintf("%s/-/%s-%s.tgz", pkg, path.Base(pkg), version)
+		if err := rm.Upload(ctx, tarball, bytes.NewReader(data)); err != nil {
+			return err
+		}
+		// the remote root is replaced with the refraction
+		// URL when the document is served to clients
+		dist["tarball"] = fmt.Sprintf("%s/%s", rm.String(), tarball)
+		existing[version] = manifest
+		times[version] = now
+		log.Info("published version", "Version", version)
+	}
+	for k, v := range distTags {
+		tags[k] = v
+	}
+	// keep the remaining metadata (e.g., readme)
+	// from the most recent publish
+	for k, v := range doc {
+		switch k {
+		case "_id", "_rev", "_attachments", "versions", "dist-tags", "time":
+			continue
+		}
+		packument[k] = v
+	}
+	times["modified"] = now
+	if _, ok := times["created"]; !ok {
+		times["created"] = now
+	}
+	data, err := json.Marshal(packument)
+	if err != nil {
+		log.Error(err, "failed to encode package document")
+		return err
+	}
+	if err := rm.Upload(ctx, pkg, bytes.NewReader(data)); err != nil {
+		return err
+	}
+	// make sure that the next request in
+	// every Refraction sees the new versions
+	for _, key := range p.pkgCache.Keys() {
+		if _, name, _ := strings.Cut(key, "/"); name == pkg {
+			p.pkgCache.Delete(key)
+		}
+	}
+	if p.repos != nil {
+		_ = p.repos.NPMPackageRepo.Expire(ctx, pkg)
+	}
+	return nil
+}
+
+// getHostedPackage retrieves the package document from a
+// hosted remote, or creates a new one if the package
+// hasn't been published before.
+func (p *Provider) getHostedPackage(ctx context.Context, rm *remote.BackedRemote, pkg string) (map[string]any, error) {
+	log := logr.FromContextOrDiscard(ctx).WithName("npm").WithValues("Package", pkg, "Remote", rm.Model().Name)
+	packument := map[string]any{
+		"_id":  pkg,
+		"name": pkg,
+	}
+	if r, err := rm.Download(ctx, pkg, &schemas.RequestContext{}); err == nil {
+		defer r.Close()
+		if err := json.NewDecoder(r).Decode(&packument); err != nil {
+			log.Error(err, "failed to decode existing package document")
+			return nil, err
+		}
+	} else {
+		log.V(1).Info("creating new package document")
+	}
+	for _, k := range []string{"versions", "dist-tags", "time"} {
+		if _, ok := packument[k].(map[string]any); !ok {
+			packument[k] = map[string]any{}
+		}
+	}
+	return packument, nil
+}
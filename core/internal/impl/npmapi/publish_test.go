@@ -0,0 +1,118 @@
+package npmapi
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/quota"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func publishRequest(pkg, version, tarball string) string {
+	h := sha1.Sum([]byte(tarball))
+	return fmt.Sprintf(`{
+  "_id": "%[1]s",
+  "name": "%[1]s",
+  "description": "a test package",
+  "dist-tags": {"latest": "%[2]s"},
+  "versions": {"%[2]s": {"name": "%[1]s", "version": "%[2]s", "dist": {"shasum": "%[3]s", "tarball": "http://localhost/%[1]s/-/%[1]s-%[2]s.tgz"}}},
+  "_attachments": {"%[1]s-%[2]s.tgz": {"content_type": "application/octet-stream", "data": "%[4]s", "length": %[5]d}}
+}`, pkg, version, hex.EncodeToString(h[:]), base64.StdEncoding.EncodeToString([]byte(tarball)), len(tarball))
+}
+
+func TestProvider_Publish(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	getPkg := func(ctx context.Context, file string) (string, error) {
+		return "", nil
+	}
+	store := storage.NewNoOp()
+	rm := remote.NewBackedRemote(ctx, &model.Remote{
+		Name:      "npm-hosted",
+		Archetype: model.ArchetypeNpm,
+		Hosted:    true,
+		Security:  &model.RemoteSecurity{},
+	}, store, &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+	p := NewProvider(nil, "https://prism.example.org", remote.NewMemoryLocker())
+
+	require.NoError(t, p.Publish(ctx, rm, "@prism/test", strings.NewReader(publishRequest("@prism/test", "1.0.0", "v1"))))
+	require.NoError(t, p.Publish(ctx, rm, "@prism/test", strings.NewReader(publishRequest("@prism/test", "1.1.0", "v2"))))
+	assert.EqualValues(t, "v1", string(store.Data["npm-hosted/@prism/test/-/test-1.0.0.tgz"]))
+	assert.EqualValues(t, "v2", string(store.Data["npm-hosted/@prism/test/-/test-1.1.0.tgz"]))
+
+	r, err := rm.Download(ctx, "@prism/test", &schemas.RequestContext{})
+	require.NoError(t, err)
+	var doc struct {
+		Description string            `json:"description"`
+		DistTags    map[string]string `json:"dist-tags"`
+		Versions    map[string]struct {
+			Dist struct {
+				Tarball string `json:"tarball"`
+			} `json:"dist"`
+		} `json:"versions"`
+		Attachments map[string]any `json:"_attachments"`
+	}
+	require.NoError(t, json.NewDecoder(r).Decode(&doc))
+	assert.EqualValues(t, "a test package", doc.Description)
+	assert.EqualValues(t, "1.1.0", doc.DistTags["latest"])
+	assert.Len(t, doc.Versions, 2)
+	assert.EqualValues(t, "hosted://npm-hosted/@prism/test/-/test-1.0.0.tgz", doc.Versions["1.0.0"].Dist.Tarball)
+	assert.Empty(t, doc.Attachments)
+
+	t.Run("cached documents are cleared in every refraction", func(t *testing.T) {
+		p.pkgCache.Set(packageKey("foo", "@prism/test"), "{}", ttlcache.DefaultTTL)
+		p.pkgCache.Set(packageKey("bar", "@prism/test"), "{}", ttlcache.DefaultTTL)
+		p.pkgCache.Set(packageKey("foo", "@prism/other"), "{}", ttlcache.DefaultTTL)
+		require.NoError(t, p.Publish(ctx, rm, "@prism/test", strings.NewReader(publishRequest("@prism/test", "1.2.0", "v6"))))
+		assert.Nil(t, p.pkgCache.Get(packageKey("foo", "@prism/test")))
+		assert.Nil(t, p.pkgCache.Get(packageKey("bar", "@prism/test")))
+		assert.NotNil(t, p.pkgCache.Get(packageKey("foo", "@prism/other")))
+	})
+	t.Run("existing version", func(t *testing.T) {
+		err := p.Publish(ctx, rm, "@prism/test", strings.NewReader(publishRequest("@prism/test", "1.0.0", "v3")))
+		var prob *problem.ProblemDetails
+		require.ErrorAs(t, err, &prob)
+		assert.EqualValues(t, http.StatusConflict, prob.Status)
+		assert.EqualValues(t, "v1", string(store.Data["npm-hosted/@prism/test/-/test-1.0.0.tgz"]))
+	})
+	t.Run("mismatched shasum", func(t *testing.T) {
+		req := strings.Replace(publishRequest("prism-test", "1.0.0", "v1"), base64.StdEncoding.EncodeToString([]byte("v1")), base64.StdEncoding.EncodeToString([]byte("v4")), 1)
+		err := p.Publish(ctx, rm, "prism-test", strings.NewReader(req))
+		var prob *problem.ProblemDetails
+		require.ErrorAs(t, err, &prob)
+		assert.EqualValues(t, http.StatusBadRequest, prob.Status)
+	})
+	t.Run("mismatched name", func(t *testing.T) {
+		err := p.Publish(ctx, rm, "@prism/test", strings.NewReader(publishRequest("@prism/other", "2.0.0", "v5")))
+		var prob *problem.ProblemDetails
+		require.ErrorAs(t, err, &prob)
+		assert.EqualValues(t, http.StatusBadRequest, prob.Status)
+		assert.NotContains(t, store.Data, "npm-hosted/@prism/test/-/test-2.0.0.tgz")
+		assert.NotContains(t, store.Data, "npm-hosted/@prism/test/-/other-2.0.0.tgz")
+	})
+	t.Run("mismatched version name", func(t *testing.T) {
+		req := strings.Replace(publishRequest("@prism/test", "2.0.0", "v5"), `{"name": "@prism/test"`, `{"name": "@prism/other"`, 1)
+		err := p.Publish(ctx, rm, "@prism/test", strings.NewReader(req))
+		var prob *problem.ProblemDetails
+		require.ErrorAs(t, err, &prob)
+		assert.EqualValues(t, http.StatusBadRequest, prob.Status)
+	})
+}
@@ -0,0 +1,118 @@
+package npmapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"gitlab.com/go-prism/prism3/core/pkg/versions"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"strings"
+)
+
+// integrityAlgorithms are the algorithms that we accept
+// from the "integrity" field, strongest first.
+var integrityAlgorithms = []string{"sha512", "sha384", "sha256"}
+
+// IsTarball returns true if the path
+// refers to the tarball of a version.
+func IsTarball(path string) bool {
+	_, _, ok := versions.Parse(model.ArchetypeNpm, strings.TrimPrefix(path, "/"))
+	return ok
+}
+
+// Tarball downloads the tarball of a version. The digest
+// from the package document of the Refraction is given
+// to the remote, so that the tarball is checked before
+// it is served or cached.
+func (p *Provider) Tarball(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_npm_tarball", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("npm").WithValues("Path", path, "Refraction", ref.String())
+	// find the best location for the file
+	msg, err := ref.Exists(ctx, path, rctx)
+	if err != nil {
+		return nil, err
+	}
+	target := msg.URI
+	if want := p.integrity(ctx, ref, path); want != nil {
+		if _, existing := remote.SplitDigest(target); existing == nil {
+			target += "#" + want.String()
+		}
+	} else {
+		log.V(1).Info("unable to verify tarball as its digest is unknown")
+	}
+	// make sure to clone the request context
+	// otherwise remotes will overwrite each other
+	return msg.Remote.Download(ctx, target, rctx.Clone())
+}
+
+// integrity returns the digest that the package
+// document declares for the tarball, if any.
+func (p *Provider) integrity(ctx context.Context, ref *refract.Refraction, path string) *remote.Digest {
+	pkg, version, ok := versions.Parse(model.ArchetypeNpm, strings.TrimPrefix(path, "/"))
+	if !ok || p.repos == nil {
+		return nil
+	}
+	data, err := p.repos.NPMPackageRepo.GetPackageVersion(ctx, ref.String(), pkg, version)
+	if err != nil || data == "" {
+		return nil
+	}
+	var manifest struct {
+		Dist struct {
+			Integrity string `json:"integrity"`
+			Shasum    string `json:"shasum"`
+		} `json:"dist"`
+	}
+	if err := json.Unmarshal([]byte(data), &manifest); err != nil {
+		logr.FromContextOrDiscard(ctx).V(1).Info("failed to parse version manifest", "Package", pkg, "Version", version, "Error", err.Error())
+		return nil
+	}
+	return parseIntegrity(manifest.Dist.Integrity, manifest.Dist.Shasum)
+}
+
+// parseIntegrity converts the Subresource Integrity string
+// of a tarball (e.g., "sha512-<base64>") into a digest.
+// The strongest hash is used, falling back to the
+// SHA-1 "shasum" if there isn't one.
+//
+// https://w3c.github.io/webappsec-subresource-integrity/
+func parseIntegrity(integrity, shasum string) *remote.Digest {
+	hashes := map[string]string{}
+	for _, v := range strings.Fields(integrity) {
+		alg, val, ok := strings.Cut(v, "-")
+		if !ok {
+			continue
+		}
+		// options (e.g., "?foo") aren't part of the hash
+		val, _, _ = strings.Cut(val, "?")
+		sum, err := base64.StdEncoding.DecodeString(val)
+		if err != nil {
+			continue
+		}
+		hashes[strings.ToLower(alg)] = hex.EncodeToString(sum)
+	}
+	for _, alg := range integrityAlgorithms {
+		if val, ok := hashes[alg]; ok {
+			if d, ok := remote.ParseDigest(alg + "=" + val); ok {
+				return d
+			}
+		}
+	}
+	if d, ok := remote.ParseDigest("sha1=" + shasum); ok {
+		return d
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package npmapi
+
+import (
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseIntegrity(t *testing.T) {
+	sha512Sum := sha512.Sum512([]byte("prism"))
+	sha1Sum := sha1.Sum([]byte("prism"))
+	integrity := "sha512-" + base64.StdEncoding.EncodeToString(sha512Sum[:])
+	shasum := hex.EncodeToString(sha1Sum[:])
+
+	var cases = []struct {
+		name      string
+		integrity string
+		shasum    string
+		want      string
+	}{
+		{
+			"integrity is preferred",
+			integrity,
+			shasum,
+			"sha512=" + hex.EncodeToString(sha512Sum[:]),
+		},
+		{
+			"strongest hash is used",
+			"sha1-" + base64.StdEncoding.EncodeToString(sha1Sum[:]) + " " + integrity + "?foo",
+			"",
+			"sha512=" + hex.EncodeToString(sha512Sum[:]),
+		},
+		{
+			"shasum is used as a fallback",
+			"",
+			shasum,
+			"sha1=" + shasum,
+		},
+		{
+			"malformed integrity falls back",
+			"sha512-???",
+			shasum,
+			"sha1=" + shasum,
+		},
+		{
+			"no digest",
+			"",
+			"",
+			"",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := parseIntegrity(tt.integrity, tt.shasum)
+			if tt.want == "" {
+				assert.Nil(t, d)
+				return
+			}
+			assert.EqualValues(t, tt.want, d.String())
+		})
+	}
+}
+
+func TestIsTarball(t *testing.T) {
+	assert.True(t, IsTarball("/lodash/-/lodash-4.17.21.tgz"))
+	assert.True(t, IsTarball("@types/node/-/node-18.0.0.tgz"))
+	assert.False(t, IsTarball("lodash"))
+}
@@ -3,6 +3,19 @@ package npmapi
 import (
 	"github.com/jellydator/ttlcache/v3"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"sync"
+	"time"
+)
+
+const (
+	// maxAge is how long a package document is
+	// served without asking the remotes for changes.
+	maxAge = time.Minute * 5
+	// staleWhileRevalidate is how long an old package
+	// document is served while it is refreshed in
+	// the background.
+	staleWhileRevalidate = time.Hour * 24
 )
 
 type Provider struct {
@@ -12,4 +25,19 @@ type Provider struct {
 	// caches
 	pkgCache        *ttlcache.Cache[string, string]
 	pkgVersionCache *ttlcache.Cache[string, string]
+
+	// locker makes sure that only one publish
+	// updates a package document at a time
+	locker remote.Locker
+	// revalidating holds the packages that are
+	// being refreshed in the background
+	revalidating sync.Map
+}
+
+// Attachment is a tarball that is included
+// in an "npm publish" request.
+type Attachment struct {
+	ContentType string `json:"content_type"`
+	Data        string `json:"data"`
+	Length      int    `json:"length"`
 }
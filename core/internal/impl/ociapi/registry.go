@@ -0,0 +1,142 @@
+package ociapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	MediaTypeImageIndex    = "application/vnd.oci.image.index.v1+json"
+	MediaTypeImageManifest = "application/vnd.oci.image.manifest.v1+json"
+
+	digestPrefix = "sha256:"
+)
+
+func NewProvider() *Provider {
+	return &Provider{
+		// tags can be moved at any time, so
+		// only keep them for a short time
+		tagCache: ttlcache.New[string, []byte](ttlcache.WithCapacity[string, []byte](1000), ttlcache.WithTTL[string, []byte](time.Minute)),
+	}
+}
+
+// IsManifest returns true if the path
+// refers to a manifest.
+func IsManifest(path string) bool {
+	_, kind, _, ok := remote.ParseOCIPath(path)
+	return ok && kind == remote.OCIManifests
+}
+
+// IsBlob returns true if the path
+// refers to a blob.
+func IsBlob(path string) bool {
+	_, ok := remote.BlobDigest(path)
+	return ok
+}
+
+// Digest returns the digest of the given
+// content in the form of "sha256:<hex>".
+func Digest(data []byte) string {
+	h := sha256.Sum256(data)
+	return digestPrefix + hex.EncodeToString(h[:])
+}
+
+// MediaType determines the media type of a manifest.
+// Older manifests may not contain the mediaType field,
+// in which case we infer it from the content.
+func MediaType(data []byte) string {
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return MediaTypeImageManifest
+	}
+	if m.MediaType != "" {
+		return m.MediaType
+	}
+	if m.Manifests != nil {
+		return MediaTypeImageIndex
+	}
+	return MediaTypeImageManifest
+}
+
+// Manifest retrieves a manifest by its tag or digest. Manifests
+// requested by digest are verified against that digest.
+func (p *Provider) Manifest(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_oci_manifest", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("oci").WithValues("Path", path, "Refraction", ref.String())
+	log.Info("retrieving manifest")
+	_, _, reference, _ := remote.ParseOCIPath(path)
+	isDigest := strings.HasPrefix(reference, digestPrefix)
+	span.SetAttributes(attribute.Bool("digest", isDigest))
+	// a tag may point to different content
+	// depending on what the user is able to see
+	key := ref.String() + "/" + strings.TrimPrefix(path, "/")
+	if rctx != nil {
+		key += rctx.Token
+	}
+	if !isDigest {
+		if item := p.tagCache.Get(key); item != nil {
+			log.V(1).Info("found manifest in cache")
+			return bytes.NewReader(item.Value()), nil
+		}
+	}
+	r, err := ref.Download(ctx, path, rctx)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		log.Error(err, "failed to read manifest")
+		return nil, err
+	}
+	if isDigest {
+		if digest := Digest(data); digest != reference {
+			log.Info("manifest does not match the requested digest", "Digest", digest)
+			return nil, problem.Errorf(http.StatusBadGateway, "manifest digest mismatch")
+		}
+		return bytes.NewReader(data), nil
+	}
+	p.tagCache.Set(key, data, ttlcache.DefaultTTL)
+	return bytes.NewReader(data), nil
+}
+
+// Blob retrieves a blob by its digest. Blobs are
+// cached in storage by the remote that provides them.
+func (p *Provider) Blob(ctx context.Context, ref *refract.Refraction, path, method string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_oci_blob", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("oci").WithValues("Path", path, "Refraction", ref.String())
+	if method == http.MethodHead {
+		log.V(1).Info("checking for blob")
+		if _, err := ref.Exists(ctx, path, rctx); err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(nil), nil
+	}
+	log.Info("retrieving blob")
+	return ref.Download(ctx, path, rctx)
+}
@@ -0,0 +1,78 @@
+package ociapi
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testManifest = `{"schemaVersion":2,"mediaType":"application/vnd.docker.distribution.manifest.v2+json"}`
+
+func TestMediaType(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out string
+	}{
+		{testManifest, "application/vnd.docker.distribution.manifest.v2+json"},
+		{`{"schemaVersion":2,"manifests":[]}`, MediaTypeImageIndex},
+		{`{"schemaVersion":2,"layers":[]}`, MediaTypeImageManifest},
+	}
+	for _, tt := range cases {
+		t.Run(tt.out, func(t *testing.T) {
+			assert.EqualValues(t, tt.out, MediaType([]byte(tt.in)))
+		})
+	}
+}
+
+func TestProvider_Manifest(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.New(t))
+	digest := Digest([]byte(testManifest))
+	var count int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/foo/manifests/latest", "/v2/foo/manifests/" + digest:
+			if r.Method == http.MethodGet {
+				count++
+			}
+			_, _ = w.Write([]byte(testManifest))
+		case "/v2/foo/manifests/sha256:0000":
+			_, _ = w.Write([]byte(testManifest))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	ref := refract.NewSimple(ctx, "test", []remote.Remote{remote.NewOCIRemote(ctx, ts.URL, ts.Client())})
+	p := NewProvider()
+
+	t.Run("tags are cached", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			r, err := p.Manifest(ctx, ref, "foo/manifests/latest", &schemas.RequestContext{})
+			assert.NoError(t, err)
+			data, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.EqualValues(t, testManifest, string(data))
+		}
+		assert.EqualValues(t, 1, count)
+	})
+	t.Run("digest is verified", func(t *testing.T) {
+		_, err := p.Manifest(ctx, ref, "foo/manifests/"+digest, &schemas.RequestContext{})
+		assert.NoError(t, err)
+
+		_, err = p.Manifest(ctx, ref, "foo/manifests/sha256:0000", &schemas.RequestContext{})
+		assert.Error(t, err)
+	})
+	t.Run("missing manifest", func(t *testing.T) {
+		_, err := p.Manifest(ctx, ref, "bar/manifests/latest", &schemas.RequestContext{})
+		assert.Error(t, err)
+	})
+}
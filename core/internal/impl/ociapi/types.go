@@ -0,0 +1,18 @@
+package ociapi
+
+import (
+	"github.com/jellydator/ttlcache/v3"
+)
+
+type Provider struct {
+	// caches
+	tagCache *ttlcache.Cache[string, []byte]
+}
+
+// Manifest contains the fields that we need
+// to determine the media type of a manifest.
+type Manifest struct {
+	MediaType string `json:"mediaType"`
+	// Manifests is only present on an index
+	Manifests []any `json:"manifests"`
+}
@@ -0,0 +1,13 @@
+<!DOCTYPE html>
+<html>
+    <head>
+        <meta name="pypi:repository-version" content="1.0">
+        <title>Links for {{ .Package }}</title>
+    </head>
+    <body>
+        <h1>Links for {{ .Package }}</h1>
+        {{- range .Items }}
+        <a href="{{ .URL }}"{{if .RequiresPython }} data-requires-python="{{ .RequiresPython }}"{{end }}>{{ .Filename }}</a><br/>
+        {{- end }}
+    </body>
+</html>
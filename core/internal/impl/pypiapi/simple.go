@@ -4,59 +4,186 @@ import (
 	"bytes"
 	"context"
 	_ "embed"
+	"encoding/json"
 	"fmt"
 	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/quarantine"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"gitlab.com/go-prism/prism3/core/pkg/versions"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/html"
 	"html/template"
 	"io"
+	"net/http"
+	"strings"
 	"sync"
+	"time"
 )
 
 //go:embed index.html.tpl
 var indexTemplate string
 
-func NewProvider(repos *repo.Repos, publicURL string) *Provider {
+func NewProvider(repos *repo.Repos, publicURL string, locker remote.Locker) *Provider {
 	return &Provider{
 		publicURL: publicURL,
 		repos:     repos,
+		locker:    locker,
 	}
 }
 
-func (p *Provider) Index(ctx context.Context, ref *refract.Refraction, pkg string) (io.Reader, error) {
+// Index returns the simple page of a package. Files that
+// are hidden by the quarantine policy or affected by a
+// known vulnerability are left out.
+func (p *Provider) Index(ctx context.Context, ref *refract.Refraction, pkg string, pol *quarantine.Policy, adv *advisory.Policy) (io.Reader, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_pypi_index", trace.WithAttributes(
 		attribute.String("package", pkg),
 	))
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithName("pypi").WithValues("Package", pkg, "Refraction", ref.String())
 	log.Info("retrieving PyPi package manifest")
-	items := p.fetch(ctx, ref, pkg)
+	if ref.Offline() {
+		log.Info("serving stored PyPi index as the refraction is offline")
+		doc, _, err := p.repos.PyPackageRepo.GetIndex(ctx, ref.String(), pkg)
+		if err != nil {
+			return nil, err
+		}
+		return &refract.Stale{Reader: strings.NewReader(doc), Warning: refract.WarningDisconnected}, nil
+	}
+	doc, updated, err := p.repos.PyPackageRepo.GetIndex(ctx, ref.String(), pkg)
+	age := time.Since(updated)
+	switch {
+	case err == nil && age < maxAge:
+		log.V(1).Info("serving fresh PyPi index from the database", "Age", age)
+		return strings.NewReader(doc), nil
+	case err == nil && age < staleWhileRevalidate:
+		// serve what we have and refresh it in the
+		// background, so the client isn't kept waiting
+		log.Info("serving stale PyPi index while it is refreshed", "Age", age)
+		p.revalidate(ctx, ref, pkg, pol, adv)
+		return strings.NewReader(doc), nil
+	}
+	items, ok := p.fetch(ctx, ref, pkg, pol)
+	if !ok {
+		if err == nil {
+			log.Info("serving stale PyPi index as no remote could be reached", "Age", age)
+			return &refract.Stale{Reader: strings.NewReader(doc), Warning: refract.WarningRevalidationFailed}, nil
+		}
+		return nil, problem.New(http.StatusNotFound).Errorf("package could not be found in any remote")
+	}
+	data, err := p.render(ctx, ref, pkg, items, pol, adv)
+	if err != nil {
+		return nil, err
+	}
+	return strings.NewReader(data), nil
+}
 
+// render templates the index of a package and saves
+// it so that it can be served if the remotes
+// are unreachable.
+func (p *Provider) render(ctx context.Context, ref *refract.Refraction, pkg string, items []*schemas.PyPackage, pol *quarantine.Policy, adv *advisory.Policy) (string, error) {
+	log := logr.FromContextOrDiscard(ctx).WithName("pypi").WithValues("Package", pkg, "Refraction", ref.String())
+	items = p.quarantine(ctx, pkg, items, pol)
+	items, err := p.vulnerable(ctx, pkg, items, adv)
+	if err != nil {
+		log.Error(err, "failed to apply advisories to PyPi index")
+		return "", err
+	}
 	// template our response
 	idx := Index{Package: pkg, Items: items, PublicURL: p.publicURL, Ref: ref.String()}
 	tmpl := template.Must(template.New("index").Parse(indexTemplate))
 	buf := bytes.NewBuffer(nil)
 	if err := tmpl.Execute(buf, idx); err != nil {
 		log.Error(err, "failed to generate index.html template")
-		return nil, err
+		return "", err
 	}
-	return bytes.NewReader(buf.Bytes()), nil
+	_ = p.repos.PyPackageRepo.SaveIndex(ctx, ref.String(), pkg, buf.String())
+	return buf.String(), nil
 }
 
-func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, pkg string) []*schemas.PyPackage {
+// revalidate regenerates the index of a package in the
+// background. Only one refresh of a package runs at a time.
+func (p *Provider) revalidate(ctx context.Context, ref *refract.Refraction, pkg string, pol *quarantine.Policy, adv *advisory.Policy) {
+	key := ref.String() + "/" + pkg
+	if _, loaded := p.revalidating.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+	// the request context is cancelled once
+	// the response has been sent
+	ctx = logr.NewContext(context.Background(), logr.FromContextOrDiscard(ctx))
+	go func() {
+		defer p.revalidating.Delete(key)
+		if items, ok := p.fetch(ctx, ref, pkg, pol); ok {
+			_, _ = p.render(ctx, ref, pkg, items, pol, adv)
+		}
+	}()
+}
+
+// fetch downloads the index of a package from the
+// remotes. It returns false if none of the remotes
+// could provide the index.
+func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, pkg string, pol *quarantine.Policy) ([]*schemas.PyPackage, bool) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_pypi_fetch", trace.WithAttributes(
 		attribute.String("package", pkg),
 	))
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithName("pypi").WithValues("Package", pkg, "Refraction", ref.String())
-	remotes := ref.Remotes()
+	remotes, ordered := ref.Candidates(pkg)
 	var items []*schemas.PyPackage
+	var found bool
+
+	download := func(rem remote.Remote) ([]*schemas.PyPackage, error) {
+		// todo support request context
+		resp, err := rem.Download(ctx, fmt.Sprintf("/%s/", pkg), &schemas.RequestContext{})
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Close()
+		packages, err := p.parse(ctx, pkg, resp)
+		if err != nil {
+			return nil, err
+		}
+		// the simple API doesn't tell us when
+		// files were uploaded
+		if pol.Enabled() {
+			times := p.uploadTimes(ctx, rem, pkg)
+			for _, pack := range packages {
+				if t, ok := times[pack.Filename]; ok {
+					pack.UploadTime = &t
+				}
+			}
+		}
+		// save the packages
+		_ = p.repos.PyPackageRepo.BatchInsert(ctx, packages)
+		return packages, nil
+	}
+	// only use the first remote that has the package,
+	// so that a public index can't shadow it
+	if ordered {
+		log.Info("fetching PyPi metadata from the first remote that has it", "Count", len(remotes))
+		for i := range remotes {
+			packages, err := download(remotes[i])
+			if len(packages) > 0 {
+				return packages, true
+			}
+			// we can only move on if the remote
+			// definitely doesn't have the package
+			if err != nil && !refract.IsNotFound(err) {
+				log.Info("preferred remote could not be checked", "Remote", remotes[i].String(), "Error", err.Error())
+				return nil, found
+			}
+			found = found || err == nil
+		}
+		return nil, found
+	}
 
 	// create a mutex so we
 	// can safely collect package info
@@ -69,26 +196,121 @@ func (p *Provider) fetch(ctx context.Context, ref *refract.Refraction, pkg strin
 		// download the document
 		go func() {
 			defer wg.Done()
-			// todo support request context
-			resp, err := remotes[j].Download(ctx, fmt.Sprintf("/%s/", pkg), &schemas.RequestContext{})
-			if err != nil {
-				return
-			}
-			packages, err := p.parse(ctx, pkg, resp)
-			if err != nil {
-				return
-			}
-			// save the packages
-			_ = p.repos.PyPackageRepo.BatchInsert(ctx, packages)
+			packages, err := download(remotes[j])
 			// add our packages to the list
 			s.Lock()
 			items = append(items, packages...)
+			found = found || err == nil
 			s.Unlock()
 		}()
 	}
 	// wait for all responses
 	wg.Wait()
-	return items
+	return items, found
+}
+
+// quarantine removes the files that were
+// uploaded too recently to be served.
+func (p *Provider) quarantine(ctx context.Context, pkg string, items []*schemas.PyPackage, pol *quarantine.Policy) []*schemas.PyPackage {
+	if !pol.Enabled() {
+		return items
+	}
+	// files from hosted remotes (or ones that were
+	// fetched before) may only have their upload
+	// time in the database
+	var times map[string]time.Time
+	if p.repos != nil {
+		times, _ = p.repos.PyPackageRepo.GetUploadTimes(ctx, pkg)
+	}
+	name := Normalise(pkg)
+	allowed := make([]*schemas.PyPackage, 0, len(items))
+	for _, i := range items {
+		var published time.Time
+		if i.UploadTime != nil {
+			published = *i.UploadTime
+		} else {
+			published = times[i.Filename]
+		}
+		_, version, _ := versions.Parse(model.ArchetypePip, i.Filename)
+		if pol.Allow(ctx, model.ArchetypePip, name, version, published) {
+			allowed = append(allowed, i)
+		}
+	}
+	pol.Hide(ctx, model.ArchetypePip, name, len(items)-len(allowed))
+	return allowed
+}
+
+// vulnerable removes the files of versions that
+// are affected by a known vulnerability.
+func (p *Provider) vulnerable(ctx context.Context, pkg string, items []*schemas.PyPackage, adv *advisory.Policy) ([]*schemas.PyPackage, error) {
+	if !adv.Hides() {
+		return items, nil
+	}
+	m, err := adv.Match(ctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make([]*schemas.PyPackage, 0, len(items))
+	for _, i := range items {
+		_, version, _ := versions.Parse(model.ArchetypePip, i.Filename)
+		if len(m.Affects(pkg, version)) == 0 {
+			allowed = append(allowed, i)
+		}
+	}
+	adv.Hide(ctx, Normalise(pkg), len(items)-len(allowed))
+	return allowed, nil
+}
+
+// uploadTimes asks the JSON API of a remote when
+// each file of a package was uploaded.
+//
+// https://warehouse.pypa.io/api-reference/json.html
+func (p *Provider) uploadTimes(ctx context.Context, rem remote.Remote, pkg string) map[string]time.Time {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_pypi_uploadTimes", trace.WithAttributes(
+		attribute.String("package", pkg),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("pypi").WithValues("Package", pkg)
+	br, ok := rem.(*remote.BackedRemote)
+	if !ok || br.Model().Hosted {
+		return nil
+	}
+	target := jsonURL(br.Model().URI, pkg)
+	if target == "" {
+		log.Info("unable to locate JSON API of remote, files without an upload time will be quarantined", "Remote", br.Model().Name)
+		return nil
+	}
+	resp, err := rem.Download(ctx, target, &schemas.RequestContext{})
+	if err != nil {
+		log.Info("failed to retrieve upload times", "Url", target, "Error", err.Error())
+		return nil
+	}
+	defer resp.Close()
+	var project Project
+	if err := json.NewDecoder(resp).Decode(&project); err != nil {
+		log.Error(err, "failed to decode JSON API response", "Url", target)
+		return nil
+	}
+	times := map[string]time.Time{}
+	for _, files := range project.Releases {
+		for _, f := range files {
+			if t, err := time.Parse(time.RFC3339, f.UploadTime); err == nil {
+				times[f.Filename] = t
+			}
+		}
+	}
+	log.V(1).Info("retrieved upload times", "Count", len(times))
+	return times
+}
+
+// jsonURL returns the JSON API address of a package,
+// which sits next to the simple API of the index.
+func jsonURL(root, pkg string) string {
+	base, ok := strings.CutSuffix(strings.TrimSuffix(root, "/"), "/simple")
+	if !ok || !strings.HasPrefix(base, "https://") {
+		return ""
+	}
+	return fmt.Sprintf("%s/pypi/%s/json", base, pkg)
 }
 
 func (p *Provider) parse(ctx context.Context, pkg string, r io.Reader) ([]*schemas.PyPackage, error) {
@@ -5,10 +5,13 @@ import (
 	"context"
 	_ "embed"
 	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/quarantine"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"html/template"
 	"strings"
 	"testing"
+	"time"
 )
 
 //go:embed testdata/requests.html
@@ -46,3 +49,46 @@ func TestProvider_Index(t *testing.T) {
 	assert.NoError(t, err)
 	t.Log(buf.String())
 }
+
+func TestJsonURL(t *testing.T) {
+	var cases = []struct {
+		root string
+		out  string
+	}{
+		{"https://pypi.org/simple", "https://pypi.org/pypi/requests/json"},
+		{"https://pypi.org/simple/", "https://pypi.org/pypi/requests/json"},
+		{"https://example.org/pypi/simple", "https://example.org/pypi/pypi/requests/json"},
+		{"https://example.org/packages", ""},
+		{"http://pypi.org/simple", ""},
+	}
+	for _, tt := range cases {
+		t.Run(tt.root, func(t *testing.T) {
+			assert.EqualValues(t, tt.out, jsonURL(tt.root, "requests"))
+		})
+	}
+}
+
+func TestProvider_quarantine(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-time.Hour * 24 * 30)
+	items := []*schemas.PyPackage{
+		{Name: "Requests", Filename: "requests-2.28.0.tar.gz", UploadTime: &old},
+		{Name: "Requests", Filename: "requests-2.29.0-py3-none-any.whl", UploadTime: &now},
+		{Name: "Requests", Filename: "requests-2.29.0.tar.gz", UploadTime: &now},
+		{Name: "Requests", Filename: "requests-2.30.0.tar.gz", UploadTime: &now},
+		{Name: "Requests", Filename: "requests-2.31.0.tar.gz"},
+	}
+	p := new(Provider)
+
+	out := p.quarantine(context.TODO(), "Requests", items, nil)
+	assert.Len(t, out, 5)
+
+	pol := quarantine.New(7, now, []*model.QuarantineExemption{{Package: "requests", Version: "2.30.0"}})
+	out = p.quarantine(context.TODO(), "Requests", items, pol)
+	var files []string
+	for _, i := range out {
+		files = append(files, i.Filename)
+	}
+	// files without an upload time are hidden
+	assert.ElementsMatch(t, []string{"requests-2.28.0.tar.gz", "requests-2.30.0.tar.gz"}, files)
+}
@@ -2,7 +2,21 @@ package pypiapi
 
 import (
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"html/template"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// maxAge is how long an index is served
+	// without asking the remotes for changes.
+	maxAge = time.Minute * 5
+	// staleWhileRevalidate is how long an old index
+	// is served while it is refreshed in the background.
+	staleWhileRevalidate = time.Hour * 24
 )
 
 type Index struct {
@@ -12,7 +26,53 @@ type Index struct {
 	Items     []*schemas.PyPackage
 }
 
+// hostedIndex is the simple page of a
+// package in a hosted remote.
+type hostedIndex struct {
+	Package string
+	Items   []hostedItem
+}
+
+type hostedItem struct {
+	Filename string
+	// URL points into the hosted remote, which
+	// html/template would otherwise consider unsafe
+	URL            template.URL
+	RequiresPython string
+}
+
+// Upload is a file sent to the legacy
+// upload API (e.g., by twine).
+//
+// https://warehouse.pypa.io/api-reference/legacy.html#upload-api
+type Upload struct {
+	Name           string
+	Version        string
+	Filename       string
+	SHA256         string
+	RequiresPython string
+	Content        io.Reader
+}
+
+// Project is the subset of the JSON API
+// response that we use.
+//
+// https://warehouse.pypa.io/api-reference/json.html#project
+type Project struct {
+	Releases map[string][]struct {
+		Filename   string `json:"filename"`
+		UploadTime string `json:"upload_time_iso_8601"`
+	} `json:"releases"`
+}
+
 type Provider struct {
 	publicURL string
 	repos     *repo.Repos
+
+	// locker makes sure that only one upload
+	// updates a simple page at a time
+	locker remote.Locker
+	// revalidating holds the packages that are
+	// being refreshed in the background
+	revalidating sync.Map
 }
@@ -0,0 +1,133 @@
+package pypiapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"html/template"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+//go:embed hosted.html.tpl
+var hostedTemplate string
+
+var regexNormalise = regexp.MustCompile(`[-_.]+`)
+
+// Normalise converts a package name into
+// the form used by the simple API.
+//
+// https://peps.python.org/pep-0503/#normalized-names
+func Normalise(name string) string {
+	return strings.ToLower(regexNormalise.ReplaceAllString(name, "-"))
+}
+
+// Upload saves a distribution file to a hosted remote and
+// adds it to the simple page of the package. Files that
+// have already been uploaded cannot be replaced.
+func (p *Provider) Upload(ctx context.Context, rm *remote.BackedRemote, upload *Upload) error {
+	pkg := Normalise(upload.Name)
+	filename := path.Base(upload.Filename)
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_pypi_upload", trace.WithAttributes(
+		attribute.String("package", pkg),
+		attribute.String("filename", filename),
+		attribute.String("remote", rm.Model().Name),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("pypi").WithValues("Package", pkg, "Filename", filename, "Remote", rm.Model().Name)
+	log.Info("uploading PyPi package")
+	if pkg == "" || filename == "" || filename == "." || filename == "/" {
+		return problem.New(http.StatusBadRequest).Errorf("upload must contain a package name and file")
+	}
+
+	data, err := io.ReadAll(upload.Content)
+	if err != nil {
+		log.Error(err, "failed to read upload")
+		return err
+	}
+	h := sha256.Sum256(data)
+	digest := hex.EncodeToString(h[:])
+	if upload.SHA256 != "" && !strings.EqualFold(upload.SHA256, digest) {
+		log.Info("rejecting upload with mismatched digest", "Expected", upload.SHA256, "Actual", digest)
+		return problem.New(http.StatusBadRequest).Errorf("file does not match sha256_digest")
+	}
+
+	// only one upload can update the simple page
+	// at a time, regardless of which replica it's on
+	unlock, err := p.locker.Lock(ctx, fmt.Sprintf("pypi/upload/%s/%s", rm.Model().ID, pkg))
+	if err != nil {
+		log.Error(err, "failed to lock simple page")
+		return err
+	}
+	defer unlock()
+
+	var items []*schemas.PyPackage
+	if r, err := rm.Download(ctx, fmt.Sprintf("/%s/", pkg), &schemas.RequestContext{}); err == nil {
+		defer r.Close()
+		items, err = p.parse(ctx, pkg, r)
+		if err != nil {
+			return err
+		}
+	}
+	for _, i := range items {
+		if i.Filename == filename {
+			log.Info("rejecting attempt to replace an existing file")
+			return problem.New(http.StatusConflict).Errorf("file already exists")
+		}
+	}
+	if err := rm.Upload(ctx, fmt.Sprintf("%s/%s", pkg, filename), bytes.NewReader(data)); err != nil {
+		return err
+	}
+	now := time.Now()
+	item := &schemas.PyPackage{
+		Name:           pkg,
+		Filename:       filename,
+		URL:            fmt.Sprintf("%s/%s/%s#sha256=%s", rm.String(), pkg, filename, digest),
+		RequiresPython: upload.RequiresPython,
+		UploadTime:     &now,
+	}
+	items = append(items, item)
+
+	// template the new simple page
+	idx := hostedIndex{Package: pkg}
+	for _, i := range items {
+		idx.Items = append(idx.Items, hostedItem{
+			Filename:       i.Filename,
+			URL:            template.URL(i.URL),
+			RequiresPython: i.RequiresPython,
+		})
+	}
+	tmpl := template.Must(template.New("hosted").Parse(hostedTemplate))
+	buf := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(buf, idx); err != nil {
+		log.Error(err, "failed to generate index.html template")
+		return err
+	}
+	if err := rm.Upload(ctx, fmt.Sprintf("%s/", pkg), buf); err != nil {
+		return err
+	}
+	// make sure that the next request
+	// sees the new file
+	if p.repos != nil {
+		// record when the file was uploaded
+		// so that it can be quarantined
+		_ = p.repos.PyPackageRepo.BatchInsert(ctx, []*schemas.PyPackage{item})
+		_ = p.repos.PyPackageRepo.ExpireIndex(ctx, pkg)
+	}
+	return nil
+}
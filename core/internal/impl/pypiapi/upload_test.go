@@ -0,0 +1,95 @@
+package pypiapi
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/lpar/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/quota"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNormalise(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out string
+	}{
+		{"requests", "requests"},
+		{"Django_REST.framework", "django-rest-framework"},
+		{"zope--interface", "zope-interface"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.EqualValues(t, tt.out, Normalise(tt.in))
+		})
+	}
+}
+
+func TestProvider_Upload(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	getPkg := func(ctx context.Context, file string) (string, error) {
+		return "", nil
+	}
+	store := storage.NewNoOp()
+	rm := remote.NewBackedRemote(ctx, &model.Remote{
+		Name:      "pypi-hosted",
+		Archetype: model.ArchetypePip,
+		Hosted:    true,
+		Security:  &model.RemoteSecurity{},
+	}, store, &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+	p := NewProvider(nil, "https://prism.example.org", remote.NewMemoryLocker())
+
+	for _, f := range []string{"prism_test-1.0.0.tar.gz", "prism_test-1.0.0-py3-none-any.whl"} {
+		require.NoError(t, p.Upload(ctx, rm, &Upload{
+			Name:           "Prism_Test",
+			Version:        "1.0.0",
+			Filename:       f,
+			RequiresPython: ">=3.7",
+			Content:        strings.NewReader(f),
+		}))
+		assert.EqualValues(t, f, string(store.Data["pypi-hosted/prism-test/"+f]))
+	}
+
+	r, err := rm.Download(ctx, "/prism-test/", &schemas.RequestContext{})
+	require.NoError(t, err)
+	packages, err := p.parse(ctx, "prism-test", r)
+	require.NoError(t, err)
+	require.Len(t, packages, 2)
+	assert.EqualValues(t, "prism_test-1.0.0.tar.gz", packages[0].Filename)
+	assert.True(t, strings.HasPrefix(packages[0].URL, "hosted://pypi-hosted/prism-test/prism_test-1.0.0.tar.gz#sha256="))
+	assert.EqualValues(t, ">=3.7", packages[1].RequiresPython)
+
+	t.Run("existing file", func(t *testing.T) {
+		err := p.Upload(ctx, rm, &Upload{
+			Name:     "prism-test",
+			Filename: "prism_test-1.0.0.tar.gz",
+			Content:  strings.NewReader("foo"),
+		})
+		var prob *problem.ProblemDetails
+		require.ErrorAs(t, err, &prob)
+		assert.EqualValues(t, http.StatusConflict, prob.Status)
+	})
+	t.Run("mismatched digest", func(t *testing.T) {
+		err := p.Upload(ctx, rm, &Upload{
+			Name:     "prism-test",
+			Filename: "prism_test-1.0.1.tar.gz",
+			SHA256:   "0000",
+			Content:  strings.NewReader("foo"),
+		})
+		var prob *problem.ProblemDetails
+		require.ErrorAs(t, err, &prob)
+		assert.EqualValues(t, http.StatusBadRequest, prob.Status)
+	})
+}
@@ -0,0 +1,196 @@
+package rpmapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	dirRepodata = "repodata"
+	fileRepomd  = "repomd.xml"
+)
+
+func NewProvider() *Provider {
+	return &Provider{
+		// repomd.xml changes whenever the repository
+		// is updated, so only keep it for a short time
+		repomdCache: ttlcache.New[string, *Snapshot](ttlcache.WithCapacity[string, *Snapshot](100), ttlcache.WithTTL[string, *Snapshot](time.Minute)),
+		originCache: ttlcache.New[string, string](ttlcache.WithCapacity[string, string](1000), ttlcache.WithTTL[string, string](time.Hour)),
+	}
+}
+
+// IsMetadata returns true if the path refers
+// to the repomd.xml of a repository or one
+// of its signature files.
+func IsMetadata(p string) bool {
+	dir, file := path.Split(strings.TrimPrefix(p, "/"))
+	if path.Base(dir) != dirRepodata {
+		return false
+	}
+	return file == fileRepomd || strings.HasPrefix(file, fileRepomd+".")
+}
+
+// IsRepodata returns true if the path refers
+// to a file in the repodata directory that
+// is referenced by the repomd.xml.
+func IsRepodata(p string) bool {
+	dir, _ := path.Split(strings.TrimPrefix(p, "/"))
+	return path.Base(dir) == dirRepodata && !IsMetadata(p)
+}
+
+// repoRoot returns the root of the repository
+// that contains the given repodata file.
+func repoRoot(p string) string {
+	dir, _ := path.Split(strings.TrimPrefix(p, "/"))
+	return strings.TrimSuffix(strings.TrimSuffix(dir, "/"), dirRepodata)
+}
+
+func findRemote(ref *refract.Refraction, uri string) remote.Remote {
+	for _, rm := range ref.Remotes() {
+		if rm.String() == uri {
+			return rm
+		}
+	}
+	return nil
+}
+
+// Metadata serves the repomd.xml of a repository
+// and its signature. Both files are served from
+// the same remote so that they always match.
+func (p *Provider) Metadata(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_rpm_metadata", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("rpm").WithValues("Path", path, "Refraction", ref.String())
+	log.Info("retrieving RPM repository metadata")
+	root := repoRoot(path)
+	snap, err := p.getRepomd(ctx, ref, root, rctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(path, fileRepomd) {
+		return bytes.NewReader(snap.Data), nil
+	}
+	rm := findRemote(ref, snap.Remote)
+	if rm == nil {
+		return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+	}
+	log.V(1).Info("downloading signature from remote", "Remote", snap.Remote)
+	return rm.Download(ctx, path, rctx)
+}
+
+// Repodata serves a file referenced by the repomd.xml
+// from the remote that provided the repomd.xml.
+func (p *Provider) Repodata(ctx context.Context, ref *refract.Refraction, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_rpm_repodata", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("rpm").WithValues("Path", path, "Refraction", ref.String())
+	if item := p.originCache.Get(ref.String() + "/" + strings.TrimPrefix(path, "/")); item != nil {
+		if rm := findRemote(ref, item.Value()); rm != nil {
+			log.V(1).Info("downloading repodata from origin", "Remote", item.Value())
+			return rm.Download(ctx, path, rctx)
+		}
+	}
+	// the hashed repodata files are content-addressed,
+	// so it doesn't matter which remote we get them from
+	log.V(1).Info("unable to locate origin of repodata")
+	return ref.Download(ctx, path, rctx)
+}
+
+// getRepomd retrieves the repomd.xml from the cache
+// or the first remote (by order) that provides it.
+func (p *Provider) getRepomd(ctx context.Context, ref *refract.Refraction, root string, rctx *schemas.RequestContext) (*Snapshot, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "api_rpm_getRepomd", trace.WithAttributes(
+		attribute.String("root", root),
+		attribute.String("refraction", ref.String()),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("rpm").WithValues("Root", root, "Refraction", ref.String())
+	// the repomd.xml differs depending
+	// on what the user is able to see
+	key := ref.String() + "/" + root
+	if rctx != nil {
+		key += rctx.Token
+	}
+	if item := p.repomdCache.Get(key); item != nil {
+		log.V(1).Info("found repomd.xml in cache")
+		return item.Value(), nil
+	}
+	target := root + dirRepodata + "/" + fileRepomd
+	remotes := ref.Remotes()
+	docs := make([][]byte, len(remotes))
+	wg := sync.WaitGroup{}
+	log.Info("fetching repomd.xml from remotes", "Count", len(remotes))
+	for i := range remotes {
+		wg.Add(1)
+		j := i
+		go func() {
+			defer wg.Done()
+			// make sure to clone the request context
+			// otherwise remotes will overwrite each other
+			resp, err := remotes[j].Download(ctx, target, rctx.Clone())
+			if err != nil {
+				log.V(1).Info("skipping remote as repomd.xml could not be retrieved", "Remote", remotes[j].String(), "Error", err.Error())
+				return
+			}
+			defer resp.Close()
+			data, err := io.ReadAll(resp)
+			if err != nil {
+				log.Error(err, "failed to read repomd.xml", "Remote", remotes[j].String())
+				return
+			}
+			docs[j] = data
+		}()
+	}
+	wg.Wait()
+
+	for i, data := range docs {
+		if data == nil {
+			continue
+		}
+		var repomd Repomd
+		if err := xml.Unmarshal(data, &repomd); err != nil {
+			log.Error(err, "failed to parse repomd.xml", "Remote", remotes[i].String())
+			continue
+		}
+		// record where the referenced files
+		// need to be downloaded from
+		for _, d := range repomd.Data {
+			if d.Location.Href == "" {
+				continue
+			}
+			p.originCache.Set(ref.String()+"/"+root+strings.TrimPrefix(d.Location.Href, "/"), remotes[i].String(), ttlcache.DefaultTTL)
+		}
+		log.V(1).Info("using repomd.xml from remote", "Remote", remotes[i].String(), "Revision", repomd.Revision)
+		snap := &Snapshot{
+			Remote: remotes[i].String(),
+			Data:   data,
+		}
+		p.repomdCache.Set(key, snap, ttlcache.DefaultTTL)
+		return snap, nil
+	}
+	log.Info("unable to locate repomd.xml in any remote")
+	return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+}
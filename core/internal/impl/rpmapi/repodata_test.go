@@ -0,0 +1,45 @@
+package rpmapi
+
+import (
+	_ "embed"
+	"encoding/xml"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+//go:embed testdata/repomd.xml
+var repomdFile []byte
+
+func TestIsMetadata(t *testing.T) {
+	var cases = []struct {
+		path     string
+		metadata bool
+		repodata bool
+	}{
+		{"9/BaseOS/x86_64/os/repodata/repomd.xml", true, false},
+		{"9/BaseOS/x86_64/os/repodata/repomd.xml.asc", true, false},
+		{"repodata/repomd.xml", true, false},
+		{"9/BaseOS/x86_64/os/repodata/abc-primary.xml.gz", false, true},
+		{"9/BaseOS/x86_64/os/Packages/b/bash-5.1.8-6.el9_1.x86_64.rpm", false, false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.EqualValues(t, tt.metadata, IsMetadata(tt.path))
+			assert.EqualValues(t, tt.repodata, IsRepodata(tt.path))
+		})
+	}
+}
+
+func TestRepoRoot(t *testing.T) {
+	assert.EqualValues(t, "9/BaseOS/x86_64/os/", repoRoot("9/BaseOS/x86_64/os/repodata/repomd.xml"))
+	assert.EqualValues(t, "", repoRoot("/repodata/repomd.xml"))
+}
+
+func TestRepomd(t *testing.T) {
+	var repomd Repomd
+	assert.NoError(t, xml.Unmarshal(repomdFile, &repomd))
+	assert.EqualValues(t, "1687265329", repomd.Revision)
+	assert.Len(t, repomd.Data, 2)
+	assert.EqualValues(t, "primary", repomd.Data[0].Type)
+	assert.EqualValues(t, "repodata/0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b-filelists.xml.zst", repomd.Data[1].Location.Href)
+}
@@ -0,0 +1,38 @@
+package rpmapi
+
+import (
+	"encoding/xml"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+type Provider struct {
+	// caches
+	repomdCache *ttlcache.Cache[string, *Snapshot]
+	originCache *ttlcache.Cache[string, string]
+}
+
+// Snapshot is a copy of the repomd.xml
+// of a repository, and the remote that
+// it was retrieved from.
+type Snapshot struct {
+	// Remote is the URI of the remote
+	Remote string
+	Data   []byte
+}
+
+// Repomd is the repodata/repomd.xml document
+// that describes the metadata of a repository.
+type Repomd struct {
+	XMLName  xml.Name `xml:"repomd"`
+	Revision string   `xml:"revision"`
+	Data     []Data   `xml:"data"`
+}
+
+type Data struct {
+	Type     string   `xml:"type,attr"`
+	Location Location `xml:"location"`
+}
+
+type Location struct {
+	Href string `xml:"href,attr"`
+}
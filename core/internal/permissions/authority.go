@@ -0,0 +1,66 @@
+/*
+ *    Copyright 2022 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+package permissions
+
+import (
+	"context"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"google.golang.org/grpc"
+)
+
+const (
+	methodRemoveRole       = "/rbac.Authority/RemoveRole"
+	methodRemoveGlobalRole = "/rbac.Authority/RemoveGlobalRole"
+)
+
+// Authority is the API of the rbac sidecar. It extends
+// the generated rbac.AuthorityClient with the RPCs that
+// remove role bindings, which take the same requests as
+// the RPCs that add them.
+type Authority interface {
+	rbac.AuthorityClient
+	RemoveRole(ctx context.Context, in *rbac.AddRoleRequest, opts ...grpc.CallOption) (*rbac.GenericResponse, error)
+	RemoveGlobalRole(ctx context.Context, in *rbac.AddGlobalRoleRequest, opts ...grpc.CallOption) (*rbac.GenericResponse, error)
+}
+
+type authorityClient struct {
+	rbac.AuthorityClient
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthority(cc grpc.ClientConnInterface) Authority {
+	return &authorityClient{
+		AuthorityClient: rbac.NewAuthorityClient(cc),
+		cc:              cc,
+	}
+}
+
+func (c *authorityClient) RemoveRole(ctx context.Context, in *rbac.AddRoleRequest, opts ...grpc.CallOption) (*rbac.GenericResponse, error) {
+	out := new(rbac.GenericResponse)
+	if err := c.cc.Invoke(ctx, methodRemoveRole, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorityClient) RemoveGlobalRole(ctx context.Context, in *rbac.AddGlobalRoleRequest, opts ...grpc.CallOption) (*rbac.GenericResponse, error) {
+	out := new(rbac.GenericResponse)
+	if err := c.cc.Invoke(ctx, methodRemoveGlobalRole, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
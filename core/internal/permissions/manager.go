@@ -25,13 +25,14 @@ import (
 	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
 	"gitlab.com/go-prism/prism3/core/internal/errs"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/tokens"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func NewManager(ctx context.Context, r rbac.AuthorityClient, superuser string) (*Manager, error) {
+func NewManager(ctx context.Context, r Authority, superuser, groupClaim string) (*Manager, error) {
 	log := logr.FromContextOrDiscard(ctx)
 	log.V(1).Info("creating initial superuser", "Username", superuser)
 	if _, err := r.AddGlobalRole(ctx, &rbac.AddGlobalRoleRequest{Subject: NormalUser(superuser), Role: string(model.RoleSuper)}); err != nil {
@@ -40,7 +41,8 @@ func NewManager(ctx context.Context, r rbac.AuthorityClient, superuser string) (
 	}
 	log.V(1).Info("successfully created initial superuser")
 	return &Manager{
-		RBAC: r,
+		RBAC:       r,
+		groupClaim: groupClaim,
 	}, nil
 }
 
@@ -56,8 +58,23 @@ func (m *Manager) CanI(ctx context.Context, resource repo.Resource, resourceID s
 	}
 	username := NormalUser(user.AsUsername())
 	log = log.WithValues("User", username)
+	// access tokens can only be used for
+	// the resources that they were scoped to
+	if t, ok := tokens.FromContext(ctx); ok {
+		if !tokens.Allows(t, resource, resourceID, verb) {
+			log.Info("blocking user access due to missing token scope", "Token", t.ID)
+			metricCan.Add(ctx, 1, attribute.Bool("forbidden", true))
+			return errs.ErrForbidden
+		}
+		// service accounts don't have any role bindings,
+		// so their scopes are all that they are allowed
+		if t.ServiceAccountID != "" {
+			metricCan.Add(ctx, 1, attribute.Bool("forbidden", false))
+			return nil
+		}
+	}
 	log.V(1).Info("checking user access")
-	resp, err := m.RBAC.Can(ctx, &rbac.AccessRequest{
+	ok, err := m.can(ctx, user, &rbac.AccessRequest{
 		Subject:  username,
 		Resource: m.resourceName(resource, resourceID),
 		Action:   verb,
@@ -67,8 +84,7 @@ func (m *Manager) CanI(ctx context.Context, resource repo.Resource, resourceID s
 		metricCan.Add(ctx, 1, attribute.Bool("forbidden", true))
 		return errs.ErrForbidden
 	}
-	log.V(1).Info("successfully completed RBAC check", "Member", resp.GetOk())
-	ok = resp.GetOk()
+	log.V(1).Info("successfully completed RBAC check", "Member", ok)
 	if !ok {
 		log.Info("blocking user access due to missing RBAC rule")
 		metricCan.Add(ctx, 1, attribute.Bool("forbidden", true))
@@ -88,10 +104,17 @@ func (m *Manager) AmI(ctx context.Context, role model.Role) error {
 		metricHas.Add(ctx, 1, attribute.Bool("forbidden", true))
 		return errs.ErrUnauthorised
 	}
+	// roles can't be scoped, so they're
+	// never available to access tokens
+	if t, ok := tokens.FromContext(ctx); ok {
+		log.Info("blocking role access from access token", "Token", t.ID)
+		metricHas.Add(ctx, 1, attribute.Bool("forbidden", true))
+		return errs.ErrForbidden
+	}
 	username := NormalUser(user.AsUsername())
 	log.V(1).Info("normalised user", "User", username)
 	log.V(1).Info("checking user access to role", "User", username)
-	resp, err := m.RBAC.Can(ctx, &rbac.AccessRequest{
+	ok, err := m.can(ctx, user, &rbac.AccessRequest{
 		Subject:  username,
 		Resource: string(role),
 	})
@@ -100,7 +123,6 @@ func (m *Manager) AmI(ctx context.Context, role model.Role) error {
 		metricHas.Add(ctx, 1, attribute.Bool("forbidden", true))
 		return errs.ErrForbidden
 	}
-	ok = resp.GetOk()
 	if !ok {
 		log.Info("unable to find role in any ancestor")
 		metricHas.Add(ctx, 1, attribute.Bool("forbidden", true))
@@ -110,6 +132,50 @@ func (m *Manager) AmI(ctx context.Context, role model.Role) error {
 	return nil
 }
 
+// can checks whether the user has access, either
+// directly or through one of their groups.
+func (m *Manager) can(ctx context.Context, user *client.UserClaim, req *rbac.AccessRequest) (bool, error) {
+	resp, err := m.RBAC.Can(ctx, req)
+	if err != nil {
+		return false, err
+	}
+	if resp.GetOk() {
+		return true, nil
+	}
+	for _, g := range m.Groups(ctx, user) {
+		resp, err := m.RBAC.Can(ctx, &rbac.AccessRequest{
+			Subject:  GroupSubject(g),
+			Resource: req.Resource,
+			Action:   req.Action,
+		})
+		if err != nil {
+			return false, err
+		}
+		if resp.GetOk() {
+			logr.FromContextOrDiscard(ctx).V(1).Info("granting access through group membership", "Group", g)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Groups returns the names of the groups that a user belongs to, as
+// listed in the OIDC claims of the request.
+//
+// Access tokens don't carry any claims, and the groups that a user
+// was in when the token was created may have since been taken away
+// from them, so group bindings never apply to access tokens.
+func (m *Manager) Groups(ctx context.Context, user *client.UserClaim) []string {
+	if m.groupClaim == "" {
+		return nil
+	}
+	if _, ok := tokens.FromContext(ctx); ok {
+		return nil
+	}
+	v, _ := claim(user.Claims, m.groupClaim)
+	return ParseGroups(v)
+}
+
 func (*Manager) resourceName(r repo.Resource, id string) string {
 	return fmt.Sprintf("%s::%s", r, id)
 }
@@ -0,0 +1,73 @@
+package permissions
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/av1o/cap10/pkg/client"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/errs"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/tokens"
+	"gitlab.com/go-prism/prism3/core/pkg/db/datatypes"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+// fakeAuthority grants access to the
+// subjects in its list of bindings.
+type fakeAuthority struct {
+	Authority
+	bindings map[string]string
+}
+
+func (f *fakeAuthority) Can(_ context.Context, in *rbac.AccessRequest, _ ...grpc.CallOption) (*rbac.GenericResponse, error) {
+	return &rbac.GenericResponse{Ok: f.bindings[in.Subject] == in.Resource}, nil
+}
+
+func TestManager_CanI(t *testing.T) {
+	authority := &fakeAuthority{bindings: map[string]string{
+		"group:developers": "refraction::foo",
+	}}
+	m := &Manager{RBAC: authority, groupClaim: "groups"}
+
+	var cases = []struct {
+		name   string
+		user   *client.UserClaim
+		id     string
+		forbid bool
+	}{
+		{"group from claims", &client.UserClaim{Iss: "CN=Issuer", Sub: "CN=User", Claims: map[string]string{"Groups": "admins,developers"}}, "foo", false},
+		{"other resource", &client.UserClaim{Iss: "CN=Issuer", Sub: "CN=User", Claims: map[string]string{"Groups": "developers"}}, "bar", true},
+		{"no groups", &client.UserClaim{Iss: "CN=Issuer", Sub: "CN=Nobody"}, "foo", true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := client.PersistUserCtx(context.TODO(), nil, tt.user)
+			err := m.CanI(ctx, repo.ResourceRefraction, tt.id, rbac.Verb_READ)
+			if tt.forbid {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestManager_CanIToken(t *testing.T) {
+	authority := &fakeAuthority{bindings: map[string]string{
+		"group:developers": "refraction::foo",
+	}}
+	m := &Manager{RBAC: authority, groupClaim: "groups"}
+
+	ctx := tokens.NewContext(context.TODO(), &schemas.AccessToken{AccessToken: model.AccessToken{
+		Iss:    "CN=Issuer",
+		Sub:    "CN=User",
+		Scopes: datatypes.JSONArray{tokens.Scope(repo.ResourceRefraction, "foo", rbac.Verb_READ)},
+	}})
+	ctx = client.PersistUserCtx(ctx, nil, &client.UserClaim{Iss: "CN=Issuer", Sub: "CN=User", Claims: map[string]string{"Groups": "developers"}})
+	// group bindings don't apply to access tokens,
+	// even if the token is scoped to the resource
+	assert.ErrorIs(t, m.CanI(ctx, repo.ResourceRefraction, "foo", rbac.Verb_READ), errs.ErrForbidden)
+}
@@ -17,10 +17,12 @@
 
 package permissions
 
-import (
-	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
-)
+// GroupPrefix marks a role binding subject as
+// a group rather than an individual user.
+const GroupPrefix = "group:"
 
 type Manager struct {
-	RBAC rbac.AuthorityClient
+	RBAC Authority
+
+	groupClaim string
 }
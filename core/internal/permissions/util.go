@@ -1,6 +1,7 @@
 package permissions
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -20,6 +21,45 @@ func NormalUser(s string) string {
 	return fmt.Sprintf("%s/%s", strings.Join(bitsIss, ","), strings.Join(bitsSub, ","))
 }
 
+// GroupSubject returns the role binding
+// subject for members of a group.
+func GroupSubject(group string) string {
+	return GroupPrefix + group
+}
+
+// ParseGroups reads the list of groups from an OIDC
+// claim. Claims are passed to us as headers, so the
+// list may be either a JSON array or comma-separated.
+func ParseGroups(s string) []string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	var groups []string
+	if err := json.Unmarshal([]byte(s), &groups); err != nil {
+		groups = strings.Split(s, ",")
+	}
+	trim(groups)
+	results := make([]string, 0, len(groups))
+	for _, g := range groups {
+		if g != "" {
+			results = append(results, g)
+		}
+	}
+	return results
+}
+
+// claim retrieves the value of a claim. Claim names
+// are case-insensitive since they come from headers.
+func claim(claims map[string]string, name string) (string, bool) {
+	for k, v := range claims {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
 // UserEquals checks that two cap10
 // usernames are equal.
 func UserEquals(a, b string) bool {
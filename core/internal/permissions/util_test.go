@@ -50,3 +50,20 @@ func TestUserEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGroups(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out []string
+	}{
+		{"", nil},
+		{"admins", []string{"admins"}},
+		{"admins, developers,", []string{"admins", "developers"}},
+		{`["admins", "/platform/developers"]`, []string{"admins", "/platform/developers"}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.EqualValues(t, tt.out, ParseGroups(tt.in))
+		})
+	}
+}
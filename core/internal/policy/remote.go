@@ -19,16 +19,24 @@ package policy
 
 import (
 	"context"
+	"fmt"
 	"github.com/djcass44/go-utils/utilities/sliceutils"
 	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/av1o/cap10/pkg/client"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/permissions"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"gitlab.com/go-prism/prism3/core/pkg/versions"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"net/http"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 )
 
 var (
@@ -36,6 +44,9 @@ var (
 	RegexNode          = regexp.MustCompile(`.tgz$`)
 	RegexHelm          = regexp.MustCompile(`.tgz(.prov)?$`)
 	RegexPy            = regexp.MustCompile(`.(tar.gz|whl)$`)
+	RegexCargo         = regexp.MustCompile(`(/download|\.crate)$`)
+	RegexRPM           = regexp.MustCompile(`(\.rpm|(^|/)repodata/[0-9a-f]{32,}-[^/]+)$`)
+	RegexOCI           = regexp.MustCompile(`/(blobs|manifests)/sha256:[0-9a-f]{64}$`)
 	ExcludedExtensions = []string{
 		".js",
 		".html",
@@ -43,64 +54,180 @@ var (
 	}
 )
 
-type RegexEnforcer struct {
+type RemoteEnforcer struct {
 	archetype model.Archetype
 	allow     []*regexp.Regexp
 	block     []*regexp.Regexp
+	rules     []*rule
+	// audit logs requests that would have been
+	// denied by a rule instead of denying them.
+	// The allow and block lists are always enforced.
+	audit bool
+	// invalid is set if any of the policies couldn't
+	// be compiled, in which case every request is
+	// denied rather than ignoring the policy
+	invalid bool
 }
 
-func NewRegexEnforcer(ctx context.Context, r *model.Remote) *RegexEnforcer {
-	log := logr.FromContextOrDiscard(ctx)
-	allow := make([]*regexp.Regexp, len(r.Security.Allowed))
-	for i := range allow {
-		log.V(2).Info("compiling ALLOW regex", r.Security.Allowed[i])
-		allow[i] = regexp.MustCompile(r.Security.Allowed[i])
-	}
-	block := make([]*regexp.Regexp, len(r.Security.Blocked))
-	for i := range block {
-		log.V(2).Info("compiling BLOCK regex", r.Security.Blocked[i])
-		block[i] = regexp.MustCompile(r.Security.Blocked[i])
-	}
-	log.V(1).Info("successfully compiled regex policies", "AllowedCount", len(allow), "BlockedCount", len(block))
-	return &RegexEnforcer{
+func NewRemoteEnforcer(ctx context.Context, r *model.Remote) *RemoteEnforcer {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Remote", r.Name)
+	e := &RemoteEnforcer{
 		archetype: r.Archetype,
-		allow:     allow,
-		block:     block,
+		audit:     r.Security.PolicyMode == model.PolicyModeAudit,
 	}
+	for _, s := range r.Security.Allowed {
+		log.V(2).Info("compiling ALLOW regex", "Regex", s)
+		re, err := regexp.Compile(s)
+		if err != nil {
+			log.Error(err, "failed to compile ALLOW regex", "Regex", s)
+			e.invalid = true
+			continue
+		}
+		e.allow = append(e.allow, re)
+	}
+	for _, s := range r.Security.Blocked {
+		log.V(2).Info("compiling BLOCK regex", "Regex", s)
+		re, err := regexp.Compile(s)
+		if err != nil {
+			log.Error(err, "failed to compile BLOCK regex", "Regex", s)
+			e.invalid = true
+			continue
+		}
+		e.block = append(e.block, re)
+	}
+	for _, s := range r.Security.Rules {
+		log.V(2).Info("compiling rule", "Rule", s)
+		ru, err := compileRule(s)
+		if err != nil {
+			log.Error(err, "failed to compile rule", "Rule", s)
+			e.invalid = true
+			continue
+		}
+		e.rules = append(e.rules, ru)
+	}
+	log.V(1).Info("successfully compiled policies", "AllowedCount", len(e.allow), "BlockedCount", len(e.block), "RuleCount", len(e.rules), "Audit", e.audit, "Invalid", e.invalid)
+	return e
+}
+
+// Validate checks that regexes and rules can be
+// compiled, so that they can be rejected before
+// they are saved.
+func Validate(allowed, blocked, rules []string) error {
+	for _, s := range allowed {
+		if _, err := regexp.Compile(s); err != nil {
+			return problem.New(http.StatusBadRequest).Errorf("invalid allow pattern %q: %s", s, err)
+		}
+	}
+	for _, s := range blocked {
+		if _, err := regexp.Compile(s); err != nil {
+			return problem.New(http.StatusBadRequest).Errorf("invalid block pattern %q: %s", s, err)
+		}
+	}
+	for _, s := range rules {
+		if _, err := compileRule(s); err != nil {
+			return problem.New(http.StatusBadRequest).Errorf("invalid rule %q: %s", s, err)
+		}
+	}
+	return nil
 }
 
-func (r *RegexEnforcer) CanReceive(ctx context.Context, path string) bool {
+func (r *RemoteEnforcer) CanReceive(ctx context.Context, path string, rctx *schemas.RequestContext) bool {
 	attributes := []attribute.KeyValue{
 		attribute.String("archetype", string(r.archetype)),
 		attribute.String("path", path),
 	}
-	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "policy_regex_canReceive", trace.WithAttributes(attributes...))
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "policy_remote_canReceive", trace.WithAttributes(attributes...))
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path, "Archetype", r.archetype)
-	log.V(1).Info("validating regex receiver policy")
-	if r.anyMatch(path, r.block) {
-		log.V(1).Info("blocked by blocklist")
+	log.V(1).Info("validating receiver policy")
+	if reason := r.denyList(path); reason != "" {
+		log.V(1).Info("denied by policy", "Reason", reason)
 		metricReceive.Add(ctx, 1, append(attributes, attribute.Bool("allowed", false))...)
 		return false
 	}
+	reason := r.denyRules(ctx, path, rctx)
+	if reason == "" {
+		metricReceive.Add(ctx, 1, append(attributes, attribute.Bool("allowed", true))...)
+		return true
+	}
+	// let the request through, but record that
+	// it would have been denied
+	if r.audit {
+		log.Info("policy would have denied request", "Reason", reason)
+		metricReceive.Add(ctx, 1, append(attributes, attribute.Bool("allowed", true), attribute.Bool("audit", true))...)
+		return true
+	}
+	log.V(1).Info("denied by policy", "Reason", reason)
+	metricReceive.Add(ctx, 1, append(attributes, attribute.Bool("allowed", false))...)
+	return false
+}
+
+// denyList returns the reason that a request should be
+// denied by the allow and block lists, or an empty
+// string if it is allowed. These are enforced regardless
+// of the policy mode.
+func (r *RemoteEnforcer) denyList(path string) string {
+	if r.invalid {
+		return "policy could not be compiled"
+	}
+	if r.anyMatch(path, r.block) {
+		return "blocked by blocklist"
+	}
 	if len(r.allow) > 0 && !r.anyMatch(path, r.allow) {
-		log.V(1).Info("blocked by allowlist")
-		metricReceive.Add(ctx, 1, append(attributes, attribute.Bool("allowed", false))...)
-		return false
+		return "blocked by allowlist"
+	}
+	return ""
+}
+
+// denyRules returns the reason that a request should be
+// denied by a rule, or an empty string if it is allowed.
+func (r *RemoteEnforcer) denyRules(ctx context.Context, path string, rctx *schemas.RequestContext) string {
+	if len(r.rules) == 0 {
+		return ""
+	}
+	vars := r.input(ctx, path, rctx).vars()
+	for _, ru := range r.rules {
+		ok, err := ru.eval(vars)
+		if err != nil {
+			logr.FromContextOrDiscard(ctx).Error(err, "failed to evaluate rule", "Rule", ru.expr)
+		}
+		if !ok {
+			return fmt.Sprintf("blocked by rule: %s", ru.expr)
+		}
+	}
+	return ""
+}
+
+// input collects the facts about a request
+// that rules are evaluated against.
+func (r *RemoteEnforcer) input(ctx context.Context, path string, rctx *schemas.RequestContext) *Input {
+	// handle downloads having a fragment
+	uri, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "#")
+	in := &Input{
+		Path:      uri,
+		Archetype: string(r.archetype),
+		Now:       time.Now(),
+	}
+	in.Name, in.Version, _ = versions.Parse(r.archetype, uri)
+	if user, ok := client.GetContextUser(ctx); ok {
+		in.User = permissions.NormalUser(user.AsUsername())
+		in.Claims = user.Claims
+	}
+	if rctx != nil {
+		in.Header = rctx.Header
 	}
-	metricReceive.Add(ctx, 1, append(attributes, attribute.Bool("allowed", true))...)
-	return true
+	return in
 }
 
-func (r *RegexEnforcer) CanCache(ctx context.Context, path string) bool {
+func (r *RemoteEnforcer) CanCache(ctx context.Context, path string) bool {
 	attributes := []attribute.KeyValue{
 		attribute.String("archetype", string(r.archetype)),
 		attribute.String("path", path),
 	}
-	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "policy_regex_canCache", trace.WithAttributes(attributes...))
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "policy_remote_canCache", trace.WithAttributes(attributes...))
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path, "Archetype", r.archetype)
-	log.V(1).Info("validating regex cache policy")
+	log.V(1).Info("validating cache policy")
 	if r.archetype == "" {
 		log.V(1).Info("cannot cache data without an archetype")
 		metricCache.Add(ctx, 1, append(attributes, attribute.Bool("allowed", false))...)
@@ -113,11 +240,21 @@ func (r *RegexEnforcer) CanCache(ctx context.Context, path string) bool {
 	case model.ArchetypeMaven:
 		canCache = !strings.HasSuffix(path, "maven-metadata.xml")
 	case model.ArchetypeAlpine:
+		// the index is cached by the apkapi
+		// provider after it has been merged
 		canCache = !strings.HasSuffix(path, "APKINDEX.tar.gz")
 	case model.ArchetypeDebian:
 		canCache = RegexDebian.MatchString(path)
 	case model.ArchetypeHelm:
 		canCache = RegexHelm.MatchString(path)
+	case model.ArchetypeRust:
+		canCache = RegexCargo.MatchString(path)
+	case model.ArchetypeRpm:
+		canCache = RegexRPM.MatchString(path)
+	case model.ArchetypeOci:
+		// tags can be moved, so only content
+		// addressed by its digest is cached
+		canCache = RegexOCI.MatchString(path)
 	case model.ArchetypePip:
 		// handle downloads having a fragment
 		uri, _, ok := strings.Cut(path, "#")
@@ -137,12 +274,12 @@ func (r *RegexEnforcer) CanCache(ctx context.Context, path string) bool {
 
 // canCacheGeneric excludes common Web resources
 // (e.g., html, js, css)
-func (*RegexEnforcer) canCacheGeneric(path string) bool {
+func (*RemoteEnforcer) canCacheGeneric(path string) bool {
 	ext := filepath.Ext(path)
 	return !sliceutils.Includes(ExcludedExtensions, ext)
 }
 
-func (*RegexEnforcer) anyMatch(path string, rules []*regexp.Regexp) bool {
+func (*RemoteEnforcer) anyMatch(path string, rules []*regexp.Regexp) bool {
 	// check if any regex matches the path
 	for _, r := range rules {
 		if r.MatchString(path) {
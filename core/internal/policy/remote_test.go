@@ -22,11 +22,14 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/assert"
+	"gitlab.com/av1o/cap10/pkg/client"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/httpclient"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"testing"
 )
 
-func TestRegexEnforcer_CanReceive(t *testing.T) {
+func TestRemoteEnforcer_CanReceive(t *testing.T) {
 	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
 	var cases = []struct {
 		path string
@@ -47,7 +50,7 @@ func TestRegexEnforcer_CanReceive(t *testing.T) {
 	}
 	for _, tt := range cases {
 		t.Run(tt.path, func(t *testing.T) {
-			enf := NewRegexEnforcer(ctx, &model.Remote{
+			enf := NewRemoteEnforcer(ctx, &model.Remote{
 				Archetype: model.ArchetypeGeneric,
 				Security: &model.RemoteSecurity{
 					Blocked: []string{
@@ -55,13 +58,110 @@ func TestRegexEnforcer_CanReceive(t *testing.T) {
 					},
 				},
 			})
-			ok := enf.CanReceive(ctx, tt.path)
+			ok := enf.CanReceive(ctx, tt.path, nil)
 			assert.EqualValues(t, tt.ok, ok)
 		})
 	}
 }
 
-func TestRegexEnforcer_CanCache(t *testing.T) {
+func TestRemoteEnforcer_CanReceiveRules(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	userCtx := client.PersistUserCtx(ctx, nil, &client.UserClaim{
+		Sub:    "CN=John Smith,OU=Engineering",
+		Iss:    "CN=Prism CA",
+		Claims: map[string]string{"team": "platform"},
+	})
+	var cases = []struct {
+		name  string
+		ctx   context.Context
+		rctx  *schemas.RequestContext
+		path  string
+		rules []string
+		ok    bool
+	}{
+		{"package name", ctx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`name != "left-pad"`}, true},
+		{"blocked package name", ctx, nil, "left-pad/-/left-pad-1.3.0.tgz", []string{`name != "left-pad"`}, false},
+		{"version", ctx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`!version.startsWith("0.")`}, true},
+		{"blocked version", ctx, nil, "/lodash/-/lodash-0.1.0.tgz", []string{`!version.startsWith("0.")`}, false},
+		{"archetype", ctx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`archetype == "NPM"`}, true},
+		{"path", ctx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`path.startsWith("lodash/")`}, true},
+		{"anonymous user", ctx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`user != ""`}, false},
+		{"user", userCtx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`user.endsWith("CN=John Smith,OU=Engineering")`}, true},
+		{"claim", userCtx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`claims["team"] == "platform"`}, true},
+		{"missing claim", ctx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`claims["team"] == "platform"`}, false},
+		{"header", ctx, &schemas.RequestContext{AuthOpts: httpclient.AuthOpts{Header: "Private-Token"}}, "lodash/-/lodash-4.17.21.tgz", []string{`header == "Private-Token"`}, true},
+		{"time", ctx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`now > timestamp("2020-01-01T00:00:00Z")`}, true},
+		{"all rules must pass", ctx, nil, "lodash/-/lodash-4.17.21.tgz", []string{`true`, `false`}, false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			enf := NewRemoteEnforcer(ctx, &model.Remote{
+				Archetype: model.ArchetypeNpm,
+				Security: &model.RemoteSecurity{
+					Rules: tt.rules,
+				},
+			})
+			ok := enf.CanReceive(tt.ctx, tt.path, tt.rctx)
+			assert.EqualValues(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestRemoteEnforcer_CanReceiveAudit(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	enf := NewRemoteEnforcer(ctx, &model.Remote{
+		Archetype: model.ArchetypeGeneric,
+		Security: &model.RemoteSecurity{
+			Blocked:    []string{"^secret"},
+			Rules:      []string{`false`},
+			PolicyMode: model.PolicyModeAudit,
+		},
+	})
+	// rules are only audited
+	assert.True(t, enf.CanReceive(ctx, "file.txt", nil))
+	// but the block list is still enforced
+	assert.False(t, enf.CanReceive(ctx, "secret/file.txt", nil))
+}
+
+func TestRemoteEnforcer_CanReceiveInvalid(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	enf := NewRemoteEnforcer(ctx, &model.Remote{
+		Archetype: model.ArchetypeGeneric,
+		Security: &model.RemoteSecurity{
+			Blocked: []string{"(unclosed"},
+		},
+	})
+	assert.False(t, enf.CanReceive(ctx, "file.txt", nil))
+}
+
+func TestValidate(t *testing.T) {
+	var cases = []struct {
+		name    string
+		allowed []string
+		blocked []string
+		rules   []string
+		ok      bool
+	}{
+		{"valid", []string{"^foo"}, []string{"bar$"}, []string{`name == "foo"`}, true},
+		{"invalid allow", []string{"(unclosed"}, nil, nil, false},
+		{"invalid block", nil, []string{"[a-"}, nil, false},
+		{"invalid syntax", nil, nil, []string{`name ==`}, false},
+		{"unknown variable", nil, nil, []string{`foo == "bar"`}, false},
+		{"not a bool", nil, nil, []string{`name`}, false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.allowed, tt.blocked, tt.rules)
+			if tt.ok {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRemoteEnforcer_CanCache(t *testing.T) {
 	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
 	var cases = []struct {
 		arch model.Archetype
@@ -88,10 +188,50 @@ func TestRegexEnforcer_CanCache(t *testing.T) {
 			"/foo/bar/library.jar",
 			true,
 		},
+		{
+			model.ArchetypeRust,
+			"crates/serde/1.0.0/download",
+			true,
+		},
+		{
+			model.ArchetypeRust,
+			"se/rd/serde",
+			false,
+		},
+		{
+			model.ArchetypeRpm,
+			"9/BaseOS/x86_64/os/repodata/repomd.xml",
+			false,
+		},
+		{
+			model.ArchetypeRpm,
+			"9/BaseOS/x86_64/os/repodata/8f1a9e4c1d6b0c3f2e5a7b9d0c1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e-primary.xml.gz",
+			true,
+		},
+		{
+			model.ArchetypeRpm,
+			"9/BaseOS/x86_64/os/Packages/b/bash-5.1.8-6.el9_1.x86_64.rpm",
+			true,
+		},
+		{
+			model.ArchetypeOci,
+			"library/alpine/manifests/3.18",
+			false,
+		},
+		{
+			model.ArchetypeOci,
+			"library/alpine/manifests/sha256:c5c5fda71656f28e49ac9c5416b3643eaa6a108a8093151d6d1afc39463be8ec",
+			true,
+		},
+		{
+			model.ArchetypeOci,
+			"library/alpine/blobs/sha256:31e352740f534f9ad170f75378a84fe453d6156e40700b882d737a8f4a6988a3",
+			true,
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.path, func(t *testing.T) {
-			enf := NewRegexEnforcer(ctx, &model.Remote{
+			enf := NewRemoteEnforcer(ctx, &model.Remote{
 				Archetype: tt.arch,
 				Security:  &model.RemoteSecurity{},
 			})
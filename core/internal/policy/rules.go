@@ -0,0 +1,117 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package policy
+
+import (
+	"fmt"
+	"github.com/google/cel-go/cel"
+	"time"
+)
+
+// env declares the variables that
+// rules can refer to.
+var env = mustEnv()
+
+func mustEnv() *cel.Env {
+	e, err := cel.NewEnv(
+		cel.Variable("path", cel.StringType),
+		cel.Variable("name", cel.StringType),
+		cel.Variable("version", cel.StringType),
+		cel.Variable("archetype", cel.StringType),
+		cel.Variable("user", cel.StringType),
+		cel.Variable("claims", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("header", cel.StringType),
+		cel.Variable("now", cel.TimestampType),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Input contains the facts about a
+// request that rules are evaluated
+// against.
+type Input struct {
+	Path      string
+	Name      string
+	Version   string
+	Archetype string
+	// User is the username of the client,
+	// or empty if they are anonymous
+	User   string
+	Claims map[string]string
+	// Header is the name of the header that
+	// the client authenticated with
+	Header string
+	Now    time.Time
+}
+
+func (i *Input) vars() map[string]any {
+	claims := i.Claims
+	if claims == nil {
+		claims = map[string]string{}
+	}
+	return map[string]any{
+		"path":      i.Path,
+		"name":      i.Name,
+		"version":   i.Version,
+		"archetype": i.Archetype,
+		"user":      i.User,
+		"claims":    claims,
+		"header":    i.Header,
+		"now":       i.Now,
+	}
+}
+
+// rule is a compiled CEL expression that
+// must evaluate to true for a request
+// to be allowed.
+type rule struct {
+	expr string
+	prg  cel.Program
+}
+
+// compileRule parses and type-checks an
+// expression.
+func compileRule(expr string) (*rule, error) {
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("rule must evaluate to a bool, not %s", ast.OutputType())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	return &rule{expr: expr, prg: prg}, nil
+}
+
+// eval returns true if the rule allows the
+// request. Rules that fail to evaluate (e.g.,
+// because a claim is missing) deny the request.
+func (r *rule) eval(vars map[string]any) (bool, error) {
+	out, _, err := r.prg.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+	ok, isBool := out.Value().(bool)
+	return ok && isBool, nil
+}
@@ -1,8 +1,11 @@
 package policy
 
-import "context"
+import (
+	"context"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+)
 
 type Enforcer interface {
-	CanReceive(ctx context.Context, path string) bool
+	CanReceive(ctx context.Context, path string, rctx *schemas.RequestContext) bool
 	CanCache(ctx context.Context, path string) bool
 }
@@ -19,8 +19,11 @@ package refract
 
 import (
 	"context"
+	"github.com/go-logr/logr"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/quarantine"
 	"gitlab.com/go-prism/prism3/core/pkg/quota"
 	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
@@ -28,24 +31,108 @@ import (
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"io"
+	"regexp"
+	"regexp/syntax"
+	"sort"
 )
 
 type BackedRefraction struct {
-	mod *model.Refraction
-	rf  *Refraction
+	mod        *model.Refraction
+	rf         *Refraction
+	advisories *advisory.Policy
 }
 
-func NewBackedRefraction(ctx context.Context, mod *model.Refraction, store storage.Reader, netObserver quota.Observer, onCreate repo.CreateArtifactFunc, getPyPi, getHelm repo.GetPackageFunc) *BackedRefraction {
+func NewBackedRefraction(ctx context.Context, mod *model.Refraction, store storage.Reader, netObserver quota.Observer, flight *remote.Coalescer, health *remote.Health, onCreate repo.CreateArtifactFunc, onDigest repo.SetDigestFunc, getPyPi, getHelm repo.GetPackageFunc, getAffected repo.ListAffectedFunc, getExemptions quarantine.ListFunc, getPublished quarantine.PublishedFunc) *BackedRefraction {
 	remotes := make([]remote.Remote, len(mod.Remotes))
+	advisories := advisory.Load(mod, getAffected)
+	downloads := quarantine.NewDownloads(mod, getExemptions, getPublished)
 	for i := range mod.Remotes {
-		remotes[i] = remote.NewBackedRemote(ctx, mod.Remotes[i], store, netObserver, onCreate, getPyPi, getHelm)
+		rem := remote.NewBackedRemote(ctx, mod.Remotes[i], store, netObserver, flight, health, onCreate, onDigest, getPyPi, getHelm)
+		rem.SetOffline(mod.Offline)
+		rem.SetRefraction(mod.ID)
+		rem.SetAdvisories(advisories)
+		rem.SetQuarantine(downloads)
+		remotes[i] = rem
 	}
+	rf := New(ctx, mod.Name, mod.Strategy, getPins(ctx, mod, remotes), remotes)
+	rf.offline = mod.Offline
 	return &BackedRefraction{
-		mod: mod,
-		rf:  NewSimple(ctx, mod.Name, remotes),
+		mod:        mod,
+		rf:         rf,
+		advisories: advisories,
 	}
 }
 
+// getPins matches each pin to its remote. The pins are
+// saved as a JSON object which doesn't keep the order that
+// they were configured in, so the most specific pattern is
+// checked first (see specificity). Patterns that are just
+// as specific are sorted so that the result is stable.
+func getPins(ctx context.Context, mod *model.Refraction, remotes []remote.Remote) []Pin {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Refraction", mod.Name)
+	patterns := make([]string, 0, len(mod.Pins))
+	compiled := make(map[string]*regexp.Regexp, len(mod.Pins))
+	for k := range mod.Pins {
+		re, err := regexp.Compile(k)
+		if err != nil {
+			log.Error(err, "skipping pin with invalid pattern", "Pattern", k)
+			continue
+		}
+		patterns = append(patterns, k)
+		compiled[k] = re
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		si, sj := specificity(patterns[i]), specificity(patterns[j])
+		if si != sj {
+			return si > sj
+		}
+		if len(patterns[i]) != len(patterns[j]) {
+			return len(patterns[i]) > len(patterns[j])
+		}
+		return patterns[i] < patterns[j]
+	})
+	var pins []Pin
+	for _, pattern := range patterns {
+		re := compiled[pattern]
+		for i := range mod.Remotes {
+			if mod.Remotes[i].ID == mod.Pins[pattern] {
+				pins = append(pins, Pin{
+					Pattern: re,
+					Remote:  remotes[i],
+				})
+				break
+			}
+		}
+	}
+	return pins
+}
+
+// specificity returns the length of the literal text
+// that a path must start with to match the pattern, so
+// that "^@acme/internal-" is more specific than "^@acme/".
+func specificity(pattern string) int {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return 0
+	}
+	re = re.Simplify()
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	n := 0
+	for _, sub := range subs {
+		switch sub.Op {
+		case syntax.OpBeginLine, syntax.OpBeginText:
+		case syntax.OpLiteral:
+			n += len(sub.Rune)
+		default:
+			return n
+		}
+	}
+	return n
+}
+
 func (b *BackedRefraction) Exists(ctx context.Context, path string, rctx *schemas.RequestContext) (string, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "refraction_backed_exists")
 	defer span.End()
@@ -56,7 +143,13 @@ func (b *BackedRefraction) Exists(ctx context.Context, path string, rctx *schema
 	return msg.URI, nil
 }
 
-func (b *BackedRefraction) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+func (b *BackedRefraction) Head(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "refraction_backed_head")
+	defer span.End()
+	return b.rf.Head(ctx, path, rctx)
+}
+
+func (b *BackedRefraction) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "refraction_backed_download")
 	defer span.End()
 	return b.rf.Download(ctx, path, rctx)
@@ -69,3 +162,9 @@ func (b *BackedRefraction) Refraction() *Refraction {
 func (b *BackedRefraction) Model() *model.Refraction {
 	return b.mod
 }
+
+// Advisories returns the vulnerability policy of
+// the Refraction, which may be nil.
+func (b *BackedRefraction) Advisories() *advisory.Policy {
+	return b.advisories
+}
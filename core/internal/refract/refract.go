@@ -18,24 +18,36 @@
 package refract
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"github.com/go-logr/logr"
 	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"io"
 	"net/http"
 	"sync"
-	"time"
 )
 
 func NewSimple(ctx context.Context, name string, remotes []remote.Remote) *Refraction {
+	return New(ctx, name, model.ResolutionStrategyFastest, nil, remotes)
+}
+
+// New creates a Refraction that picks between its
+// remotes using the given strategy. Pins are only
+// used by the PINNED strategy and are checked in
+// the order that they are given.
+func New(ctx context.Context, name string, strategy model.ResolutionStrategy, pins []Pin, remotes []remote.Remote) *Refraction {
 	return &Refraction{
-		name:    name,
-		remotes: remotes,
+		name:     name,
+		remotes:  remotes,
+		strategy: strategy,
+		pins:     pins,
 		rp: &sync.Pool{
 			New: func() any {
 				return remote.NewEphemeralRemote(ctx, "", nil)
@@ -48,44 +60,91 @@ func (r *Refraction) String() string {
 	return r.name
 }
 
+// Offline returns true if the Refraction must
+// not contact its remotes.
+func (r *Refraction) Offline() bool {
+	return r.offline
+}
+
 func (r *Refraction) Remotes() []remote.Remote {
 	return r.remotes
 }
 
+// Candidates returns the remotes that may serve the
+// path. If ordered is true, the first remote that has
+// the path must be used rather than whichever remote
+// responds first.
+func (r *Refraction) Candidates(path string) (remotes []remote.Remote, ordered bool) {
+	switch r.strategy {
+	case model.ResolutionStrategyPinned:
+		for _, p := range r.pins {
+			if p.Pattern.MatchString(path) {
+				return []remote.Remote{p.Remote}, true
+			}
+		}
+		return r.remotes, true
+	case model.ResolutionStrategyOrdered:
+		return r.remotes, true
+	default:
+		return r.remotes, false
+	}
+}
+
+type probe struct {
+	index int
+	msg   Message
+}
+
 func (r *Refraction) Exists(ctx context.Context, path string, rctx *schemas.RequestContext) (*Message, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "refraction_exists")
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path)
 	log.V(2).Info("using request context", "RequestContext", rctx)
-	ch := make(chan Message, 1)
+	remotes, ordered := r.Candidates(path)
+	span.SetAttributes(attribute.String("strategy", string(r.strategy)))
+	// make sure that remotes can always send their
+	// response, even once we've stopped listening
+	ch := make(chan probe, len(remotes))
 	// create a goroutine for each remote
-	log.Info("probing remotes", "Count", len(r.remotes))
+	log.Info("probing remotes", "Count", len(remotes), "Strategy", r.strategy)
 	// create a new context that we use for all
 	// requests, so we can cancel the old ones
-	// todo cancel contexts more aggressively
-	reqCtx, cancel := context.WithTimeout(ctx, time.Second*10)
+	reqCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
-	for i := range r.remotes {
-		rem := r.remotes[i]
+	for i := range remotes {
+		rem := remotes[i]
+		j := i
 		go func() {
+			// give each remote its own deadline so
+			// that a slow remote doesn't hold up the
+			// others
+			probeCtx, probeCancel := context.WithTimeout(reqCtx, remote.Timeout(rem))
+			defer probeCancel()
 			// make sure to clone the request context
 			// otherwise remotes will overwrite each other
-			uri, err := rem.Exists(reqCtx, path, rctx.Clone())
-			ch <- Message{
-				URI:    uri,
-				Remote: rem,
-				Err:    err,
+			uri, err := rem.Exists(probeCtx, path, rctx.Clone())
+			ch <- probe{
+				index: j,
+				msg: Message{
+					URI:    uri,
+					Remote: rem,
+					Err:    err,
+				},
 			}
 		}()
 	}
-	// wait for the first response or for
-	// the context to expire
+	// wait for the first response (or the first in
+	// order of preference) or for the context to expire
 	var bestStatus int
+	results := make([]*Message, len(remotes))
+	next := 0
 	count := 0
-	for count < len(r.remotes) {
+	for count < len(remotes) {
 		select {
-		case val := <-ch:
-			if val.URI != "" {
+		case p := <-ch:
+			val := p.msg
+			results[p.index] = &val
+			if val.URI != "" && !ordered {
 				log.Info("received final response from remote", "Url", val.URI)
 				return &val, nil
 			}
@@ -101,6 +160,23 @@ func (r *Refraction) Exists(ctx context.Context, path string, rctx *schemas.Requ
 					}
 				}
 			}
+			// a remote can only be used once every
+			// remote before it has ruled itself out
+			for ordered && next < len(results) && results[next] != nil {
+				if results[next].URI != "" {
+					log.Info("received final response from preferred remote", "Url", results[next].URI, "Position", next)
+					return results[next], nil
+				}
+				// only a remote that definitely doesn't have
+				// the file can be skipped, otherwise a remote
+				// further down the list could serve a file
+				// that the preferred remote would have shadowed
+				if !IsNotFound(results[next].Err) {
+					log.Info("preferred remote could not be checked", "Position", next, "Remote", results[next].Remote.String(), "Error", errString(results[next].Err))
+					return nil, unavailable(results[next].Err)
+				}
+				next++
+			}
 		case _ = <-ctx.Done():
 			log.V(1).Info("context was cancelled while waiting for a remote to respond")
 			return nil, ctx.Err()
@@ -115,7 +191,55 @@ func (r *Refraction) Exists(ctx context.Context, path string, rctx *schemas.Requ
 	return nil, problem.New(bestStatus)
 }
 
-func (r *Refraction) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+// IsNotFound returns true if the error is a
+// definite 404 from the remote.
+func IsNotFound(err error) bool {
+	var httpErr problem.HTTPError
+	return errors.As(err, &httpErr) && httpErr.GetStatus() == http.StatusNotFound
+}
+
+// unavailable converts the error from a remote that
+// could not be checked into a response for the client.
+func unavailable(err error) error {
+	var httpErr problem.HTTPError
+	if errors.As(err, &httpErr) {
+		return err
+	}
+	return problem.New(http.StatusBadGateway).Errorf("preferred remote could not be checked")
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// Head checks that a file exists and returns its stored
+// copy (if there is one) without reading it, so that the
+// gateway can send the same headers as it would for
+// a GET request.
+func (r *Refraction) Head(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "refraction_head")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path)
+	msg, err := r.Exists(ctx, path, rctx)
+	if err != nil {
+		return nil, err
+	}
+	if o, ok := msg.Remote.(Opener); ok {
+		// make sure to clone the request context
+		// otherwise remotes will overwrite each other
+		obj, err := o.Open(ctx, msg.URI, rctx.Clone())
+		if err == nil {
+			return obj, nil
+		}
+		log.V(1).Info("unable to open stored copy of file", "Error", err.Error())
+	}
+	return io.NopCloser(bytes.NewReader(nil)), nil
+}
+
+func (r *Refraction) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "refraction_download")
 	defer span.End()
 	// find the best location for the file
@@ -24,11 +24,17 @@ import (
 	"github.com/lpar/problem"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/datatypes"
+	"gitlab.com/go-prism/prism3/core/pkg/quota"
 	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"testing"
+	"time"
 )
 
 func singleCodeServer(t *testing.T, code int) *httptest.Server {
@@ -92,3 +98,231 @@ func TestRefraction_Exists(t *testing.T) {
 		})
 	}
 }
+
+func TestRefraction_ExistsStrategy(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond * 200)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(slow.Close)
+	fast := singleCodeServer(t, http.StatusOK)
+	notFound := singleCodeServer(t, http.StatusNotFound)
+
+	slowRemote := remote.NewEphemeralRemote(ctx, slow.URL, nil)
+	fastRemote := remote.NewEphemeralRemote(ctx, fast.URL, nil)
+	notFoundRemote := remote.NewEphemeralRemote(ctx, notFound.URL, nil)
+
+	var cases = []struct {
+		name string
+		ref  *Refraction
+		path string
+		uri  string
+	}{
+		{
+			"fastest remote wins",
+			New(ctx, "", model.ResolutionStrategyFastest, nil, []remote.Remote{slowRemote, fastRemote}),
+			"foo.txt",
+			fast.URL + "/foo.txt",
+		},
+		{
+			"first remote wins",
+			New(ctx, "", model.ResolutionStrategyOrdered, nil, []remote.Remote{slowRemote, fastRemote}),
+			"foo.txt",
+			slow.URL + "/foo.txt",
+		},
+		{
+			"missing remotes are skipped",
+			New(ctx, "", model.ResolutionStrategyOrdered, nil, []remote.Remote{notFoundRemote, slowRemote, fastRemote}),
+			"foo.txt",
+			slow.URL + "/foo.txt",
+		},
+		{
+			"pinned remote wins",
+			New(ctx, "", model.ResolutionStrategyPinned, []Pin{
+				{Pattern: regexp.MustCompile(`^internal/`), Remote: fastRemote},
+			}, []remote.Remote{slowRemote, fastRemote}),
+			"internal/foo.txt",
+			fast.URL + "/internal/foo.txt",
+		},
+		{
+			"unpinned paths are ordered",
+			New(ctx, "", model.ResolutionStrategyPinned, []Pin{
+				{Pattern: regexp.MustCompile(`^internal/`), Remote: fastRemote},
+			}, []remote.Remote{slowRemote, fastRemote}),
+			"foo.txt",
+			slow.URL + "/foo.txt",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := tt.ref.Exists(ctx, tt.path, &schemas.RequestContext{})
+			require.NoError(t, err)
+			assert.EqualValues(t, tt.uri, msg.URI)
+		})
+	}
+
+	t.Run("failed remotes are not skipped", func(t *testing.T) {
+		serverError := singleCodeServer(t, http.StatusInternalServerError)
+		ref := New(ctx, "", model.ResolutionStrategyOrdered, nil, []remote.Remote{
+			remote.NewEphemeralRemote(ctx, serverError.URL, nil),
+			fastRemote,
+		})
+		_, err := ref.Exists(ctx, "foo.txt", &schemas.RequestContext{})
+		var httpErr problem.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.EqualValues(t, http.StatusInternalServerError, httpErr.GetStatus())
+	})
+	t.Run("timed out remotes are not skipped", func(t *testing.T) {
+		hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second * 5):
+			}
+		}))
+		t.Cleanup(hung.Close)
+		ref := New(ctx, "", model.ResolutionStrategyOrdered, nil, []remote.Remote{
+			&timeoutRemote{Remote: remote.NewEphemeralRemote(ctx, hung.URL, hung.Client()), timeout: time.Millisecond * 100},
+			fastRemote,
+		})
+		_, err := ref.Exists(ctx, "foo.txt", &schemas.RequestContext{})
+		var httpErr problem.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.EqualValues(t, http.StatusBadGateway, httpErr.GetStatus())
+	})
+	t.Run("pinned remote is the only candidate", func(t *testing.T) {
+		ref := New(ctx, "", model.ResolutionStrategyPinned, []Pin{
+			{Pattern: regexp.MustCompile(`^internal/`), Remote: notFoundRemote},
+		}, []remote.Remote{fastRemote, notFoundRemote})
+		_, err := ref.Exists(ctx, "internal/foo.txt", &schemas.RequestContext{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetPins(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	public := remote.NewEphemeralRemote(ctx, "https://public.example.org", nil)
+	internal := remote.NewEphemeralRemote(ctx, "https://internal.example.org", nil)
+	mod := &model.Refraction{
+		Name: "npm",
+		Remotes: []*model.Remote{
+			{ID: "public"},
+			{ID: "internal"},
+		},
+		Pins: datatypes.JSONMap{
+			`^@acme/`:           "public",
+			`^@acme/internal-`:  "internal",
+			`^@acme/internal-x`: "public",
+			`[`:                 "internal",
+		},
+	}
+	ref := New(ctx, "", model.ResolutionStrategyPinned, getPins(ctx, mod, []remote.Remote{public, internal}), []remote.Remote{public, internal})
+
+	var cases = []struct {
+		path string
+		want remote.Remote
+	}{
+		{"@acme/widget", public},
+		{"@acme/internal-widget", internal},
+		{"@acme/internal-xyz", public},
+	}
+	for _, tt := range cases {
+		t.Run(tt.path, func(t *testing.T) {
+			remotes, ordered := ref.Candidates(tt.path)
+			assert.True(t, ordered)
+			assert.EqualValues(t, []remote.Remote{tt.want}, remotes)
+		})
+	}
+}
+
+func TestSpecificity(t *testing.T) {
+	var cases = []struct {
+		pattern string
+		n       int
+	}{
+		{`^@acme/`, 6},
+		{`^@acme/.*`, 6},
+		{`^@acme/internal-`, 15},
+		{`@acme/(foo|bar)`, 6},
+		{`.*`, 0},
+		{`[`, 0},
+	}
+	for _, tt := range cases {
+		t.Run(tt.pattern, func(t *testing.T) {
+			assert.EqualValues(t, tt.n, specificity(tt.pattern))
+		})
+	}
+}
+
+type timeoutRemote struct {
+	remote.Remote
+	timeout time.Duration
+}
+
+func (r *timeoutRemote) Timeout() time.Duration {
+	return r.timeout
+}
+
+func TestRefraction_ExistsTimeout(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+
+	hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second * 5):
+		}
+	}))
+	t.Cleanup(hung.Close)
+	notFound := singleCodeServer(t, http.StatusNotFound)
+
+	ref := NewSimple(ctx, "", []remote.Remote{
+		&timeoutRemote{Remote: remote.NewEphemeralRemote(ctx, hung.URL, hung.Client()), timeout: time.Millisecond * 100},
+		remote.NewEphemeralRemote(ctx, notFound.URL, nil),
+	})
+	start := time.Now()
+	_, err := ref.Exists(ctx, "foo.txt", &schemas.RequestContext{})
+	assert.Error(t, err)
+	// the hung remote is abandoned once its
+	// own timeout expires
+	assert.Less(t, time.Since(start), time.Second*2)
+}
+
+func TestRefraction_Head(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+
+	store := storage.NewNoOp()
+	store.Data["generic-hosted/foo.txt"] = []byte("hello world")
+	noop := func(context.Context, string, string) error {
+		return nil
+	}
+	hosted := remote.NewBackedRemote(ctx, &model.Remote{
+		Name:      "generic-hosted",
+		Archetype: model.ArchetypeGeneric,
+		Hosted:    true,
+		Security:  &model.RemoteSecurity{},
+	}, store, &quota.NoopObserver{}, nil, nil, noop, func(context.Context, string, string, string) error {
+		return nil
+	}, nil, nil)
+	ref := NewSimple(ctx, "", []remote.Remote{hosted})
+
+	t.Run("stored file", func(t *testing.T) {
+		r, err := ref.Head(ctx, "foo.txt", &schemas.RequestContext{})
+		require.NoError(t, err)
+		defer r.Close()
+		obj, ok := r.(*storage.Object)
+		require.True(t, ok)
+		assert.EqualValues(t, 11, obj.Info().Size)
+		assert.NotEmpty(t, obj.Info().ETag)
+	})
+	t.Run("missing file", func(t *testing.T) {
+		_, err := ref.Head(ctx, "bar.txt", &schemas.RequestContext{})
+		assert.Error(t, err)
+	})
+	t.Run("upstream file", func(t *testing.T) {
+		r, err := NewSimple(ctx, "", []remote.Remote{remote.NewEphemeralRemote(ctx, singleCodeServer(t, http.StatusOK).URL, nil)}).Head(ctx, "foo.txt", &schemas.RequestContext{})
+		require.NoError(t, err)
+		_, ok := r.(*storage.Object)
+		assert.False(t, ok)
+	})
+}
@@ -18,17 +18,57 @@
 package refract
 
 import (
+	"context"
 	"errors"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"io"
+	"regexp"
 	"sync"
 )
 
 var ErrNotFound = errors.New("object could not be found in any remote")
 
+// Warning codes (RFC 7234) that are sent
+// with stale responses.
+const (
+	// WarningRevalidationFailed is used when a stale
+	// response is served because every remote failed.
+	WarningRevalidationFailed = `111 - "Revalidation Failed"`
+	// WarningDisconnected is used when a stale response
+	// is served because the Refraction is offline.
+	WarningDisconnected = `112 - "Disconnected Operation"`
+)
+
 type Refraction struct {
-	name    string
-	remotes []remote.Remote
-	rp      *sync.Pool
+	name     string
+	remotes  []remote.Remote
+	strategy model.ResolutionStrategy
+	pins     []Pin
+	offline  bool
+	rp       *sync.Pool
+}
+
+// Pin sends requests for paths that match
+// the pattern to a single remote.
+type Pin struct {
+	Pattern *regexp.Regexp
+	Remote  remote.Remote
+}
+
+// Stale is a response that was served from a
+// previous copy rather than from the remotes.
+type Stale struct {
+	io.Reader
+	Warning string
+}
+
+// Opener is implemented by remotes that can open
+// their stored copy of a file without downloading it.
+type Opener interface {
+	Open(ctx context.Context, path string, rctx *schemas.RequestContext) (*storage.Object, error)
 }
 
 type Message struct {
@@ -0,0 +1,83 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package resolver
+
+import (
+	"context"
+	"errors"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/errs"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+// Authorise checks that the user is allowed
+// to read from the refraction.
+func (r *Resolver) Authorise(ctx context.Context, bucket string) error {
+	_, err := r.refraction(ctx, bucket)
+	return err
+}
+
+// refraction returns the refraction that a request is
+// for, once we know that the user is allowed to read from it.
+func (r *Resolver) refraction(ctx context.Context, bucket string) (*refract.BackedRefraction, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Refraction", bucket)
+	ref, err := r.cache.Get(bucket)
+	if err != nil {
+		log.Error(err, "failed to retrieve requested refraction")
+		return nil, err
+	}
+	refraction := ref.(*refract.BackedRefraction)
+	if err := r.canRead(ctx, refraction.Model()); err != nil {
+		return nil, err
+	}
+	return refraction, nil
+}
+
+// canRead checks that the user has READ access
+// to the refraction if it is private.
+func (r *Resolver) canRead(ctx context.Context, ref *model.Refraction) error {
+	if !ref.Private {
+		return nil
+	}
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_canRead", trace.WithAttributes(
+		attribute.String("refraction", ref.Name),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Refraction", ref.Name)
+	if r.authz == nil {
+		log.Info("rejecting request to private refraction as no authoriser has been configured")
+		return problem.New(http.StatusForbidden).Errorf("you do not have permission to access this refraction")
+	}
+	if err := r.authz.CanI(ctx, repo.ResourceRefraction, ref.ID, rbac.Verb_READ); err != nil {
+		log.Info("rejecting request to private refraction", "Error", err.Error())
+		if errors.Is(err, errs.ErrUnauthorised) {
+			return problem.New(http.StatusUnauthorized).Errorf("you must be logged in to access this refraction")
+		}
+		return problem.New(http.StatusForbidden).Errorf("you do not have permission to access this refraction")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package resolver
+
+import (
+	"context"
+	"github.com/lpar/problem"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/errs"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"net/http"
+	"testing"
+)
+
+type testAuthoriser struct {
+	err error
+}
+
+func (t *testAuthoriser) CanI(context.Context, repo.Resource, string, rbac.Verb) error {
+	return t.err
+}
+
+func TestResolver_canRead(t *testing.T) {
+	var cases = []struct {
+		name    string
+		private bool
+		authz   Authoriser
+		code    int
+	}{
+		{"public", false, nil, http.StatusOK},
+		{"public anonymous", false, &testAuthoriser{err: errs.ErrUnauthorised}, http.StatusOK},
+		{"private", true, &testAuthoriser{}, http.StatusOK},
+		{"private anonymous", true, &testAuthoriser{err: errs.ErrUnauthorised}, http.StatusUnauthorized},
+		{"private forbidden", true, &testAuthoriser{err: errs.ErrForbidden}, http.StatusForbidden},
+		{"private no authoriser", true, nil, http.StatusForbidden},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Resolver{authz: tt.authz}
+			err := r.canRead(context.TODO(), &model.Refraction{ID: "foo", Name: "foo", Private: tt.private})
+			if tt.code == http.StatusOK {
+				assert.NoError(t, err)
+				return
+			}
+			var p *problem.ProblemDetails
+			assert.ErrorAs(t, err, &p)
+			assert.EqualValues(t, tt.code, p.Status)
+		})
+	}
+}
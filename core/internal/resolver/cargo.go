@@ -0,0 +1,32 @@
+package resolver
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/impl/cargoapi"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"io"
+)
+
+func (r *Resolver) ResolveCargo(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_cargo")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("cargo")
+	log.V(3).Info("handling Cargo request", "Payload", req)
+	refraction, err := r.refraction(ctx, req.bucket)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case req.path == cargoapi.ConfigName:
+		log.V(1).Info("fetching config")
+		return r.cargo.Config(ctx, refraction.Refraction(), rctx)
+	case cargoapi.IsDownload(req.path):
+		log.V(1).Info("fetching crate")
+		return r.cargo.Download(ctx, refraction.Refraction(), req.path, rctx)
+	}
+	log.V(1).Info("fetching index")
+	return r.cargo.Index(ctx, refraction.Refraction(), req.path, rctx)
+}
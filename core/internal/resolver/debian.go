@@ -0,0 +1,29 @@
+package resolver
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/impl/debapi"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"io"
+)
+
+func (r *Resolver) ResolveDebian(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_debian")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("debian")
+	log.V(3).Info("handling Debian request", "Payload", req)
+	refraction, err := r.refraction(ctx, req.bucket)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case debapi.IsIndex(req.path):
+		return r.debian.Index(ctx, refraction.Refraction(), req.path, rctx)
+	case debapi.IsPool(req.path):
+		return r.debian.Pool(ctx, refraction.Refraction(), req.path, rctx)
+	}
+	return refraction.Download(ctx, req.path, rctx)
+}
@@ -0,0 +1,112 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/errs"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/impl/pypiapi"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"io"
+	"net/http"
+)
+
+func (r *Resolver) Upload(ctx context.Context, req *Request, body io.Reader) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_generic_upload")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("generic")
+	log.V(3).Info("handling generic upload", "Payload", req)
+	refraction, rm, err := r.hosted(ctx, req.bucket)
+	if err != nil {
+		return err
+	}
+	// other archetypes have an index that needs to be
+	// updated, so files must go through their own client
+	if refraction.Model().Archetype != model.ArchetypeGeneric {
+		log.V(1).Info("rejecting generic upload to non-generic refraction", "Archetype", refraction.Model().Archetype)
+		return problem.New(http.StatusMethodNotAllowed).Errorf("refraction does not accept generic uploads")
+	}
+	return rm.Upload(ctx, req.path, body)
+}
+
+func (r *Resolver) PublishNPM(ctx context.Context, req *NPMRequest, body io.Reader) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_npm_publish")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("npm")
+	log.V(3).Info("handling NPM publish", "Payload", req)
+	_, rm, err := r.hosted(ctx, req.bucket)
+	if err != nil {
+		return err
+	}
+	return r.npm.Publish(ctx, rm, req.pkg, body)
+}
+
+func (r *Resolver) PushHelm(ctx context.Context, req *Request, body io.Reader) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_helm_push")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("helm")
+	log.V(3).Info("handling Helm push", "Payload", req)
+	_, rm, err := r.hosted(ctx, req.bucket)
+	if err != nil {
+		return err
+	}
+	return r.helm.Push(ctx, rm, body)
+}
+
+func (r *Resolver) UploadPyPi(ctx context.Context, req *Request, upload *pypiapi.Upload) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_pypi_upload")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("pypi")
+	log.V(3).Info("handling PyPi upload", "Payload", req, "Package", upload.Name, "Filename", upload.Filename)
+	_, rm, err := r.hosted(ctx, req.bucket)
+	if err != nil {
+		return err
+	}
+	return r.pypi.Upload(ctx, rm, upload)
+}
+
+// hosted returns the hosted remote that uploads to the
+// refraction are saved to, once we know that the user is
+// allowed to create files in it.
+func (r *Resolver) hosted(ctx context.Context, bucket string) (*refract.BackedRefraction, *remote.BackedRemote, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_hosted")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Refraction", bucket)
+	ref, err := r.cache.Get(bucket)
+	if err != nil {
+		log.Error(err, "failed to retrieve requested refraction")
+		return nil, nil, err
+	}
+	refraction := ref.(*refract.BackedRefraction)
+	// uploads go to the first hosted
+	// remote in the refraction
+	for _, rem := range refraction.Refraction().Remotes() {
+		rm, ok := rem.(*remote.BackedRemote)
+		if !ok || !rm.Model().Hosted {
+			continue
+		}
+		span.SetAttributes(attribute.String("remote", rm.Model().Name))
+		if r.authz == nil {
+			log.Info("rejecting upload as no authoriser has been configured")
+			return nil, nil, problem.New(http.StatusForbidden).Errorf("you do not have permission to upload to this refraction")
+		}
+		if err := r.authz.CanI(ctx, repo.ResourceRemote, rm.Model().ID, rbac.Verb_CREATE); err != nil {
+			log.Info("rejecting upload to hosted remote", "Remote", rm.Model().Name, "Error", err.Error())
+			if errors.Is(err, errs.ErrUnauthorised) {
+				return nil, nil, problem.New(http.StatusUnauthorized).Errorf("you must be logged in to upload to this refraction")
+			}
+			return nil, nil, problem.New(http.StatusForbidden).Errorf("you do not have permission to upload to this refraction")
+		}
+		return refraction, rm, nil
+	}
+	log.V(1).Info("refraction does not contain a hosted remote")
+	return nil, nil, problem.New(http.StatusMethodNotAllowed).Errorf("refraction does not contain a hosted remote")
+}
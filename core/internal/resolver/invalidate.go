@@ -0,0 +1,106 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package resolver
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/db/notify"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Listen evicts cached refractions when the database
+// tells us that they (or any of their remotes) have
+// changed. Since every replica receives the
+// notification, changes are picked up everywhere
+// rather than once the cache expires.
+//
+// Evicted refractions are rebuilt on their next
+// request, which also rebuilds the policy enforcer
+// and HTTP client of each remote.
+func (r *Resolver) Listen(ctx context.Context, notifier *notify.Notifier) {
+	log := logr.FromContextOrDiscard(ctx).WithName("resolver")
+	l := make(chan *notify.Message)
+	notifier.AddListener(ctx, l)
+	log.V(1).Info("listening for changes to refractions")
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.V(1).Info("halting listener as the context has completed")
+				notifier.RemoveListener(ctx, l)
+				return
+			case msg := <-l:
+				r.evict(ctx, msg)
+			}
+		}
+	}()
+}
+
+// evict removes any cached refraction
+// that is affected by the message.
+func (r *Resolver) evict(ctx context.Context, msg *notify.Message) {
+	if msg == nil {
+		return
+	}
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_evict")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("table", msg.Table),
+		attribute.String("id", msg.ID),
+	)
+	log := logr.FromContextOrDiscard(ctx).WithValues("Table", msg.Table, "ID", msg.ID, "Operation", msg.Operation)
+	for k, v := range r.cache.GetALL(false) {
+		br, ok := v.(*refract.BackedRefraction)
+		if !ok || !affects(br.Model(), msg) {
+			continue
+		}
+		log.V(1).Info("evicting refraction from cache", "Name", k)
+		r.cache.Remove(k)
+	}
+}
+
+// affects returns true if the message refers to the
+// refraction or to something that it depends on.
+func affects(ref *model.Refraction, msg *notify.Message) bool {
+	if msg.Table == schemas.TableNameRefractions {
+		return ref.ID == msg.ID
+	}
+	for _, rm := range ref.Remotes {
+		switch msg.Table {
+		case schemas.TableNameRemotes:
+			if rm.ID == msg.ID {
+				return true
+			}
+		case schemas.TableNameRemoteSecurities:
+			if rm.SecurityID == msg.ID {
+				return true
+			}
+		case schemas.TableNameTransportSecurities:
+			if rm.TransportID == msg.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package resolver
+
+import (
+	"context"
+	"github.com/bluele/gcache"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/db/notify"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"testing"
+)
+
+func TestResolver_evict(t *testing.T) {
+	ctx := context.TODO()
+	newRemote := func(id string) *model.Remote {
+		return &model.Remote{
+			ID:          id,
+			Name:        id,
+			Archetype:   model.ArchetypeGeneric,
+			SecurityID:  id + "-security",
+			Security:    &model.RemoteSecurity{},
+			TransportID: id + "-transport",
+			Transport:   &model.TransportSecurity{},
+		}
+	}
+	var cases = []struct {
+		name  string
+		msg   *notify.Message
+		evict []string
+	}{
+		{
+			"refraction",
+			&notify.Message{Table: schemas.TableNameRefractions, ID: "ref-a"},
+			[]string{"a"},
+		},
+		{
+			"shared remote",
+			&notify.Message{Table: schemas.TableNameRemotes, ID: "shared"},
+			[]string{"a", "b"},
+		},
+		{
+			"remote security",
+			&notify.Message{Table: schemas.TableNameRemoteSecurities, ID: "only-b-security"},
+			[]string{"b"},
+		},
+		{
+			"transport security",
+			&notify.Message{Table: schemas.TableNameTransportSecurities, ID: "only-b-transport"},
+			[]string{"b"},
+		},
+		{
+			"unrelated table",
+			&notify.Message{Table: schemas.TableNameStoredUsers, ID: "shared"},
+			nil,
+		},
+		{
+			"unknown remote",
+			&notify.Message{Table: schemas.TableNameRemotes, ID: "missing"},
+			nil,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Resolver{cache: gcache.New(10).ARC().Build()}
+			_ = r.cache.Set("a", refract.NewBackedRefraction(ctx, &model.Refraction{
+				ID:      "ref-a",
+				Name:    "a",
+				Remotes: []*model.Remote{newRemote("shared")},
+			}, storage.NewNoOp(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil))
+			_ = r.cache.Set("b", refract.NewBackedRefraction(ctx, &model.Refraction{
+				ID:      "ref-b",
+				Name:    "b",
+				Remotes: []*model.Remote{newRemote("shared"), newRemote("only-b")},
+			}, storage.NewNoOp(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil))
+
+			r.evict(ctx, tt.msg)
+
+			for _, k := range []string{"a", "b"} {
+				evicted := false
+				for _, e := range tt.evict {
+					if e == k {
+						evicted = true
+					}
+				}
+				assert.EqualValues(t, !evicted, r.cache.Has(k), k)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package resolver
 import (
 	"context"
 	"github.com/go-logr/logr"
-	"gitlab.com/go-prism/prism3/core/internal/refract"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
@@ -21,16 +20,15 @@ func (r *Resolver) ResolveNPM(ctx context.Context, req *NPMRequest, _ *schemas.R
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithName("npm")
 	log.V(3).Info("handling NPM request", "Payload", req)
-	ref, err := r.cache.Get(req.bucket)
+	refraction, err := r.refraction(ctx, req.bucket)
 	if err != nil {
-		log.Error(err, "failed to retrieve requested refraction")
 		return nil, err
 	}
-	refraction := ref.(*refract.BackedRefraction)
+	pol := r.quarantine(ctx, refraction)
 	if req.version != "" {
 		log.V(1).Info("fetching package version")
-		return r.npm.PackageVersion(ctx, refraction.Refraction(), req.pkg, req.version)
+		return r.npm.PackageVersion(ctx, refraction.Refraction(), req.pkg, req.version, pol, refraction.Advisories())
 	}
 	log.V(1).Info("fetching package")
-	return r.npm.Package(ctx, refraction.Refraction(), req.pkg)
+	return r.npm.Package(ctx, refraction.Refraction(), req.pkg, pol, refraction.Advisories())
 }
@@ -0,0 +1,35 @@
+package resolver
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/impl/ociapi"
+	"gitlab.com/go-prism/prism3/core/internal/refract"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"io"
+	"net/http"
+)
+
+func (r *Resolver) ResolveOCI(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "resolver_oci")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("oci")
+	log.V(3).Info("handling OCI request", "Payload", req)
+	refraction, err := r.refraction(ctx, req.bucket)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case ociapi.IsManifest(req.path):
+		// clients need the digest of the manifest, so
+		// we need to download it even for a HEAD request
+		return r.oci.Manifest(ctx, refraction.Refraction(), req.path, rctx)
+	case ociapi.IsBlob(req.path):
+		return r.oci.Blob(ctx, refraction.Refraction(), req.path, req.method, rctx)
+	}
+	log.V(1).Info("unsupported registry path", "Path", req.path)
+	return nil, problem.Errorf(http.StatusNotFound, refract.ErrNotFound.Error())
+}
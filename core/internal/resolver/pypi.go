@@ -3,7 +3,6 @@ package resolver
 import (
 	"context"
 	"github.com/go-logr/logr"
-	"gitlab.com/go-prism/prism3/core/internal/refract"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
@@ -15,11 +14,9 @@ func (r *Resolver) ResolvePyPi(ctx context.Context, req *Request, _ *schemas.Req
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithName("pypi")
 	log.V(3).Info("handling PyPi request", "Payload", req)
-	ref, err := r.cache.Get(req.bucket)
+	refraction, err := r.refraction(ctx, req.bucket)
 	if err != nil {
-		log.Error(err, "failed to retrieve requested refraction")
 		return nil, err
 	}
-	refraction := ref.(*refract.BackedRefraction)
-	return r.pypi.Index(ctx, refraction.Refraction(), req.path)
+	return r.pypi.Index(ctx, refraction.Refraction(), req.path, r.quarantine(ctx, refraction), refraction.Advisories())
 }
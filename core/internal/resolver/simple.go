@@ -18,17 +18,25 @@
 package resolver
 
 import (
-	"bytes"
 	"context"
 	"github.com/bluele/gcache"
 	"github.com/go-logr/logr"
 	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/impl/apkapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/cargoapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/debapi"
 	"gitlab.com/go-prism/prism3/core/internal/impl/helmapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/mavenapi"
 	"gitlab.com/go-prism/prism3/core/internal/impl/npmapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/ociapi"
 	"gitlab.com/go-prism/prism3/core/internal/impl/pypiapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/rpmapi"
 	"gitlab.com/go-prism/prism3/core/internal/refract"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/quarantine"
 	"gitlab.com/go-prism/prism3/core/pkg/quota"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/storage"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
@@ -44,10 +52,20 @@ func (r *Request) New(bucket, path, method string) {
 	r.method = method
 }
 
-func NewResolver(ctx context.Context, repos *repo.Repos, store storage.Reader, publicURL string) *Resolver {
+func NewResolver(ctx context.Context, repos *repo.Repos, store storage.Reader, locker remote.Locker, health *remote.Health, publicURL string, apk apkapi.Options, authz Authoriser) *Resolver {
 	r := new(Resolver)
 	r.repos = repos
+	r.authz = authz
 	r.ctx = ctx
+	r.flight = remote.NewCoalescer(locker)
+	// hosted uploads always need a lock, even
+	// if there's only a single replica
+	uploads := locker
+	if uploads == nil {
+		uploads = remote.NewMemoryLocker()
+	}
+	r.health = health
+	r.limits = quota.NewEnforcer(ctx, repos.BandwidthRepo, quota.NewNetObserver(ctx, repos.BandwidthRepo))
 
 	// caches
 	r.cache = gcache.New(1000).ARC().Expiration(time.Minute * 5).LoaderFunc(r.getRefraction).Build()
@@ -55,9 +73,15 @@ func NewResolver(ctx context.Context, repos *repo.Repos, store storage.Reader, p
 	r.store = store
 
 	// providers
-	r.helm = helmapi.NewIndex(repos, publicURL)
-	r.npm = npmapi.NewProvider(repos, publicURL)
-	r.pypi = pypiapi.NewProvider(repos, publicURL)
+	r.apk = apkapi.NewProvider(ctx, repos, store, apk)
+	r.cargo = cargoapi.NewProvider(publicURL)
+	r.debian = debapi.NewProvider()
+	r.helm = helmapi.NewIndex(repos, publicURL, uploads)
+	r.maven = mavenapi.NewProvider()
+	r.npm = npmapi.NewProvider(repos, publicURL, uploads)
+	r.oci = ociapi.NewProvider()
+	r.pypi = pypiapi.NewProvider(repos, publicURL, uploads)
+	r.rpm = rpmapi.NewProvider()
 	return r
 }
 
@@ -66,13 +90,20 @@ func (r *Resolver) ResolveHelm(ctx context.Context, req *Request, _ *schemas.Req
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithName("helm")
 	log.V(3).Info("handling Helm request", "Payload", req)
-	ref, err := r.cache.Get(req.bucket)
+	refraction, err := r.refraction(ctx, req.bucket)
 	if err != nil {
-		log.Error(err, "failed to retrieve requested refraction")
 		return nil, err
 	}
-	refraction := ref.(*refract.BackedRefraction)
-	return r.helm.Serve(ctx, refraction)
+	return r.helm.Serve(ctx, refraction, r.quarantine(ctx, refraction), refraction.Advisories())
+}
+
+// quarantine returns the policy that hides recently
+// published versions of packages in the refraction.
+func (r *Resolver) quarantine(ctx context.Context, ref *refract.BackedRefraction) *quarantine.Policy {
+	if ref.Model().QuarantineDays <= 0 {
+		return nil
+	}
+	return quarantine.Load(ctx, ref.Model(), r.repos.QuarantineRepo.ListExemptions)
 }
 
 func (r *Resolver) Resolve(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error) {
@@ -80,20 +111,53 @@ func (r *Resolver) Resolve(ctx context.Context, req *Request, rctx *schemas.Requ
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithName("generic")
 	log.V(3).Info("handling generic request", "Payload", req)
-	ref, err := r.cache.Get(req.bucket)
+	br, err := r.refraction(ctx, req.bucket)
 	if err != nil {
-		log.Error(err, "failed to retrieve requested refraction")
 		span.RecordError(err)
 		return nil, err
 	}
 	// if we received a HEAD request, just check if
 	// the resource exists
-	br := ref.(*refract.BackedRefraction)
 	if req.method == http.MethodHead {
-		if _, err := br.Exists(ctx, req.path, rctx); err != nil {
-			return nil, err
+		// merged documents have to be generated so that
+		// the headers match what a GET would return
+		if br.Model().Archetype == model.ArchetypeMaven && mavenapi.IsMetadata(req.path) {
+			return r.maven.Metadata(ctx, br.Refraction(), req.path, rctx)
+		}
+		return br.Head(ctx, req.path, rctx)
+	}
+	switch br.Model().Archetype {
+	case model.ArchetypeAlpine:
+		// the index is merged from all remotes
+		// and signed with our own key
+		if apkapi.IsKey(req.path) {
+			return r.apk.PublicKey(ctx)
+		}
+		if apkapi.IsIndex(req.path) {
+			return r.apk.Index(ctx, br.Refraction(), req.path, rctx)
+		}
+	case model.ArchetypeMaven:
+		// maven metadata needs to be merged from
+		// all remotes rather than served from the
+		// first one that has it
+		if mavenapi.IsMetadata(req.path) {
+			return r.maven.Metadata(ctx, br.Refraction(), req.path, rctx)
+		}
+	case model.ArchetypeNpm:
+		// tarballs are checked against the
+		// digest in the package document
+		if npmapi.IsTarball(req.path) {
+			return r.npm.Tarball(ctx, br.Refraction(), req.path, rctx)
+		}
+	case model.ArchetypeRpm:
+		// repodata needs to come from the same
+		// remote as the repomd.xml that references it
+		if rpmapi.IsMetadata(req.path) {
+			return r.rpm.Metadata(ctx, br.Refraction(), req.path, rctx)
+		}
+		if rpmapi.IsRepodata(req.path) {
+			return r.rpm.Repodata(ctx, br.Refraction(), req.path, rctx)
 		}
-		return bytes.NewBuffer(nil), nil
 	}
 	return br.Download(ctx, req.path, rctx)
 }
@@ -117,9 +181,29 @@ func (r *Resolver) getRefraction(v any) (any, error) {
 		r.ctx,
 		ref,
 		r.store,
-		quota.NewNetObserver(r.ctx, r.repos.BandwidthRepo),
+		r.limits,
+		r.flight,
+		r.health,
 		r.repos.ArtifactRepo.CreateArtifact,
+		r.repos.ArtifactRepo.SetDigest,
 		r.repos.PyPackageRepo.GetPackage,
 		r.repos.HelmPackageRepo.GetPackage,
+		r.repos.AdvisoryRepo.ListAffected,
+		r.repos.QuarantineRepo.ListExemptions,
+		r.published,
 	), nil
 }
+
+// published returns when a file was published,
+// so that the quarantine can be applied to it.
+func (r *Resolver) published(ctx context.Context, ref *model.Refraction, name, version, file string) (time.Time, error) {
+	switch ref.Archetype {
+	case model.ArchetypeNpm:
+		return r.repos.NPMPackageRepo.GetPublishTime(ctx, ref.Name, name, version)
+	case model.ArchetypePip:
+		return r.repos.PyPackageRepo.GetUploadTime(ctx, file)
+	case model.ArchetypeHelm:
+		return r.repos.HelmPackageRepo.GetCreated(ctx, name, version)
+	}
+	return time.Time{}, nil
+}
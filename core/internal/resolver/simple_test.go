@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/internal/impl/apkapi"
 	"gitlab.com/go-prism/prism3/core/pkg/db"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
@@ -83,7 +84,7 @@ func TestResolver_Resolve(t *testing.T) {
 	require.NoError(t, err)
 
 	// set up the resolver
-	r := NewResolver(ctx, repos, storage.NewNoOp(), "https://prism.example.org")
+	r := NewResolver(ctx, repos, storage.NewNoOp(), nil, nil, "https://prism.example.org", apkapi.Options{}, nil)
 	assert.NotNil(t, r)
 
 	// attempt to fetch something
@@ -20,34 +20,75 @@ package resolver
 import (
 	"context"
 	"github.com/bluele/gcache"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/impl/apkapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/cargoapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/debapi"
 	"gitlab.com/go-prism/prism3/core/internal/impl/helmapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/mavenapi"
 	"gitlab.com/go-prism/prism3/core/internal/impl/npmapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/ociapi"
 	"gitlab.com/go-prism/prism3/core/internal/impl/pypiapi"
+	"gitlab.com/go-prism/prism3/core/internal/impl/rpmapi"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/quota"
+	"gitlab.com/go-prism/prism3/core/pkg/remote"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/storage"
 	"io"
 )
 
+// Authoriser checks whether the user
+// can act on a resource.
+type Authoriser interface {
+	CanI(ctx context.Context, resource repo.Resource, resourceID string, verb rbac.Verb) error
+}
+
 type Resolver struct {
 	ctx   context.Context
 	repos *repo.Repos
+	authz Authoriser
 
 	// caches
 	cache gcache.Cache
+	// flight makes sure that only one request
+	// fills the cache for a file at a time
+	flight *remote.Coalescer
+	// health tracks the circuit breaker
+	// state of each remote
+	health *remote.Health
+	// limits records the bandwidth used by
+	// refractions and enforces their quotas
+	limits *quota.Enforcer
 
 	store storage.Reader
 	// providers
-	helm *helmapi.Index
-	npm  *npmapi.Provider
-	pypi *pypiapi.Provider
+	apk    *apkapi.Provider
+	cargo  *cargoapi.Provider
+	debian *debapi.Provider
+	helm   *helmapi.Index
+	maven  *mavenapi.Provider
+	npm    *npmapi.Provider
+	oci    *ociapi.Provider
+	pypi   *pypiapi.Provider
+	rpm    *rpmapi.Provider
 }
 
 type IResolver interface {
+	Authorise(ctx context.Context, bucket string) error
+
 	Resolve(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error)
+	ResolveCargo(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error)
+	ResolveDebian(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error)
 	ResolveHelm(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error)
 	ResolveNPM(ctx context.Context, req *NPMRequest, rctx *schemas.RequestContext) (io.Reader, error)
+	ResolveOCI(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error)
 	ResolvePyPi(ctx context.Context, req *Request, rctx *schemas.RequestContext) (io.Reader, error)
+
+	Upload(ctx context.Context, req *Request, r io.Reader) error
+	PublishNPM(ctx context.Context, req *NPMRequest, r io.Reader) error
+	PushHelm(ctx context.Context, req *Request, r io.Reader) error
+	UploadPyPi(ctx context.Context, req *Request, upload *pypiapi.Upload) error
 }
 
 type Request struct {
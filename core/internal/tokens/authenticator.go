@@ -0,0 +1,166 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package tokens
+
+import (
+	"context"
+	"errors"
+	"github.com/go-logr/logr"
+	"gitlab.com/av1o/cap10/pkg/client"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// touchInterval limits how often the time that
+// a token was last used is written.
+const touchInterval = time.Minute
+
+var (
+	ErrInvalid = errors.New("access token is not valid")
+	ErrExpired = errors.New("access token has expired")
+)
+
+func NewAuthenticator(c *client.Client, getToken repo.GetTokenFunc, touch repo.TouchTokenFunc) *Authenticator {
+	return &Authenticator{
+		client:   c,
+		getToken: getToken,
+		touch:    touch,
+	}
+}
+
+// WithOptionalUser is the same as client.WithOptionalUser,
+// except that an access token can be used instead.
+func (a *Authenticator) WithOptionalUser(h http.Handler) http.Handler {
+	return a.WithOptionalUserFunc(h.ServeHTTP)
+}
+
+// WithOptionalUserFunc is the same as client.WithOptionalUserFunc,
+// except that an access token can be used instead.
+func (a *Authenticator) WithOptionalUserFunc(f http.HandlerFunc) http.HandlerFunc {
+	return a.withToken(f, a.client.WithOptionalUserFunc(f))
+}
+
+// WithUserFunc is the same as client.WithUserFunc,
+// except that an access token can be used instead.
+func (a *Authenticator) WithUserFunc(f http.HandlerFunc) http.HandlerFunc {
+	return a.withToken(f, a.client.WithUserFunc(f))
+}
+
+// withToken authenticates requests that carry an
+// access token and passes everything else to next.
+//
+// Requests with a token that isn't valid are
+// rejected rather than treated as anonymous.
+func (a *Authenticator) withToken(f, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// the request may have already been authenticated
+		// by middleware higher up in the chain
+		if _, ok := FromContext(r.Context()); ok {
+			f(w, r)
+			return
+		}
+		secret, ok := extract(r)
+		if !ok {
+			next(w, r)
+			return
+		}
+		// the token is only meant for us, so make sure
+		// that it is never forwarded to a remote
+		r.Header.Del("Authorization")
+		token, err := a.verify(r.Context(), secret)
+		if err != nil {
+			metricAuth.Add(r.Context(), 1, attribute.Bool("ok", false))
+			w.Header().Set("WWW-Authenticate", `Basic realm="prism"`)
+			http.Error(w, "Unauthorised", http.StatusUnauthorized)
+			return
+		}
+		metricAuth.Add(r.Context(), 1, attribute.Bool("ok", true))
+		f(w, r.WithContext(NewContext(r.Context(), token)))
+	}
+}
+
+// verify finds the token that a secret belongs
+// to and checks that it can still be used.
+func (a *Authenticator) verify(ctx context.Context, secret string) (*schemas.AccessToken, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "tokens_verify")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	token, err := a.getToken(ctx, Hash(secret))
+	if err != nil {
+		log.Info("rejecting unknown access token")
+		return nil, ErrInvalid
+	}
+	log = log.WithValues("Token", token.ID, "Iss", token.Iss, "Sub", token.Sub)
+	now := time.Now()
+	if now.Unix() >= token.ExpiresAt {
+		log.Info("rejecting expired access token", "ExpiresAt", token.ExpiresAt)
+		return nil, ErrExpired
+	}
+	if now.Unix()-token.LastUsedAt >= int64(touchInterval.Seconds()) {
+		if err := a.touch(ctx, token.ID, now); err != nil {
+			log.Error(err, "failed to record access token usage")
+		}
+	}
+	log.V(1).Info("authenticated request using access token")
+	return token, nil
+}
+
+// extract finds an access token in the Authorization
+// header. Clients that only support Basic auth (e.g.,
+// pip) can send the token as either the username
+// or the password.
+func extract(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if scheme, token, ok := strings.Cut(header, " "); ok && strings.EqualFold(scheme, "Bearer") {
+		token = strings.TrimSpace(token)
+		return token, strings.HasPrefix(token, Prefix)
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return "", false
+	}
+	if strings.HasPrefix(password, Prefix) {
+		return password, true
+	}
+	if strings.HasPrefix(username, Prefix) {
+		return username, true
+	}
+	return "", false
+}
+
+// NewContext adds the identity of a token to the
+// context so that it looks like any other user.
+func NewContext(ctx context.Context, token *schemas.AccessToken) context.Context {
+	user := &client.UserClaim{
+		Sub: token.Sub,
+		Iss: token.Iss,
+	}
+	chain := &client.ChainClaim{
+		Subjects: []string{token.Sub},
+		Issuers:  []string{token.Iss},
+		RawClaim: user.AsUsername(),
+	}
+	ctx = client.PersistUserCtx(ctx, chain, user)
+	return context.WithValue(ctx, tokenContextKey, token)
+}
@@ -0,0 +1,154 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/av1o/cap10/pkg/client"
+	"gitlab.com/av1o/cap10/pkg/verify"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestExtract(t *testing.T) {
+	var cases = []struct {
+		name   string
+		setup  func(r *http.Request)
+		secret string
+		ok     bool
+	}{
+		{
+			"bearer",
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer prism_foo")
+			},
+			"prism_foo",
+			true,
+		},
+		{
+			"basic password",
+			func(r *http.Request) {
+				r.SetBasicAuth("__token__", "prism_foo")
+			},
+			"prism_foo",
+			true,
+		},
+		{
+			"basic username",
+			func(r *http.Request) {
+				r.SetBasicAuth("prism_foo", "")
+			},
+			"prism_foo",
+			true,
+		},
+		{
+			"foreign bearer",
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer foo")
+			},
+			"",
+			false,
+		},
+		{
+			"foreign basic",
+			func(r *http.Request) {
+				r.SetBasicAuth("user", "password")
+			},
+			"",
+			false,
+		},
+		{
+			"none",
+			func(*http.Request) {},
+			"",
+			false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			tt.setup(r)
+			secret, ok := extract(r)
+			assert.EqualValues(t, tt.ok, ok)
+			if ok {
+				assert.EqualValues(t, tt.secret, secret)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_WithOptionalUserFunc(t *testing.T) {
+	valid := &schemas.AccessToken{
+		AccessToken: model.AccessToken{
+			ID:        "valid",
+			Iss:       "CN=Test Issuer",
+			Sub:       "CN=Test User",
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		},
+	}
+	expired := &schemas.AccessToken{
+		AccessToken: model.AccessToken{
+			ID:        "expired",
+			ExpiresAt: time.Now().Add(-time.Hour).Unix(),
+		},
+	}
+	var touched []string
+	a := NewAuthenticator(client.NewClient(verify.NewNoOpVerifier()), func(ctx context.Context, hash string) (*schemas.AccessToken, error) {
+		switch hash {
+		case Hash("prism_valid"):
+			return valid, nil
+		case Hash("prism_expired"):
+			return expired, nil
+		}
+		return nil, errors.New("not found")
+	}, func(ctx context.Context, id string, at time.Time) error {
+		touched = append(touched, id)
+		return nil
+	})
+
+	var cases = []struct {
+		name   string
+		header string
+		code   int
+		user   string
+	}{
+		{"valid", "Bearer prism_valid", http.StatusOK, "CN=Test Issuer/CN=Test User"},
+		{"expired", "Bearer prism_expired", http.StatusUnauthorized, ""},
+		{"unknown", "Bearer prism_unknown", http.StatusUnauthorized, ""},
+		{"anonymous", "", http.StatusOK, ""},
+		{"foreign", "Bearer foo", http.StatusOK, ""},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var user string
+			var forwarded string
+			h := a.WithOptionalUserFunc(func(w http.ResponseWriter, r *http.Request) {
+				if u, ok := client.GetContextUser(r.Context()); ok {
+					user = u.AsUsername()
+				}
+				forwarded = r.Header.Get("Authorization")
+				w.WriteHeader(http.StatusOK)
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			h(w, r)
+			assert.EqualValues(t, tt.code, w.Code)
+			assert.EqualValues(t, tt.user, user)
+			// prism tokens must never be passed on
+			if tt.user != "" {
+				assert.Empty(t, forwarded)
+			}
+			if tt.name == "foreign" {
+				assert.EqualValues(t, tt.header, forwarded)
+			}
+		})
+	}
+	assert.EqualValues(t, []string{"valid"}, touched)
+}
@@ -0,0 +1,97 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package tokens
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/djcass44/go-utils/utilities/sliceutils"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"net/http"
+	"strings"
+)
+
+// Prefix marks secrets that were issued by Prism so
+// that they can be told apart from credentials
+// that are meant for a remote.
+const Prefix = "prism_"
+
+// IssuerServiceAccount is the issuer of the
+// identity that service accounts authenticate as.
+const IssuerServiceAccount = "prism:serviceaccount"
+
+// New generates the secret of an access token
+// and the hash that is stored in its place.
+func New() (string, string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", "", err
+	}
+	secret := Prefix + base64.RawURLEncoding.EncodeToString(data)
+	return secret, Hash(secret), nil
+}
+
+// Hash returns the SHA-256 of a secret.
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Scope formats the permission to perform
+// a verb on a single resource.
+func Scope(resource repo.Resource, id string, verb rbac.Verb) string {
+	return fmt.Sprintf("%s::%s::%s", resource, id, verb)
+}
+
+// ParseScope checks that a scope refers to a
+// refraction or remote and a known verb.
+func ParseScope(s string) error {
+	bits := strings.Split(s, "::")
+	if len(bits) != 3 || bits[1] == "" {
+		return problem.New(http.StatusBadRequest).Errorf("scope '%s' must be in the form <resource>::<id>::<verb>", s)
+	}
+	switch repo.Resource(bits[0]) {
+	case repo.ResourceRefraction, repo.ResourceRemote:
+	default:
+		return problem.New(http.StatusBadRequest).Errorf("scope '%s' must refer to a refraction or remote", s)
+	}
+	if _, ok := rbac.Verb_value[bits[2]]; !ok {
+		return problem.New(http.StatusBadRequest).Errorf("scope '%s' has an unknown verb", s)
+	}
+	return nil
+}
+
+// Allows returns true if a token has been
+// scoped to perform a verb on a resource.
+func Allows(t *schemas.AccessToken, resource repo.Resource, id string, verb rbac.Verb) bool {
+	return sliceutils.Includes(t.Scopes, Scope(resource, id, verb))
+}
+
+// FromContext returns the access token that
+// the request was authenticated with, if any.
+func FromContext(ctx context.Context) (*schemas.AccessToken, bool) {
+	t, ok := ctx.Value(tokenContextKey).(*schemas.AccessToken)
+	return t, ok
+}
@@ -0,0 +1,55 @@
+package tokens
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/go-rbac-proxy/pkg/rbac"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	secret, hash, err := New()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, Prefix))
+	assert.EqualValues(t, Hash(secret), hash)
+	assert.NotEqualValues(t, secret, hash)
+}
+
+func TestParseScope(t *testing.T) {
+	var cases = []struct {
+		in string
+		ok bool
+	}{
+		{"refraction::foo::READ", true},
+		{"remote::foo::UPDATE", true},
+		{"refraction::foo", false},
+		{"refraction::::READ", false},
+		{"super::foo::READ", false},
+		{"refraction::foo::FLY", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			err := ParseScope(tt.in)
+			if tt.ok {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAllows(t *testing.T) {
+	token := &schemas.AccessToken{
+		AccessToken: model.AccessToken{
+			Scopes: []string{Scope(repo.ResourceRefraction, "foo", rbac.Verb_READ)},
+		},
+	}
+	assert.True(t, Allows(token, repo.ResourceRefraction, "foo", rbac.Verb_READ))
+	assert.False(t, Allows(token, repo.ResourceRefraction, "foo", rbac.Verb_UPDATE))
+	assert.False(t, Allows(token, repo.ResourceRefraction, "bar", rbac.Verb_READ))
+	assert.False(t, Allows(token, repo.ResourceRemote, "foo", rbac.Verb_READ))
+}
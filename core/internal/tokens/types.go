@@ -0,0 +1,35 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package tokens
+
+import (
+	"gitlab.com/av1o/cap10/pkg/client"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+)
+
+// Authenticator accepts access tokens in place of
+// the identity that cap10 provides.
+type Authenticator struct {
+	client   *client.Client
+	getToken repo.GetTokenFunc
+	touch    repo.TouchTokenFunc
+}
+
+type contextKey int
+
+const tokenContextKey contextKey = iota
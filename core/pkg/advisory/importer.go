@@ -0,0 +1,238 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package advisory
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// batchSize is how many advisories are
+// saved in each transaction.
+const batchSize = 500
+
+// SchemeBucket marks a source that should be read
+// from object storage rather than the local disk.
+const SchemeBucket = "bucket://"
+
+func NewImporter(repos *repo.Repos, store storage.Reader) *Importer {
+	return &Importer{
+		repos: repos,
+		store: store,
+	}
+}
+
+// Import loads the advisories from an OSV dump. The
+// source is either a path on the local disk, or the
+// key of an object prefixed with "bucket://".
+//
+// A dump can be a JSON file containing one advisory
+// or a list of them, a zip archive of JSON files
+// (e.g., https://osv-vulnerabilities.storage.googleapis.com/npm/all.zip)
+// or a directory containing either.
+func (i *Importer) Import(ctx context.Context, source string) (*Report, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "advisory_import", trace.WithAttributes(
+		attribute.String("source", source),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Source", source)
+	log.Info("importing advisories")
+	b := &batch{repos: i.repos, report: &Report{}}
+	var err error
+	if key, ok := strings.CutPrefix(source, SchemeBucket); ok {
+		err = i.readObject(ctx, key, b.add)
+	} else {
+		err = readPath(ctx, strings.TrimPrefix(source, "file://"), b.add)
+	}
+	if err == nil {
+		err = b.flush(ctx)
+	}
+	if err != nil {
+		log.Error(err, "failed to import advisories")
+		span.RecordError(err)
+		return nil, err
+	}
+	log.Info("imported advisories", "Imported", b.report.Imported, "Withdrawn", b.report.Withdrawn, "Skipped", b.report.Skipped)
+	return b.report, nil
+}
+
+// readObject reads a dump from object storage. Zip
+// archives need random access, so they are copied
+// to a temporary file first.
+func (i *Importer) readObject(ctx context.Context, key string, fn func(ctx context.Context, v *Vulnerability) error) error {
+	r, _, err := i.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	if !strings.HasSuffix(key, ".zip") {
+		return readJSON(ctx, r, fn)
+	}
+	f, err := os.CreateTemp("", "osv-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return readZip(ctx, f.Name(), fn)
+}
+
+// readPath reads a dump from the local disk.
+func readPath(ctx context.Context, root string, fn func(ctx context.Context, v *Vulnerability) error) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch filepath.Ext(path) {
+		case ".zip":
+			return readZip(ctx, path, fn)
+		case ".json":
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			return readJSON(ctx, f, fn)
+		}
+		return nil
+	})
+}
+
+func readZip(ctx context.Context, path string, fn func(ctx context.Context, v *Vulnerability) error) error {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || filepath.Ext(f.Name) != ".json" {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = readJSON(ctx, r, fn)
+		_ = r.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// readJSON decodes a single advisory
+// or a list of advisories.
+func readJSON(ctx context.Context, r io.Reader, fn func(ctx context.Context, v *Vulnerability) error) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	data = bytes.TrimSpace(data)
+	var vulns []*Vulnerability
+	if bytes.HasPrefix(data, []byte("[")) {
+		err = json.Unmarshal(data, &vulns)
+	} else {
+		var v Vulnerability
+		err = json.Unmarshal(data, &v)
+		vulns = append(vulns, &v)
+	}
+	if err != nil {
+		return err
+	}
+	for _, v := range vulns {
+		if v.ID == "" {
+			continue
+		}
+		if err := fn(ctx, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// batch collects advisories so that they
+// can be saved together.
+type batch struct {
+	repos     *repo.Repos
+	report    *Report
+	saved     []*model.Advisory
+	packages  []*schemas.AdvisoryPackage
+	withdrawn []string
+	// ids holds the advisories in the batch, since
+	// the same row can't be upserted twice at once
+	ids map[string]struct{}
+}
+
+func (b *batch) add(ctx context.Context, v *Vulnerability) error {
+	if _, ok := b.ids[v.ID]; ok {
+		if err := b.flush(ctx); err != nil {
+			return err
+		}
+	}
+	if b.ids == nil {
+		b.ids = map[string]struct{}{}
+	}
+	b.ids[v.ID] = struct{}{}
+	if v.Withdrawn != nil {
+		b.withdrawn = append(b.withdrawn, v.ID)
+	} else if adv, packages, ok := convert(v); ok {
+		b.saved = append(b.saved, adv)
+		b.packages = append(b.packages, packages...)
+	} else {
+		b.report.Skipped++
+	}
+	if len(b.saved)+len(b.withdrawn) < batchSize {
+		return nil
+	}
+	return b.flush(ctx)
+}
+
+func (b *batch) flush(ctx context.Context) error {
+	if err := b.repos.AdvisoryRepo.SaveAdvisories(ctx, b.saved, b.packages); err != nil {
+		return err
+	}
+	if err := b.repos.AdvisoryRepo.DeleteAdvisories(ctx, b.withdrawn); err != nil {
+		return err
+	}
+	metricImported.Add(ctx, int64(len(b.saved)))
+	b.report.Imported += len(b.saved)
+	b.report.Withdrawn += len(b.withdrawn)
+	b.saved, b.packages, b.withdrawn, b.ids = nil, nil, nil, nil
+	return nil
+}
@@ -0,0 +1,233 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package advisory
+
+import (
+	"encoding/json"
+	"github.com/Masterminds/semver/v3"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ecosystems maps each archetype to the
+// name of its ecosystem in OSV. OSV doesn't
+// publish advisories for Helm, so they must
+// come from a private database.
+var ecosystems = map[model.Archetype]string{
+	model.ArchetypeNpm:  "npm",
+	model.ArchetypePip:  "PyPI",
+	model.ArchetypeHelm: "Helm",
+}
+
+var regexPyName = regexp.MustCompile(`[-_.]+`)
+
+// Ecosystem returns the OSV ecosystem of an archetype,
+// or an empty string if it isn't supported.
+func Ecosystem(archetype model.Archetype) string {
+	return ecosystems[archetype]
+}
+
+// normalise converts a package name into the form
+// that is used to store and look up advisories.
+func normalise(ecosystem, name string) string {
+	name = strings.ToLower(name)
+	if ecosystem == ecosystems[model.ArchetypePip] {
+		// PEP 503
+		return regexPyName.ReplaceAllString(name, "-")
+	}
+	return name
+}
+
+// convert splits an OSV advisory into the records that
+// are saved in the database. It returns false if the
+// advisory doesn't affect any supported ecosystem.
+func convert(v *Vulnerability) (*model.Advisory, []*schemas.AdvisoryPackage, bool) {
+	var packages []*schemas.AdvisoryPackage
+	for _, a := range v.Affected {
+		if !supported(a.Package.Ecosystem) || a.Package.Name == "" {
+			continue
+		}
+		data, err := json.Marshal(a)
+		if err != nil {
+			continue
+		}
+		packages = append(packages, &schemas.AdvisoryPackage{
+			AdvisoryID: v.ID,
+			Ecosystem:  a.Package.Ecosystem,
+			Name:       normalise(a.Package.Ecosystem, a.Package.Name),
+			Affected:   data,
+		})
+	}
+	if len(packages) == 0 {
+		return nil, nil, false
+	}
+	aliases := v.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return &model.Advisory{
+		ID:        v.ID,
+		Summary:   v.Summary,
+		Aliases:   aliases,
+		Severity:  severity(v),
+		Published: v.Published.Unix(),
+		Modified:  v.Modified.Unix(),
+	}, packages, true
+}
+
+func supported(ecosystem string) bool {
+	for _, e := range ecosystems {
+		if e == ecosystem {
+			return true
+		}
+	}
+	return false
+}
+
+// severity prefers the rating given by the database
+// (e.g., "HIGH" from GitHub) over the CVSS vector.
+func severity(v *Vulnerability) string {
+	if s, ok := v.DatabaseSpecific["severity"].(string); ok && s != "" {
+		return s
+	}
+	if len(v.Severity) > 0 {
+		return v.Severity[0].Score
+	}
+	return ""
+}
+
+// Affects returns true if a version is vulnerable.
+//
+// Explicitly listed versions are checked first. SEMVER
+// ranges are evaluated as semantic versions. ECOSYSTEM
+// ranges use the version scheme of the ecosystem, which
+// is PEP 440 for PyPI and semantic versioning for NPM
+// and Helm. Ranges whose versions can't be parsed
+// are ignored.
+func (a *Affected) Affects(version string) bool {
+	for _, v := range a.Versions {
+		if v == version {
+			return true
+		}
+	}
+	for _, r := range a.Ranges {
+		var cmp compareFunc
+		switch {
+		case r.Type == "SEMVER":
+			cmp = compareSemver
+		case r.Type == "ECOSYSTEM" && a.Package.Ecosystem == ecosystems[model.ArchetypePip]:
+			cmp = comparePEP440
+		case r.Type == "ECOSYSTEM":
+			cmp = compareSemver
+		default:
+			continue
+		}
+		if inRange(version, r.Events, cmp) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareFunc returns -1, 0 or 1 depending on whether
+// a is older than, the same as or newer than b. It
+// returns false if either version can't be parsed.
+type compareFunc func(a, b string) (int, bool)
+
+func compareSemver(a, b string) (int, bool) {
+	parse := func(s string) (*semver.Version, error) {
+		if s == "0" {
+			s = "0.0.0"
+		}
+		return semver.NewVersion(s)
+	}
+	av, err := parse(a)
+	if err != nil {
+		return 0, false
+	}
+	bv, err := parse(b)
+	if err != nil {
+		return 0, false
+	}
+	return av.Compare(bv), true
+}
+
+func comparePEP440(a, b string) (int, bool) {
+	av, err := parsePEP440(a)
+	if err != nil {
+		return 0, false
+	}
+	bv, err := parsePEP440(b)
+	if err != nil {
+		return 0, false
+	}
+	return av.Compare(bv), true
+}
+
+// inRange walks the events of a range in version
+// order to work out whether a version is affected.
+func inRange(v string, events []Event, cmp compareFunc) bool {
+	if _, ok := cmp(v, v); !ok {
+		return false
+	}
+	parsed := make([]string, 0, len(events))
+	for _, e := range events {
+		s := e.Introduced + e.Fixed + e.LastAffected + e.Limit
+		if _, ok := cmp(s, s); !ok {
+			// we can't tell where the range
+			// starts or ends
+			return false
+		}
+		parsed = append(parsed, s)
+	}
+	order := make([]int, len(events))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		c, _ := cmp(parsed[order[i]], parsed[order[j]])
+		return c < 0
+	})
+	affected := false
+	for _, i := range order {
+		e := events[i]
+		c, _ := cmp(v, parsed[i])
+		switch {
+		case e.Introduced != "":
+			if c >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if c >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if c > 0 {
+				affected = false
+			}
+		case e.Limit != "":
+			if c >= 0 {
+				affected = false
+			}
+		}
+	}
+	return affected
+}
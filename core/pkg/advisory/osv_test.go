@@ -0,0 +1,150 @@
+package advisory
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"testing"
+	"time"
+)
+
+func TestAffected_Affects(t *testing.T) {
+	a := &Affected{
+		Ranges: []Range{
+			{
+				Type: "SEMVER",
+				Events: []Event{
+					{Introduced: "0"},
+					{Fixed: "4.17.21"},
+					{Introduced: "5.0.0"},
+					{LastAffected: "5.1.0"},
+				},
+			},
+			{
+				Type:   "GIT",
+				Events: []Event{{Introduced: "abc123"}},
+			},
+		},
+		Versions: []string{"6.0.0-custom"},
+	}
+	var cases = []struct {
+		version string
+		ok      bool
+	}{
+		{"1.0.0", true},
+		{"4.17.20", true},
+		{"4.17.21", false},
+		{"4.18.0", false},
+		{"5.0.0", true},
+		{"5.1.0", true},
+		{"5.1.1", false},
+		{"6.0.0-custom", true},
+		{"not-a-version", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.EqualValues(t, tt.ok, a.Affects(tt.version))
+		})
+	}
+}
+
+func TestAffected_AffectsUnparseable(t *testing.T) {
+	a := &Affected{
+		Ranges: []Range{
+			{
+				Type:   "ECOSYSTEM",
+				Events: []Event{{Introduced: "0"}, {Fixed: "2.0.0.post1.dev3"}},
+			},
+		},
+	}
+	assert.False(t, a.Affects("1.0.0"))
+}
+
+func TestAffected_AffectsPyPI(t *testing.T) {
+	a := &Affected{
+		Package: Package{Ecosystem: "PyPI", Name: "requests"},
+		Ranges: []Range{
+			{
+				Type:   "ECOSYSTEM",
+				Events: []Event{{Introduced: "0"}, {Fixed: "2.0.0.post1"}},
+			},
+		},
+	}
+	var cases = []struct {
+		version string
+		ok      bool
+	}{
+		{"1.0", true},
+		{"1.0.post1", true},
+		{"2.0.0rc1", true},
+		{"2.0", true},
+		{"2.0.0.post1", false},
+		{"2.0.1.dev1", false},
+		{"not-a-version", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.EqualValues(t, tt.ok, a.Affects(tt.version))
+		})
+	}
+}
+
+func TestNormalise(t *testing.T) {
+	var cases = []struct {
+		ecosystem string
+		in        string
+		out       string
+	}{
+		{"PyPI", "Foo_Bar.baz", "foo-bar-baz"},
+		{"PyPI", "requests", "requests"},
+		{"npm", "@Types/Node", "@types/node"},
+		{"npm", "lodash.merge", "lodash.merge"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.EqualValues(t, tt.out, normalise(tt.ecosystem, tt.in))
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	v := &Vulnerability{
+		ID:        "GHSA-35jh-r3h4-6jhm",
+		Summary:   "Command Injection in lodash",
+		Published: time.Date(2021, time.May, 6, 0, 0, 0, 0, time.UTC),
+		Modified:  time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Affected: []Affected{
+			{Package: Package{Ecosystem: "npm", Name: "Lodash"}},
+			{Package: Package{Ecosystem: "Go", Name: "github.com/foo/bar"}},
+		},
+		Severity:         []Severity{{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:H/UI:N/S:U/C:H/I:H/A:H"}},
+		DatabaseSpecific: map[string]any{"severity": "HIGH"},
+	}
+	adv, packages, ok := convert(v)
+	require.True(t, ok)
+	assert.EqualValues(t, "GHSA-35jh-r3h4-6jhm", adv.ID)
+	assert.EqualValues(t, "HIGH", adv.Severity)
+	assert.Empty(t, adv.Aliases)
+	assert.EqualValues(t, v.Published.Unix(), adv.Published)
+
+	require.Len(t, packages, 1)
+	assert.EqualValues(t, "lodash", packages[0].Name)
+	assert.EqualValues(t, "npm", packages[0].Ecosystem)
+	var a Affected
+	require.NoError(t, json.Unmarshal(packages[0].Affected, &a))
+	assert.EqualValues(t, "Lodash", a.Package.Name)
+}
+
+func TestConvert_Unsupported(t *testing.T) {
+	_, _, ok := convert(&Vulnerability{
+		ID:       "GO-2022-0001",
+		Affected: []Affected{{Package: Package{Ecosystem: "Go", Name: "github.com/foo/bar"}}},
+	})
+	assert.False(t, ok)
+}
+
+func TestEcosystem(t *testing.T) {
+	assert.EqualValues(t, "PyPI", Ecosystem(model.ArchetypePip))
+	assert.Empty(t, Ecosystem(model.ArchetypeGo))
+}
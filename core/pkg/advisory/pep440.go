@@ -0,0 +1,220 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package advisory
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// regexPEP440 matches the version scheme used by PyPI,
+// including the alternative spellings that pip accepts.
+//
+// https://peps.python.org/pep-0440/#appendix-b-parsing-version-strings-with-regular-expressions
+var regexPEP440 = regexp.MustCompile(`^v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?:[-_.]?(?P<pre_l>alpha|beta|preview|pre|rc|a|b|c)[-_.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?:-(?P<post_n1>[0-9]+)|[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?)?` +
+	`(?:[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`)
+
+// pep440 is a parsed PyPI version.
+type pep440 struct {
+	epoch   uint64
+	release []uint64
+	// pre is the rank of the pre-release label
+	// (a < b < rc), or 0 if there isn't one
+	pre   int
+	preN  uint64
+	post  bool
+	postN uint64
+	dev   bool
+	devN  uint64
+	local []string
+}
+
+// parsePEP440 parses a version using the
+// rules of PEP 440.
+func parsePEP440(s string) (*pep440, error) {
+	m := regexPEP440.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if m == nil {
+		return nil, fmt.Errorf("invalid PEP 440 version: %q", s)
+	}
+	group := func(name string) string {
+		return m[regexPEP440.SubexpIndex(name)]
+	}
+	// numbers are validated by the regex, so the only
+	// error that can happen is them being too large
+	var err error
+	number := func(s string) uint64 {
+		if s == "" || err != nil {
+			return 0
+		}
+		var n uint64
+		n, err = strconv.ParseUint(s, 10, 64)
+		return n
+	}
+	v := &pep440{epoch: number(group("epoch"))}
+	for _, r := range strings.Split(group("release"), ".") {
+		v.release = append(v.release, number(r))
+	}
+	// trailing zeros don't change the
+	// version (i.e., 1.0 == 1.0.0)
+	for len(v.release) > 1 && v.release[len(v.release)-1] == 0 {
+		v.release = v.release[:len(v.release)-1]
+	}
+	switch group("pre_l") {
+	case "a", "alpha":
+		v.pre = 1
+	case "b", "beta":
+		v.pre = 2
+	case "rc", "c", "pre", "preview":
+		v.pre = 3
+	}
+	v.preN = number(group("pre_n"))
+	if n := group("post_n1"); n != "" {
+		v.post, v.postN = true, number(n)
+	} else if group("post_l") != "" {
+		v.post, v.postN = true, number(group("post_n2"))
+	}
+	if group("dev_l") != "" {
+		v.dev, v.devN = true, number(group("dev_n"))
+	}
+	if l := group("local"); l != "" {
+		v.local = strings.FieldsFunc(l, func(r rune) bool {
+			return r == '-' || r == '_' || r == '.'
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid PEP 440 version: %q: %w", s, err)
+	}
+	return v, nil
+}
+
+// Compare returns -1, 0 or 1 depending on whether
+// the version is older than, the same as or
+// newer than o.
+func (v *pep440) Compare(o *pep440) int {
+	if c := compareUint(v.epoch, o.epoch); c != 0 {
+		return c
+	}
+	for i := 0; i < len(v.release) || i < len(o.release); i++ {
+		var a, b uint64
+		if i < len(v.release) {
+			a = v.release[i]
+		}
+		if i < len(o.release) {
+			b = o.release[i]
+		}
+		if c := compareUint(a, b); c != 0 {
+			return c
+		}
+	}
+	// a development release of a final version
+	// (e.g., 1.0.dev1) comes before its pre-releases
+	if c := compareInt(v.preRank(), o.preRank()); c != 0 {
+		return c
+	}
+	if c := compareUint(v.preN, o.preN); c != 0 {
+		return c
+	}
+	if c := compareBool(v.post, o.post); c != 0 {
+		return c
+	}
+	if c := compareUint(v.postN, o.postN); c != 0 {
+		return c
+	}
+	// releases come after their development releases
+	if c := compareBool(!v.dev, !o.dev); c != 0 {
+		return c
+	}
+	if c := compareUint(v.devN, o.devN); c != 0 {
+		return c
+	}
+	return compareLocal(v.local, o.local)
+}
+
+// preRank orders the pre-release of a version,
+// with releases coming after any pre-release.
+func (v *pep440) preRank() int {
+	switch {
+	case v.pre != 0:
+		return v.pre
+	case v.dev && !v.post:
+		return -1
+	}
+	return 4
+}
+
+// compareLocal orders local version labels. Numeric
+// segments come after alphanumeric ones, and a
+// version without a label comes first.
+func compareLocal(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		an, aErr := strconv.ParseUint(a[i], 10, 64)
+		bn, bErr := strconv.ParseUint(b[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if c := compareUint(an, bn); c != 0 {
+				return c
+			}
+		case aErr == nil:
+			return 1
+		case bErr == nil:
+			return -1
+		default:
+			if c := strings.Compare(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
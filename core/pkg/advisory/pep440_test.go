@@ -0,0 +1,72 @@
+package advisory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPEP440_Compare(t *testing.T) {
+	// versions in ascending order, taken from
+	// the examples in PEP 440
+	ordered := []string{
+		"1.0.dev456",
+		"1.0a1",
+		"1.0a2.dev456",
+		"1.0a12.dev456",
+		"1.0a12",
+		"1.0b1.dev456",
+		"1.0b2",
+		"1.0b2.post345.dev456",
+		"1.0b2.post345",
+		"1.0rc1.dev456",
+		"1.0rc1",
+		"1.0",
+		"1.0+abc.5",
+		"1.0+abc.7",
+		"1.0+5",
+		"1.0.post456.dev34",
+		"1.0.post456",
+		"1.0.15",
+		"1.1.dev1",
+		"1!0.1",
+	}
+	for i := 0; i < len(ordered)-1; i++ {
+		a, err := parsePEP440(ordered[i])
+		require.NoError(t, err)
+		b, err := parsePEP440(ordered[i+1])
+		require.NoError(t, err)
+		assert.EqualValues(t, -1, a.Compare(b), "%s < %s", ordered[i], ordered[i+1])
+		assert.EqualValues(t, 1, b.Compare(a), "%s > %s", ordered[i+1], ordered[i])
+	}
+}
+
+func TestPEP440_Normalise(t *testing.T) {
+	var cases = [][2]string{
+		{"1.0", "1.0.0"},
+		{"v1.0", "1.0"},
+		{"1.0-alpha.1", "1.0a1"},
+		{"1.0c1", "1.0rc1"},
+		{"1.0-1", "1.0.post1"},
+		{"1.0-r", "1.0.post0"},
+		{"1.0.DEV", "1.0.dev0"},
+	}
+	for _, tt := range cases {
+		t.Run(tt[0], func(t *testing.T) {
+			a, err := parsePEP440(tt[0])
+			require.NoError(t, err)
+			b, err := parsePEP440(tt[1])
+			require.NoError(t, err)
+			assert.EqualValues(t, 0, a.Compare(b))
+		})
+	}
+}
+
+func TestParsePEP440_Invalid(t *testing.T) {
+	for _, s := range []string{"", "not-a-version", "1.0-foo", "99999999999999999999"} {
+		t.Run(s, func(t *testing.T) {
+			_, err := parsePEP440(s)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package advisory
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"gitlab.com/go-prism/prism3/core/pkg/versions"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// New creates a Policy that applies an action to
+// versions of packages that are affected by an
+// advisory. It returns nil if there is nothing to
+// do or the archetype isn't supported.
+func New(archetype model.Archetype, action model.VulnerabilityAction, list repo.ListAffectedFunc) *Policy {
+	if action == "" || action == model.VulnerabilityActionNone {
+		return nil
+	}
+	ecosystem := Ecosystem(archetype)
+	if ecosystem == "" {
+		return nil
+	}
+	return &Policy{
+		action:    action,
+		archetype: archetype,
+		ecosystem: ecosystem,
+		list:      list,
+	}
+}
+
+// Load creates the Policy of a refraction.
+func Load(ref *model.Refraction, list repo.ListAffectedFunc) *Policy {
+	return New(ref.Archetype, ref.VulnerabilityAction, list)
+}
+
+// Enabled returns true if the Policy
+// may act on any versions.
+func (p *Policy) Enabled() bool {
+	return p != nil
+}
+
+// Hides returns true if affected versions should
+// be removed from package indices.
+func (p *Policy) Hides() bool {
+	return p != nil && (p.action == model.VulnerabilityActionHide || p.action == model.VulnerabilityActionBlock)
+}
+
+// Match retrieves the advisories that
+// affect any of the given packages.
+func (p *Policy) Match(ctx context.Context, names ...string) (*Matches, error) {
+	return lookup(ctx, p.ecosystem, p.list, names...)
+}
+
+func lookup(ctx context.Context, ecosystem string, list repo.ListAffectedFunc, names ...string) (*Matches, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "advisory_lookup", trace.WithAttributes(
+		attribute.String("ecosystem", ecosystem),
+		attribute.Int("count", len(names)),
+	))
+	defer span.End()
+	// the same package may be asked
+	// for more than once
+	seen := map[string]struct{}{}
+	normal := make([]string, 0, len(names))
+	for _, n := range names {
+		n = normalise(ecosystem, n)
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		seen[n] = struct{}{}
+		normal = append(normal, n)
+	}
+	packages, err := list(ctx, ecosystem, normal...)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	m := &Matches{
+		ecosystem: ecosystem,
+		packages:  map[string][]match{},
+	}
+	log := logr.FromContextOrDiscard(ctx)
+	for _, pkg := range packages {
+		var a Affected
+		if err := json.Unmarshal(pkg.Affected, &a); err != nil {
+			log.Error(err, "failed to read affected package", "Advisory", pkg.AdvisoryID, "Package", pkg.Name)
+			continue
+		}
+		m.packages[pkg.Name] = append(m.packages[pkg.Name], match{advisory: pkg.AdvisoryID, affected: a})
+	}
+	return m, nil
+}
+
+// Affects returns the IDs of the advisories
+// that affect a version of a package.
+func (m *Matches) Affects(name, version string) []string {
+	var ids []string
+	for _, a := range m.packages[normalise(m.ecosystem, name)] {
+		if a.affected.Affects(version) {
+			ids = append(ids, a.advisory)
+		}
+	}
+	return ids
+}
+
+// Affected returns the first advisory that affects a
+// version of a package, or an empty string if
+// there aren't any.
+func (p *Policy) Affected(ctx context.Context, name, version string) (string, error) {
+	m, err := p.Match(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if ids := m.Affects(name, version); len(ids) > 0 {
+		return ids[0], nil
+	}
+	return "", nil
+}
+
+// Check applies the Policy to the download of a
+// file. Files that aren't a version of a package
+// are always allowed.
+//
+// If the advisories can't be retrieved, the download
+// is refused when the Policy would block it.
+func (p *Policy) Check(ctx context.Context, uri string) error {
+	if p == nil {
+		return nil
+	}
+	uri, _, _ = strings.Cut(strings.TrimPrefix(uri, "/"), "#")
+	name, version, ok := versions.Parse(p.archetype, uri)
+	if !ok {
+		return nil
+	}
+	// charts are matched by name, wherever
+	// they are kept in the repository
+	if p.archetype == model.ArchetypeHelm {
+		name = path.Base(name)
+	}
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", name, "Version", version, "Action", p.action)
+	id, err := p.Affected(ctx, name, version)
+	if err != nil {
+		log.Error(err, "failed to check advisories")
+		if p.action == model.VulnerabilityActionBlock {
+			return err
+		}
+		return nil
+	}
+	if id == "" {
+		return nil
+	}
+	log.Info("download of package version is affected by advisory", "Advisory", id)
+	metricMatched.Add(ctx, 1, attribute.String(attrKeyArchetype, string(p.archetype)), attribute.String(attrKeyAction, string(p.action)))
+	if p.action == model.VulnerabilityActionBlock {
+		return problem.New(http.StatusForbidden).Errorf("version %s of %s is affected by %s", version, name, id)
+	}
+	return nil
+}
+
+// FilterNPM removes affected versions
+// from an NPM package document.
+func (p *Policy) FilterNPM(ctx context.Context, pkg, doc string) (string, error) {
+	if !p.Hides() {
+		return doc, nil
+	}
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "advisory_filterNpm")
+	defer span.End()
+	m, err := p.Match(ctx, pkg)
+	if err != nil {
+		return "", err
+	}
+	data, hidden, err := versions.FilterNPM(doc, func(version string, _ time.Time) bool {
+		return len(m.Affects(pkg, version)) == 0
+	})
+	if err != nil {
+		return "", err
+	}
+	p.Hide(ctx, pkg, hidden)
+	return data, nil
+}
+
+// Hide records that versions of a package were
+// hidden from a response.
+func (p *Policy) Hide(ctx context.Context, name string, n int) {
+	if n == 0 {
+		return
+	}
+	logr.FromContextOrDiscard(ctx).Info("hiding versions affected by advisories", "Package", name, "Count", n)
+	metricHidden.Add(ctx, int64(n), attribute.String(attrKeyArchetype, string(p.archetype)), attribute.String(attrKeyAction, string(p.action)))
+}
@@ -0,0 +1,125 @@
+package advisory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/lpar/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"net/http"
+	"testing"
+)
+
+// list returns a single advisory that
+// affects lodash before 4.17.21.
+func list(_ context.Context, ecosystem string, names ...string) ([]*schemas.AdvisoryPackage, error) {
+	data, _ := json.Marshal(&Affected{
+		Package: Package{Ecosystem: "npm", Name: "lodash"},
+		Ranges:  []Range{{Type: "SEMVER", Events: []Event{{Introduced: "0"}, {Fixed: "4.17.21"}}}},
+	})
+	var result []*schemas.AdvisoryPackage
+	for _, n := range names {
+		if ecosystem == "npm" && n == "lodash" {
+			result = append(result, &schemas.AdvisoryPackage{AdvisoryID: "GHSA-35jh-r3h4-6jhm", Ecosystem: ecosystem, Name: n, Affected: data})
+		}
+	}
+	return result, nil
+}
+
+func listErr(context.Context, string, ...string) ([]*schemas.AdvisoryPackage, error) {
+	return nil, errors.New("database is unavailable")
+}
+
+func TestNew(t *testing.T) {
+	assert.Nil(t, New(model.ArchetypeNpm, model.VulnerabilityActionNone, list))
+	assert.Nil(t, New(model.ArchetypeGo, model.VulnerabilityActionBlock, list))
+
+	p := New(model.ArchetypeNpm, model.VulnerabilityActionWarn, list)
+	assert.True(t, p.Enabled())
+	assert.False(t, p.Hides())
+	assert.True(t, New(model.ArchetypeNpm, model.VulnerabilityActionBlock, list).Hides())
+}
+
+func TestPolicy_Check(t *testing.T) {
+	ctx := context.TODO()
+	var cases = []struct {
+		name   string
+		action model.VulnerabilityAction
+		list   func(context.Context, string, ...string) ([]*schemas.AdvisoryPackage, error)
+		uri    string
+		code   int
+	}{
+		{"blocked", model.VulnerabilityActionBlock, list, "/lodash/-/lodash-4.17.20.tgz", http.StatusForbidden},
+		{"fixed", model.VulnerabilityActionBlock, list, "/lodash/-/lodash-4.17.21.tgz", 0},
+		{"not a package", model.VulnerabilityActionBlock, list, "/lodash", 0},
+		{"warned", model.VulnerabilityActionWarn, list, "/lodash/-/lodash-4.17.20.tgz", 0},
+		{"hidden", model.VulnerabilityActionHide, list, "/lodash/-/lodash-4.17.20.tgz", 0},
+		{"fail closed", model.VulnerabilityActionBlock, listErr, "/lodash/-/lodash-4.17.21.tgz", -1},
+		{"fail open", model.VulnerabilityActionWarn, listErr, "/lodash/-/lodash-4.17.20.tgz", 0},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New(model.ArchetypeNpm, tt.action, tt.list).Check(ctx, tt.uri)
+			switch tt.code {
+			case 0:
+				assert.NoError(t, err)
+			case -1:
+				assert.Error(t, err)
+			default:
+				var p *problem.ProblemDetails
+				require.ErrorAs(t, err, &p)
+				assert.EqualValues(t, tt.code, p.Status)
+			}
+		})
+	}
+}
+
+func TestPolicy_CheckNil(t *testing.T) {
+	var p *Policy
+	assert.NoError(t, p.Check(context.TODO(), "/lodash/-/lodash-4.17.20.tgz"))
+}
+
+func TestPolicy_FilterNPM(t *testing.T) {
+	doc := `{
+		"name": "lodash",
+		"dist-tags": {"latest": "4.17.20"},
+		"versions": {"4.17.19": {}, "4.17.20": {}, "4.17.21": {}},
+		"time": {"4.17.19": "2020-07-08T00:00:00.000Z", "4.17.20": "2020-08-13T00:00:00.000Z", "4.17.21": "2021-02-20T00:00:00.000Z"}
+	}`
+	out, err := New(model.ArchetypeNpm, model.VulnerabilityActionHide, list).FilterNPM(context.TODO(), "lodash", doc)
+	require.NoError(t, err)
+
+	var pkg struct {
+		DistTags map[string]string `json:"dist-tags"`
+		Versions map[string]any    `json:"versions"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &pkg))
+	assert.Len(t, pkg.Versions, 1)
+	assert.Contains(t, pkg.Versions, "4.17.21")
+	assert.EqualValues(t, "4.17.21", pkg.DistTags["latest"])
+
+	// warnings don't change the document
+	out, err = New(model.ArchetypeNpm, model.VulnerabilityActionWarn, list).FilterNPM(context.TODO(), "lodash", doc)
+	require.NoError(t, err)
+	assert.EqualValues(t, doc, out)
+}
+
+func TestGroup(t *testing.T) {
+	archetypes := map[string]model.Archetype{"npm": model.ArchetypeNpm}
+	artifacts := []*model.Artifact{
+		{ID: "a", RemoteID: "npm", URI: "lodash/-/lodash-4.17.20.tgz"},
+		{ID: "b", RemoteID: "npm", URI: "lodash/-/lodash-4.17.21.tgz"},
+		{ID: "c", RemoteID: "npm", URI: "lodash"},
+	}
+	pkgs := packages(artifacts, archetypes)
+	require.Len(t, pkgs, 2)
+
+	m, err := lookup(context.TODO(), "npm", list, "lodash", "Lodash")
+	require.NoError(t, err)
+	affected := group(pkgs, map[model.Archetype]*Matches{model.ArchetypeNpm: m})
+	require.Len(t, affected["GHSA-35jh-r3h4-6jhm"], 1)
+	assert.EqualValues(t, "a", affected["GHSA-35jh-r3h4-6jhm"][0].ID)
+}
@@ -0,0 +1,144 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package advisory
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"gitlab.com/go-prism/prism3/core/pkg/versions"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"path"
+)
+
+// Scan finds the cached artifacts of the given
+// remotes that are affected by an advisory, grouped
+// by advisory.
+func Scan(ctx context.Context, repos *repo.Repos, remotes []*model.Remote) ([]*model.AffectedArtifacts, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "advisory_scan", trace.WithAttributes(
+		attribute.Int("remotes", len(remotes)),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	archetypes := map[string]model.Archetype{}
+	var ids []string
+	for _, r := range remotes {
+		if Ecosystem(r.Archetype) == "" {
+			continue
+		}
+		archetypes[r.ID] = r.Archetype
+		ids = append(ids, r.ID)
+	}
+	result := []*model.AffectedArtifacts{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+	artifacts, err := repos.ArtifactRepo.ListArtifacts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	pkgs := packages(artifacts, archetypes)
+	// look up the advisories of each
+	// ecosystem in one go
+	names := map[model.Archetype][]string{}
+	for _, p := range pkgs {
+		names[p.archetype] = append(names[p.archetype], p.name)
+	}
+	matches := map[model.Archetype]*Matches{}
+	for archetype, n := range names {
+		m, err := lookup(ctx, Ecosystem(archetype), repos.AdvisoryRepo.ListAffected, n...)
+		if err != nil {
+			return nil, err
+		}
+		matches[archetype] = m
+	}
+	affected := group(pkgs, matches)
+	advisories, err := repos.AdvisoryRepo.ListAdvisories(ctx, keys(affected))
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range advisories {
+		result = append(result, &model.AffectedArtifacts{
+			Advisory:  a,
+			Artifacts: affected[a.ID],
+		})
+	}
+	log.Info("scanned artifacts for advisories", "Artifacts", len(artifacts), "Packages", len(pkgs), "Advisories", len(result))
+	return result, nil
+}
+
+// pkg is an artifact that is a
+// version of a package.
+type pkg struct {
+	artifact  *model.Artifact
+	archetype model.Archetype
+	name      string
+	version   string
+}
+
+// packages works out which package and version
+// each artifact belongs to, skipping any that
+// aren't package files.
+func packages(artifacts []*model.Artifact, archetypes map[string]model.Archetype) []pkg {
+	var result []pkg
+	for _, a := range artifacts {
+		archetype := archetypes[a.RemoteID]
+		name, version, ok := versions.Parse(archetype, a.URI)
+		if !ok {
+			continue
+		}
+		if archetype == model.ArchetypeHelm {
+			name = path.Base(name)
+		}
+		result = append(result, pkg{
+			artifact:  a,
+			archetype: archetype,
+			name:      name,
+			version:   version,
+		})
+	}
+	return result
+}
+
+// group collects the artifacts that
+// are affected by each advisory.
+func group(pkgs []pkg, matches map[model.Archetype]*Matches) map[string][]*model.Artifact {
+	result := map[string][]*model.Artifact{}
+	for _, p := range pkgs {
+		m, ok := matches[p.archetype]
+		if !ok {
+			continue
+		}
+		for _, id := range m.Affects(p.name, p.version) {
+			result[id] = append(result[id], p.artifact)
+		}
+	}
+	return result
+}
+
+func keys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package advisory
+
+import (
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"time"
+)
+
+// Vulnerability is an advisory in the OSV format.
+//
+// https://ossf.github.io/osv-schema/
+type Vulnerability struct {
+	ID               string         `json:"id"`
+	Summary          string         `json:"summary"`
+	Aliases          []string       `json:"aliases"`
+	Published        time.Time      `json:"published"`
+	Modified         time.Time      `json:"modified"`
+	Withdrawn        *time.Time     `json:"withdrawn"`
+	Affected         []Affected     `json:"affected"`
+	Severity         []Severity     `json:"severity"`
+	DatabaseSpecific map[string]any `json:"database_specific"`
+}
+
+// Affected describes the versions of
+// a package that are vulnerable.
+type Affected struct {
+	Package  Package  `json:"package"`
+	Ranges   []Range  `json:"ranges,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+type Range struct {
+	Type   string  `json:"type"`
+	Events []Event `json:"events"`
+}
+
+// Event is the start or end of a range. Only
+// one of the fields is set.
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// Importer loads OSV advisories into
+// the database.
+type Importer struct {
+	repos *repo.Repos
+	store storage.Reader
+}
+
+// Report describes the outcome
+// of an import.
+type Report struct {
+	// Imported is the number of advisories
+	// that were created or updated
+	Imported int
+	// Withdrawn is the number of advisories
+	// that were removed
+	Withdrawn int
+	// Skipped is the number of advisories that
+	// didn't affect a supported ecosystem
+	Skipped int
+}
+
+// Policy applies the vulnerability action of a
+// refraction. A nil Policy allows every version.
+type Policy struct {
+	action    model.VulnerabilityAction
+	archetype model.Archetype
+	ecosystem string
+	list      repo.ListAffectedFunc
+}
+
+// Matches holds the affected packages that
+// were found for a set of package names.
+type Matches struct {
+	ecosystem string
+	packages  map[string][]match
+}
+
+// match is an affected package along with
+// the advisory that it came from.
+type match struct {
+	advisory string
+	affected Affected
+}
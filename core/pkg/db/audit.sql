@@ -0,0 +1,14 @@
+-- The audit log is append-only, so reject anything
+-- that would modify or remove existing events.
+CREATE OR REPLACE FUNCTION reject_audit_events_change() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit events cannot be modified or removed';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trigger_audit_events_change on audit_events;
+CREATE TRIGGER trigger_audit_events_change BEFORE UPDATE OR DELETE ON audit_events FOR EACH ROW EXECUTE PROCEDURE reject_audit_events_change();
+
+DROP TRIGGER IF EXISTS trigger_audit_events_truncate on audit_events;
+CREATE TRIGGER trigger_audit_events_truncate BEFORE TRUNCATE ON audit_events FOR EACH STATEMENT EXECUTE PROCEDURE reject_audit_events_change();
@@ -78,11 +78,24 @@ func (JSONMap) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
 
 // UnmarshalGQL implements the graphql.Unmarshaler interface
 func (m *JSONMap) UnmarshalGQL(v any) error {
-	val, ok := v.(map[string]string)
-	if !ok {
+	switch val := v.(type) {
+	case map[string]string:
+		*m = val
+	case map[string]any:
+		// objects from a request are decoded
+		// without knowing the type of their values
+		t := make(map[string]string, len(val))
+		for k, item := range val {
+			s, ok := item.(string)
+			if !ok {
+				return errors.New("JSONMap values must be strings")
+			}
+			t[k] = s
+		}
+		*m = t
+	default:
 		return errors.New("JSONMap must be a JSON object")
 	}
-	*m = val
 	return nil
 }
 
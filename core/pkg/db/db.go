@@ -19,6 +19,7 @@ package db
 
 import (
 	"context"
+	_ "embed"
 	"github.com/Unleash/unleash-client-go/v3"
 	"github.com/djcass44/go-utils/flagging"
 	"github.com/djcass44/go-utils/orm"
@@ -34,6 +35,12 @@ import (
 )
 import "gorm.io/driver/postgres"
 
+//go:embed audit.sql
+var auditSQL string
+
+//go:embed npm.sql
+var npmSQL string
+
 func NewDatabase(ctx context.Context, dsn string) (*Database, error) {
 	log := logr.FromContextOrDiscard(ctx).WithName("database")
 	// configure primary
@@ -67,6 +74,12 @@ func (db *Database) DB() *gorm.DB {
 func (db *Database) Init() error {
 	log := db.log
 	log.Info("running database migrations")
+	// the join table records the order of remotes
+	if err := db.db.SetupJoinTable(&model.Refraction{}, "Remotes", &schemas.RefRemote{}); err != nil {
+		log.Error(err, "failed to setup join table")
+		sentry.CaptureException(err)
+		return err
+	}
 	err := db.db.AutoMigrate(
 		&model.Remote{},
 		&model.Refraction{},
@@ -75,15 +88,37 @@ func (db *Database) Init() error {
 		&model.Artifact{},
 		&model.StoredUser{},
 		&model.BandwidthUsage{},
+		&model.Quota{},
+		&model.QuotaEvent{},
+		&model.QuarantineExemption{},
+		&model.Advisory{},
+		&model.ServiceAccount{},
+		&model.AuditEvent{},
 		&schemas.NPMPackage{},
 		&schemas.PyPackage{},
+		&schemas.PyIndex{},
 		&schemas.HelmPackage{},
+		&schemas.SigningKey{},
+		&schemas.AdvisoryPackage{},
+		&schemas.AccessToken{},
 	)
 	if err != nil {
 		log.Error(err, "failed to run auto-migration")
 		sentry.CaptureException(err)
 		return err
 	}
+	log.V(1).Info("removing shared NPM package documents")
+	if err := db.db.Exec(npmSQL).Error; err != nil {
+		log.Error(err, "failed to remove shared NPM package documents")
+		sentry.CaptureException(err)
+		return err
+	}
+	log.V(1).Info("protecting audit log")
+	if err := db.db.Exec(auditSQL).Error; err != nil {
+		log.Error(err, "failed to protect audit log")
+		sentry.CaptureException(err)
+		return err
+	}
 	if err := db.defaults(); err != nil {
 		sentry.CaptureException(err)
 		return err
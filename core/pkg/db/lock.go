@@ -0,0 +1,156 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"hash/fnv"
+	"time"
+)
+
+const (
+	// lockPollMin and lockPollMax bound how long we
+	// wait between attempts to take a lock
+	lockPollMin = time.Millisecond * 25
+	lockPollMax = time.Second
+	// lockWait is how long we wait for a lock
+	// before giving up
+	lockWait = time.Minute
+)
+
+// ErrLockTimeout is returned when a lock could
+// not be taken within the wait limit.
+var ErrLockTimeout = errors.New("timed out waiting for advisory lock")
+
+// AdvisoryLocker uses Postgres advisory locks to
+// make sure that only one replica works on a key
+// at a time.
+//
+// https://www.postgresql.org/docs/current/explicit-locking.html#ADVISORY-LOCKS
+type AdvisoryLocker struct {
+	db   *sql.DB
+	wait time.Duration
+}
+
+func NewAdvisoryLocker(db *Database) (*AdvisoryLocker, error) {
+	sqlDB, err := db.DB().DB()
+	if err != nil {
+		return nil, err
+	}
+	return &AdvisoryLocker{
+		db:   sqlDB,
+		wait: lockWait,
+	}, nil
+}
+
+// Lock polls until the lock for the key is held, the
+// wait limit is reached or the context is cancelled.
+// Connections are returned to the pool between
+// attempts so that waiting doesn't starve other
+// requests. Advisory locks belong to a session,
+// so the holder keeps a connection until the
+// returned function is called.
+func (a *AdvisoryLocker) Lock(ctx context.Context, key string) (func(), error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "db_advisory_lock")
+	defer span.End()
+	id := lockID(key)
+	span.SetAttributes(attribute.String("key", key), attribute.Int64("id", id))
+	log := logr.FromContextOrDiscard(ctx).WithValues("Key", key, "LockID", id)
+	deadline := time.Now().Add(a.wait)
+	backoff := lockPollMin
+	log.V(1).Info("waiting for advisory lock")
+	for {
+		conn, err := a.tryLock(ctx, id)
+		if err != nil {
+			log.Error(err, "failed to acquire advisory lock")
+			return nil, err
+		}
+		if conn != nil {
+			log.V(1).Info("acquired advisory lock")
+			return func() {
+				// the request may have been cancelled, but
+				// we still need to release the lock
+				if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", id); err != nil {
+					log.Error(err, "failed to release advisory lock")
+					discard(conn)
+					return
+				}
+				_ = conn.Close()
+				log.V(1).Info("released advisory lock")
+			}, nil
+		}
+		if time.Now().After(deadline) {
+			log.Info("gave up waiting for advisory lock", "Wait", a.wait)
+			return nil, ErrLockTimeout
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > lockPollMax {
+			backoff = lockPollMax
+		}
+	}
+}
+
+// tryLock makes a single attempt to take the lock. If
+// it succeeds, the connection holding the lock is
+// returned. Otherwise, the connection goes back to
+// the pool and nil is returned.
+func (a *AdvisoryLocker) tryLock(ctx context.Context, id int64) (*sql.Conn, error) {
+	conn, err := a.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ok bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", id).Scan(&ok); err != nil {
+		discard(conn)
+		return nil, err
+	}
+	if !ok {
+		_ = conn.Close()
+		return nil, nil
+	}
+	return conn, nil
+}
+
+// lockID converts a key into the 64-bit
+// integer that Postgres expects.
+func lockID(key string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return int64(h.Sum64())
+}
+
+// discard closes the underlying connection rather than
+// returning it to the pool, since the session may still
+// hold the lock.
+func discard(conn *sql.Conn) {
+	_ = conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+	_ = conn.Close()
+}
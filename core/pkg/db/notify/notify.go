@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"gorm.io/gorm"
 	"strings"
+	"sync"
 	"text/template"
 )
 
@@ -18,6 +19,7 @@ var triggerTpl string
 
 type Notifier struct {
 	lis      map[chan *Message]struct{}
+	mu       sync.RWMutex
 	listener chan *Message
 	log      logr.Logger
 }
@@ -71,8 +73,16 @@ func (n *Notifier) Listen() {
 		for {
 			log.V(2).Info("waiting for message from listener")
 			msg := <-n.listener
-			log.V(2).Info("broadcasting message to listeners", "Count", len(n.lis))
+			// copy the listeners so that they can be
+			// added or removed while we broadcast
+			n.mu.RLock()
+			lis := make([]chan *Message, 0, len(n.lis))
 			for k := range n.lis {
+				lis = append(lis, k)
+			}
+			n.mu.RUnlock()
+			log.V(2).Info("broadcasting message to listeners", "Count", len(lis))
+			for _, k := range lis {
 				k <- msg
 			}
 		}
@@ -83,12 +93,16 @@ func (n *Notifier) AddListener(ctx context.Context, l chan *Message) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "db_notifier_addListener")
 	defer span.End()
 	logr.FromContextOrDiscard(ctx).V(1).Info("adding listener")
+	n.mu.Lock()
 	n.lis[l] = struct{}{}
+	n.mu.Unlock()
 }
 
 func (n *Notifier) RemoveListener(ctx context.Context, l chan *Message) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "db_notifier_removeListener")
 	defer span.End()
 	logr.FromContextOrDiscard(ctx).V(1).Info("removing listener")
+	n.mu.Lock()
 	delete(n.lis, l)
+	n.mu.Unlock()
 }
@@ -0,0 +1,6 @@
+-- NPM package documents used to be shared by every
+-- Refraction. They are now stored per Refraction, so
+-- remove the old constraint and the shared documents,
+-- which are fetched again when they are next requested.
+ALTER TABLE npm_packages DROP CONSTRAINT IF EXISTS npm_packages_name_key;
+DELETE FROM npm_packages WHERE refraction IS NULL OR refraction = '';
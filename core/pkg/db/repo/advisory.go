@@ -0,0 +1,149 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package repo
+
+import (
+	"context"
+	"github.com/getsentry/sentry-go"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListAffectedFunc returns the affected packages
+// of an ecosystem that have one of the given names.
+type ListAffectedFunc = func(ctx context.Context, ecosystem string, names ...string) ([]*schemas.AdvisoryPackage, error)
+
+func NewAdvisoryRepo(db *gorm.DB) *AdvisoryRepo {
+	return &AdvisoryRepo{
+		db: db,
+	}
+}
+
+// SaveAdvisories creates or replaces advisories along
+// with the packages that they affect.
+func (r *AdvisoryRepo) SaveAdvisories(ctx context.Context, advisories []*model.Advisory, packages []*schemas.AdvisoryPackage) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_advisory_saveAdvisories", trace.WithAttributes(
+		attribute.Int("count", len(advisories)),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("saving advisories", "Count", len(advisories), "Packages", len(packages))
+	if len(advisories) == 0 {
+		return nil
+	}
+	ids := make([]string, len(advisories))
+	for i := range advisories {
+		ids[i] = advisories[i].ID
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(advisories, 1000).Error; err != nil {
+			return err
+		}
+		// the affected packages are replaced, since
+		// OSV records don't identify them individually
+		if err := tx.Where("advisory_id = ANY(?::text[])", getAnyQuery(ids)).Delete(&schemas.AdvisoryPackage{}).Error; err != nil {
+			return err
+		}
+		if len(packages) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(packages, 1000).Error
+	})
+	if err != nil {
+		log.Error(err, "failed to save advisories")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to save advisories")
+	}
+	return nil
+}
+
+// DeleteAdvisories removes advisories
+// that have been withdrawn.
+func (r *AdvisoryRepo) DeleteAdvisories(ctx context.Context, ids []string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_advisory_deleteAdvisories", trace.WithAttributes(
+		attribute.Int("count", len(ids)),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("deleting advisories", "Count", len(ids))
+	if len(ids) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("advisory_id = ANY(?::text[])", getAnyQuery(ids)).Delete(&schemas.AdvisoryPackage{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ANY(?::text[])", getAnyQuery(ids)).Delete(&model.Advisory{}).Error
+	})
+	if err != nil {
+		log.Error(err, "failed to delete advisories")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to delete advisories")
+	}
+	return nil
+}
+
+// ListAdvisories returns the advisories with the given IDs.
+func (r *AdvisoryRepo) ListAdvisories(ctx context.Context, ids []string) ([]*model.Advisory, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_advisory_listAdvisories", trace.WithAttributes(
+		attribute.Int("count", len(ids)),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("listing advisories", "Count", len(ids))
+	var result []*model.Advisory
+	if len(ids) == 0 {
+		return result, nil
+	}
+	if err := r.db.WithContext(ctx).Where("id = ANY(?::text[])", getAnyQuery(ids)).Order("id asc").Find(&result).Error; err != nil {
+		log.Error(err, "failed to list advisories")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to list advisories")
+	}
+	return result, nil
+}
+
+// ListAffected returns the affected packages of an
+// ecosystem that have one of the given names. Names
+// must already be normalised.
+func (r *AdvisoryRepo) ListAffected(ctx context.Context, ecosystem string, names ...string) ([]*schemas.AdvisoryPackage, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_advisory_listAffected", trace.WithAttributes(
+		attribute.String("ecosystem", ecosystem),
+		attribute.Int("count", len(names)),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Ecosystem", ecosystem)
+	log.V(1).Info("listing affected packages", "Count", len(names))
+	var result []*schemas.AdvisoryPackage
+	if len(names) == 0 {
+		return result, nil
+	}
+	if err := r.db.WithContext(ctx).Where("ecosystem = ? AND name = ANY(?::text[])", ecosystem, getAnyQuery(names)).Find(&result).Error; err != nil {
+		log.Error(err, "failed to list affected packages")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to list affected packages")
+	}
+	return result, nil
+}
@@ -39,6 +39,8 @@ func NewArtifactRepo(db *gorm.DB) *ArtifactRepo {
 
 type CreateArtifactFunc = func(ctx context.Context, path, remote string) error
 
+type SetDigestFunc = func(ctx context.Context, path, remote, digest string) error
+
 func (r *ArtifactRepo) CreateArtifact(ctx context.Context, path, remote string) error {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_artifact_createArtifact", trace.WithAttributes(
 		attribute.String("path", path),
@@ -81,6 +83,49 @@ func (r *ArtifactRepo) CreateArtifact(ctx context.Context, path, remote string)
 	return nil
 }
 
+// SetDigest records the SHA-256 of an artifact
+// once it has been saved to the cache.
+func (r *ArtifactRepo) SetDigest(ctx context.Context, path, remote, digest string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_artifact_setDigest", trace.WithAttributes(
+		attribute.String("path", path),
+		attribute.String("remote", remote),
+	))
+	defer span.End()
+	// normalise the path
+	path = strings.TrimPrefix(path, "/")
+	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path, "Remote", remote)
+	log.V(1).Info("updating artifact digest", "Digest", digest)
+	if err := r.db.WithContext(ctx).Model(&model.Artifact{}).Where("uri = ? AND remote_id = ?", path, remote).Updates(map[string]any{
+		"sha256":      digest,
+		"verified_at": time.Now().Unix(),
+	}).Error; err != nil {
+		log.Error(err, "failed to update artifact digest")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to update artifact digest")
+	}
+	return nil
+}
+
+// SetVerified records that the files of the
+// artifacts still match their digest.
+func (r *ArtifactRepo) SetVerified(ctx context.Context, ids []string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_artifact_setVerified", trace.WithAttributes(
+		attribute.Int("count", len(ids)),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("updating artifact verification time", "Count", len(ids))
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Model(&model.Artifact{}).Where("id = ANY(?::uuid[])", getAnyQuery(ids)).Update("verified_at", time.Now().Unix()).Error; err != nil {
+		log.Error(err, "failed to update artifact verification time")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to update artifacts")
+	}
+	return nil
+}
+
 func (r *ArtifactRepo) ListArtifacts(ctx context.Context, remotes []string) ([]*model.Artifact, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_artifact_listArtifacts", trace.WithAttributes(
 		attribute.StringSlice("remotes", remotes),
@@ -97,6 +142,26 @@ func (r *ArtifactRepo) ListArtifacts(ctx context.Context, remotes []string) ([]*
 	return result, nil
 }
 
+// DeleteArtifacts removes artifacts once
+// their files have been evicted.
+func (r *ArtifactRepo) DeleteArtifacts(ctx context.Context, ids []string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_artifact_deleteArtifacts", trace.WithAttributes(
+		attribute.Int("count", len(ids)),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("deleting artifacts", "Count", len(ids))
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Where("id = ANY(?::uuid[])", getAnyQuery(ids)).Delete(&model.Artifact{}).Error; err != nil {
+		log.Error(err, "failed to delete artifacts")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to delete artifacts")
+	}
+	return nil
+}
+
 func (r *ArtifactRepo) Count(ctx context.Context) (int64, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_artifact_count")
 	defer span.End()
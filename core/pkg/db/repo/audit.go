@@ -0,0 +1,149 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package repo
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"net/http"
+	"strings"
+)
+
+// maxAuditPage is the largest number of audit
+// events that can be fetched at once.
+const maxAuditPage = 500
+
+// RecordAuditFunc appends an event to the audit log.
+type RecordAuditFunc = func(ctx context.Context, event *model.AuditEvent) error
+
+func NewAuditRepo(db *gorm.DB) *AuditRepo {
+	return &AuditRepo{
+		db: db,
+	}
+}
+
+// CreateEvent appends an event to the audit log. There is
+// deliberately no way to modify or remove an event.
+func (r *AuditRepo) CreateEvent(ctx context.Context, event *model.AuditEvent) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_audit_createEvent", trace.WithAttributes(
+		attribute.String("action", event.Action),
+		attribute.String("resource", event.Resource),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Action", event.Action, "Resource", event.Resource)
+	log.V(1).Info("creating audit event")
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		log.Error(err, "failed to create audit event")
+		return returnErr(err, "failed to create audit event")
+	}
+	return nil
+}
+
+// ListEvents returns a page of audit events
+// that match the filter, newest first.
+func (r *AuditRepo) ListEvents(ctx context.Context, filter *model.AuditFilter, offset, limit int) (*model.AuditEventPage, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_audit_listEvents", trace.WithAttributes(
+		attribute.Int("offset", offset),
+		attribute.Int("limit", limit),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Offset", offset, "Limit", limit)
+	log.V(1).Info("listing audit events")
+	if offset < 0 || limit <= 0 || limit > maxAuditPage {
+		return nil, problem.New(http.StatusBadRequest).Errorf("offset must be positive and limit must be between 1 and %d", maxAuditPage)
+	}
+	var total int64
+	if err := r.filter(r.db.WithContext(ctx).Model(&model.AuditEvent{}), filter).Count(&total).Error; err != nil {
+		log.Error(err, "failed to count audit events")
+		return nil, returnErr(err, "failed to count audit events")
+	}
+	var results []*model.AuditEvent
+	if err := r.filter(r.db.WithContext(ctx), filter).Order("created_at DESC, id").Offset(offset).Limit(limit).Find(&results).Error; err != nil {
+		log.Error(err, "failed to list audit events")
+		return nil, returnErr(err, "failed to list audit events")
+	}
+	return &model.AuditEventPage{
+		Items: results,
+		Total: total,
+	}, nil
+}
+
+// StreamEvents calls f for each audit event that matches
+// the filter, oldest first. Events are fetched in batches
+// so that the whole log never needs to be held in memory.
+func (r *AuditRepo) StreamEvents(ctx context.Context, filter *model.AuditFilter, f func(event *model.AuditEvent) error) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_audit_streamEvents")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("streaming audit events")
+	var err error
+	for offset := 0; err == nil; offset += maxAuditPage {
+		var results []*model.AuditEvent
+		if err = r.filter(r.db.WithContext(ctx), filter).Order("created_at, id").Offset(offset).Limit(maxAuditPage).Find(&results).Error; err != nil {
+			break
+		}
+		for _, e := range results {
+			if err = f(e); err != nil {
+				break
+			}
+		}
+		if len(results) < maxAuditPage {
+			break
+		}
+	}
+	if err != nil {
+		log.Error(err, "failed to stream audit events")
+		return returnErr(err, "failed to stream audit events")
+	}
+	return nil
+}
+
+func (*AuditRepo) filter(tx *gorm.DB, filter *model.AuditFilter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+	if filter.Actor != nil && *filter.Actor != "" {
+		tx = tx.Where("actor = ?", *filter.Actor)
+	}
+	if filter.Action != nil && *filter.Action != "" {
+		tx = tx.Where("action = ?", *filter.Action)
+	}
+	// match by prefix so that every resource
+	// of a type can be found (e.g., "remote::")
+	if filter.Resource != nil && *filter.Resource != "" {
+		tx = tx.Where("resource LIKE ?", escapeLike(*filter.Resource)+"%")
+	}
+	if filter.Since != nil {
+		tx = tx.Where("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		tx = tx.Where("created_at < ?", *filter.Until)
+	}
+	return tx
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -19,13 +19,18 @@ package repo
 
 import (
 	"context"
+	"fmt"
 	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"net/http"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -59,6 +64,36 @@ func (r *BandwidthRepo) Create(ctx context.Context, resource string, usage int64
 	return nil
 }
 
+// ReleaseStorage gives back the STORAGE usage of files
+// that have been deleted from a remote, so that it is no
+// longer counted towards the quotas of the remote or of
+// the refractions that it belongs to. Usage is only
+// released from the current month and never drops
+// below zero.
+func (r *BandwidthRepo) ReleaseStorage(ctx context.Context, remoteID string, usage int64) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_bandwidth_releaseStorage")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Remote", remoteID, "Usage", usage)
+	log.V(1).Info("releasing storage usage")
+	if usage <= 0 {
+		return nil
+	}
+	var refractions []string
+	if err := r.db.WithContext(ctx).Model(&schemas.RefRemote{}).Where("remote_id = ?", remoteID).Pluck("refraction_id", &refractions).Error; err != nil {
+		log.Error(err, "failed to list refractions of remote")
+		return returnErr(err, "failed to release storage usage")
+	}
+	resources := []string{fmt.Sprintf("%s::%s", ResourceRemote, remoteID)}
+	for _, id := range refractions {
+		resources = append(resources, fmt.Sprintf("%s::%s", ResourceRefraction, id))
+	}
+	if err := r.db.WithContext(ctx).Model(&model.BandwidthUsage{}).Where("date = ? AND type = ? AND resource IN ?", time.Now().Format("200601"), model.BandwidthTypeStorage, resources).Update("usage", gorm.Expr("GREATEST(usage - ?, 0)", usage)).Error; err != nil {
+		log.Error(err, "failed to release storage usage")
+		return returnErr(err, "failed to release storage usage")
+	}
+	return nil
+}
+
 func (r *BandwidthRepo) Get(ctx context.Context, resource, date string) ([]*model.BandwidthUsage, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_bandwidth_get")
 	defer span.End()
@@ -69,6 +104,36 @@ func (r *BandwidthRepo) Get(ctx context.Context, resource, date string) ([]*mode
 		log.Error(err, "failed to fetch bandwidth usage")
 		return nil, returnErr(err, "failed to fetch bandwidth usage")
 	}
+	// fill in the limits from any quotas
+	quotas, err := r.ListQuotas(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range results {
+		for _, q := range quotas {
+			if q.Type == b.Type {
+				b.Limit = q.Limit
+			}
+		}
+	}
+	return results, nil
+}
+
+// GetUsage returns the usage of each resource
+// in the given month.
+func (r *BandwidthRepo) GetUsage(ctx context.Context, date string, resources []string) ([]*model.BandwidthUsage, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_bandwidth_getUsage")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("fetching bandwidth usage of resources", "Count", len(resources))
+	var results []*model.BandwidthUsage
+	if len(resources) == 0 {
+		return results, nil
+	}
+	if err := r.db.WithContext(ctx).Where("date = ? AND resource IN ?", date, resources).Find(&results).Error; err != nil {
+		log.Error(err, "failed to fetch bandwidth usage")
+		return nil, returnErr(err, "failed to fetch bandwidth usage")
+	}
 	return results, nil
 }
 
@@ -84,3 +149,97 @@ func (r *BandwidthRepo) GetTotal(ctx context.Context, resource string) ([]*model
 	}
 	return results, nil
 }
+
+// SetQuota creates or replaces the monthly limit
+// on a type of bandwidth used by a resource.
+func (r *BandwidthRepo) SetQuota(ctx context.Context, in *model.SetQuota) (*model.Quota, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_bandwidth_setQuota")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Resource", in.Resource, "Type", in.Type)
+	log.V(1).Info("setting quota")
+	res, id, _ := strings.Cut(in.Resource, "::")
+	if (res != string(ResourceRemote) && res != string(ResourceRefraction)) || id == "" {
+		return nil, problem.New(http.StatusBadRequest).Errorf("quotas can only be set on remotes and refractions")
+	}
+	if in.Limit <= 0 {
+		return nil, problem.New(http.StatusBadRequest).Errorf("limit must be greater than 0")
+	}
+	q := &model.Quota{
+		ID:       filepath.Join(in.Resource, string(in.Type)),
+		Resource: in.Resource,
+		Type:     in.Type,
+		Limit:    in.Limit,
+		Action:   in.Action,
+	}
+	if q.Action == "" {
+		q.Action = model.QuotaActionTooManyRequests
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"limit", "action"}),
+	}).Create(q).Error; err != nil {
+		log.Error(err, "failed to set quota")
+		return nil, returnErr(err, "failed to set quota")
+	}
+	return q, nil
+}
+
+func (r *BandwidthRepo) DeleteQuota(ctx context.Context, resource string, bandwidthType model.BandwidthType) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_bandwidth_deleteQuota")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Resource", resource, "Type", bandwidthType)
+	log.V(1).Info("deleting quota")
+	if err := r.db.WithContext(ctx).Where("resource = ? AND type = ?", resource, bandwidthType).Delete(&model.Quota{}).Error; err != nil {
+		log.Error(err, "failed to delete quota")
+		return returnErr(err, "failed to delete quota")
+	}
+	return nil
+}
+
+// ListQuotas returns the quotas of a resource. If
+// the resource is empty, every quota is returned.
+func (r *BandwidthRepo) ListQuotas(ctx context.Context, resource string) ([]*model.Quota, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_bandwidth_listQuotas")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("listing quotas", "Resource", resource)
+	var results []*model.Quota
+	tx := r.db.WithContext(ctx)
+	if resource != "" {
+		tx = tx.Where("resource = ?", resource)
+	}
+	if err := tx.Order("id").Find(&results).Error; err != nil {
+		log.Error(err, "failed to list quotas")
+		return nil, returnErr(err, "failed to list quotas")
+	}
+	return results, nil
+}
+
+// CreateQuotaEvent records that a resource has crossed
+// a threshold of its quota. It returns false if the
+// event has already been recorded.
+func (r *BandwidthRepo) CreateQuotaEvent(ctx context.Context, e *model.QuotaEvent) (bool, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_bandwidth_createQuotaEvent")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Resource", e.Resource, "Type", e.Type, "Threshold", e.Threshold)
+	log.V(1).Info("creating quota event")
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(e)
+	if err := result.Error; err != nil {
+		log.Error(err, "failed to create quota event")
+		return false, returnErr(err, "failed to create quota event")
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *BandwidthRepo) ListQuotaEvents(ctx context.Context, resource string) ([]*model.QuotaEvent, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_bandwidth_listQuotaEvents")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("listing quota events", "Resource", resource)
+	var results []*model.QuotaEvent
+	if err := r.db.WithContext(ctx).Where("resource = ?", resource).Order("created_at DESC").Limit(100).Find(&results).Error; err != nil {
+		log.Error(err, "failed to list quota events")
+		return nil, returnErr(err, "failed to list quota events")
+	}
+	return results, nil
+}
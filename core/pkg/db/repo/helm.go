@@ -24,6 +24,7 @@ import (
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"time"
 )
 
 func NewHelmRepo(db *gorm.DB) *HelmPackageRepo {
@@ -35,7 +36,16 @@ func NewHelmRepo(db *gorm.DB) *HelmPackageRepo {
 func (r *HelmPackageRepo) BatchInsert(ctx context.Context, packages []*schemas.HelmPackage) error {
 	log := logr.FromContextOrDiscard(ctx).WithName("repo_helm")
 	log.V(1).Info("upserting packages", "Count", len(packages))
-	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(packages, 1000).Error; err != nil {
+	// only the creation time is updated, so that
+	// packages indexed before it was recorded
+	// can be quarantined
+	tx := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "filename"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"created": gorm.Expr("GREATEST(helm_packages.created, excluded.created)"),
+		}),
+	})
+	if err := tx.CreateInBatches(packages, 1000).Error; err != nil {
 		log.Error(err, "failed to upsert packages")
 		sentry.CaptureException(err)
 		return returnErr(err, "failed to update Helm packages")
@@ -46,12 +56,31 @@ func (r *HelmPackageRepo) BatchInsert(ctx context.Context, packages []*schemas.H
 func (r *HelmPackageRepo) GetPackage(ctx context.Context, file string) (string, error) {
 	log := logr.FromContextOrDiscard(ctx).WithName("repo_helm").WithValues("File", file)
 	log.V(1).Info("fetching package")
-	var result string
-	if err := r.db.WithContext(ctx).Model(&schemas.HelmPackage{}).Where("filename = ?", file).Select("url").First(&result).Error; err != nil {
+	var result schemas.HelmPackage
+	if err := r.db.WithContext(ctx).Model(&schemas.HelmPackage{}).Where("filename = ?", file).Select("url", "digest").First(&result).Error; err != nil {
 		log.Error(err, "failed to find package")
 		sentry.CaptureException(err)
 		return "", returnErr(err, "failed to find Helm package")
 	}
+	// append the digest in the same way as PyPI
+	// so that the download can be checked
+	if result.Digest != "" {
+		return result.URL + "#sha256=" + result.Digest, nil
+	}
+	return result.URL, nil
+}
+
+// GetCreated returns when a version of a chart was
+// published. If more than one remote has the chart,
+// the newest time is used.
+func (r *HelmPackageRepo) GetCreated(ctx context.Context, name, version string) (time.Time, error) {
+	log := logr.FromContextOrDiscard(ctx).WithName("repo_helm").WithValues("Name", name, "Version", version)
+	log.V(1).Info("fetching package creation time")
+	var result time.Time
+	if err := r.db.WithContext(ctx).Model(&schemas.HelmPackage{}).Where("name = ? AND version = ?", name, version).Select("COALESCE(MAX(created), '0001-01-01')").Scan(&result).Error; err != nil {
+		log.V(1).Info("failed to find package", "Error", err.Error())
+		return time.Time{}, returnErr(err, "failed to find Helm package")
+	}
 	return result, nil
 }
 
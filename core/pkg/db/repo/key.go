@@ -0,0 +1,85 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewSigningKeyRepo(db *gorm.DB) *SigningKeyRepo {
+	return &SigningKeyRepo{
+		db: db,
+	}
+}
+
+// GetOrCreate retrieves the named key, or generates and saves
+// it if it doesn't exist. If multiple instances race to create
+// the key, they will all return the one that was saved first.
+func (r *SigningKeyRepo) GetOrCreate(ctx context.Context, name string, generate func() (*schemas.SigningKey, error)) (*schemas.SigningKey, error) {
+	log := logr.FromContextOrDiscard(ctx).WithName("repo_key").WithValues("Name", name)
+	log.V(1).Info("fetching signing key")
+	var result schemas.SigningKey
+	err := r.db.WithContext(ctx).Where("name = ?", name).First(&result).Error
+	if err == nil {
+		return &result, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error(err, "failed to retrieve signing key")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to retrieve signing key")
+	}
+	log.Info("generating signing key")
+	key, err := generate()
+	if err != nil {
+		log.Error(err, "failed to generate signing key")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to generate signing key")
+	}
+	key.Name = name
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(key).Error; err != nil {
+		log.Error(err, "failed to save signing key")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to save signing key")
+	}
+	// fetch the key again in case
+	// someone else created it first
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&result).Error; err != nil {
+		log.Error(err, "failed to retrieve signing key")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to retrieve signing key")
+	}
+	return &result, nil
+}
+
+// Update saves changes to an existing key.
+func (r *SigningKeyRepo) Update(ctx context.Context, key *schemas.SigningKey) error {
+	log := logr.FromContextOrDiscard(ctx).WithName("repo_key").WithValues("Name", key.Name)
+	log.V(1).Info("updating signing key")
+	if err := r.db.WithContext(ctx).Model(key).Update("private_key", key.PrivateKey).Error; err != nil {
+		log.Error(err, "failed to update signing key")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to update signing key")
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"time"
 )
 
 func NewNPMRepo(db *gorm.DB) *NPMPackageRepo {
@@ -33,15 +34,18 @@ func NewNPMRepo(db *gorm.DB) *NPMPackageRepo {
 	}
 }
 
-func (r *NPMPackageRepo) Insert(ctx context.Context, pkg, data string) error {
-	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg)
+func (r *NPMPackageRepo) Insert(ctx context.Context, ref, pkg, data string) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg, "Refraction", ref)
 	log.V(1).Info("upserting package")
 	tx := r.db.WithContext(ctx).Clauses(clause.OnConflict{
-		Columns: []clause.Column{{Name: "name"}},
+		Columns: []clause.Column{{Name: "refraction"}, {Name: "name"}},
 		// merge the new data in with the existing if there's a conflict
-		DoUpdates: clause.Assignments(map[string]interface{}{"document": gorm.Expr("npm_packages.document || excluded.document")}),
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"document":   gorm.Expr("npm_packages.document || excluded.document"),
+			"updated_at": gorm.Expr("excluded.updated_at"),
+		}),
 	})
-	if err := tx.Model(&schemas.NPMPackage{}).Create(&schemas.NPMPackage{Name: pkg, Document: datatypes.JSON(data)}).Error; err != nil {
+	if err := tx.Model(&schemas.NPMPackage{}).Create(&schemas.NPMPackage{Refraction: ref, Name: pkg, Document: datatypes.JSON(data)}).Error; err != nil {
 		log.Error(err, "failed to upsert NPM package")
 		sentry.CaptureException(err)
 		return returnErr(err, "failed to update NPM packages")
@@ -49,11 +53,11 @@ func (r *NPMPackageRepo) Insert(ctx context.Context, pkg, data string) error {
 	return nil
 }
 
-func (r *NPMPackageRepo) GetPackage(ctx context.Context, pkg string) (string, error) {
-	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg)
+func (r *NPMPackageRepo) GetPackage(ctx context.Context, ref, pkg string) (string, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg, "Refraction", ref)
 	log.V(1).Info("fetching package")
 	var result string
-	if err := r.db.WithContext(ctx).Model(&schemas.NPMPackage{}).Where("name = ?", pkg).Select("document::text").First(&result).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&schemas.NPMPackage{}).Where("refraction = ? AND name = ?", ref, pkg).Select("document::text").First(&result).Error; err != nil {
 		log.Error(err, "failed to find package")
 		sentry.CaptureException(err)
 		return "", returnErr(err, "failed to find NPM package")
@@ -61,11 +65,38 @@ func (r *NPMPackageRepo) GetPackage(ctx context.Context, pkg string) (string, er
 	return result, nil
 }
 
-func (r *NPMPackageRepo) GetPackageVersion(ctx context.Context, pkg, version string) (string, error) {
-	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg, "Version", version)
+// LastUpdated returns when the package was last
+// fetched from the remotes of the Refraction.
+func (r *NPMPackageRepo) LastUpdated(ctx context.Context, ref, pkg string) (time.Time, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg, "Refraction", ref)
+	log.V(1).Info("fetching package update time")
+	var result time.Time
+	if err := r.db.WithContext(ctx).Model(&schemas.NPMPackage{}).Where("refraction = ? AND name = ?", ref, pkg).Select("updated_at").First(&result).Error; err != nil {
+		log.V(1).Info("failed to find package", "Error", err.Error())
+		return time.Time{}, returnErr(err, "failed to find NPM package")
+	}
+	return result, nil
+}
+
+// Expire marks the package as out of date in every
+// Refraction so that the next request fetches it from
+// the remotes rather than serving the stored copy.
+func (r *NPMPackageRepo) Expire(ctx context.Context, pkg string) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg)
+	log.V(1).Info("expiring package")
+	if err := r.db.WithContext(ctx).Model(&schemas.NPMPackage{}).Where("name = ?", pkg).UpdateColumn("updated_at", time.Unix(0, 0)).Error; err != nil {
+		log.Error(err, "failed to expire package")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to expire NPM package")
+	}
+	return nil
+}
+
+func (r *NPMPackageRepo) GetPackageVersion(ctx context.Context, ref, pkg, version string) (string, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg, "Version", version, "Refraction", ref)
 	log.V(1).Info("fetching package version")
 	var result string
-	if err := r.db.WithContext(ctx).Model(&schemas.NPMPackage{}).Where("name = ?", pkg).Select("document->'versions'->>?", version).First(&result).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&schemas.NPMPackage{}).Where("refraction = ? AND name = ?", ref, pkg).Select("document->'versions'->>?", version).First(&result).Error; err != nil {
 		log.Error(err, "failed to find package")
 		sentry.CaptureException(err)
 		return "", returnErr(err, "failed to find NPM package")
@@ -73,6 +104,27 @@ func (r *NPMPackageRepo) GetPackageVersion(ctx context.Context, pkg, version str
 	return result, nil
 }
 
+// GetPublishTime returns when a version of the package
+// was published, according to its "time" field.
+func (r *NPMPackageRepo) GetPublishTime(ctx context.Context, ref, pkg, version string) (time.Time, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg, "Version", version, "Refraction", ref)
+	log.V(1).Info("fetching package version publish time")
+	var result string
+	if err := r.db.WithContext(ctx).Model(&schemas.NPMPackage{}).Where("refraction = ? AND name = ?", ref, pkg).Select("COALESCE(document->'time'->>?, '')", version).First(&result).Error; err != nil {
+		log.V(1).Info("failed to find package", "Error", err.Error())
+		return time.Time{}, returnErr(err, "failed to find NPM package")
+	}
+	if result == "" {
+		return time.Time{}, nil
+	}
+	published, err := time.Parse(time.RFC3339, result)
+	if err != nil {
+		log.V(1).Info("failed to parse publish time", "Time", result, "Error", err.Error())
+		return time.Time{}, nil
+	}
+	return published, nil
+}
+
 func (r *NPMPackageRepo) Count(ctx context.Context) (int64, error) {
 	log := logr.FromContextOrDiscard(ctx)
 	log.V(1).Info("counting NPM packages")
@@ -24,6 +24,7 @@ import (
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"time"
 )
 
 func NewPyRepo(db *gorm.DB) *PyPackageRepo {
@@ -37,7 +38,15 @@ type GetPackageFunc = func(ctx context.Context, file string) (string, error)
 func (r *PyPackageRepo) BatchInsert(ctx context.Context, packages []*schemas.PyPackage) error {
 	log := logr.FromContextOrDiscard(ctx)
 	log.V(1).Info("upserting packages", "Count", len(packages))
-	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(packages, 1000).Error; err != nil {
+	// only the upload time is updated, and only
+	// if we've learned it since the last insert
+	tx := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "filename"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"upload_time": gorm.Expr("COALESCE(excluded.upload_time, py_packages.upload_time)"),
+		}),
+	})
+	if err := tx.CreateInBatches(packages, 1000).Error; err != nil {
 		log.Error(err, "failed to upsert packages")
 		sentry.CaptureException(err)
 		return returnErr(err, "failed to update PyPi packages")
@@ -69,6 +78,85 @@ func (r *PyPackageRepo) GetPackage(ctx context.Context, file string) (string, er
 	return result, nil
 }
 
+// GetUploadTimes returns when each file of
+// a package was published, if it is known.
+func (r *PyPackageRepo) GetUploadTimes(ctx context.Context, pkg string) (map[string]time.Time, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg)
+	log.V(1).Info("fetching package upload times")
+	var results []*schemas.PyPackage
+	if err := r.db.WithContext(ctx).Where("name = ? AND upload_time IS NOT NULL", pkg).Select("filename", "upload_time").Find(&results).Error; err != nil {
+		log.Error(err, "failed to find package upload times")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to find PyPi packages")
+	}
+	times := make(map[string]time.Time, len(results))
+	for _, p := range results {
+		times[p.Filename] = *p.UploadTime
+	}
+	return times, nil
+}
+
+// GetUploadTime returns when a file was
+// published, if it is known.
+func (r *PyPackageRepo) GetUploadTime(ctx context.Context, file string) (time.Time, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", file)
+	log.V(1).Info("fetching package upload time")
+	var result schemas.PyPackage
+	if err := r.db.WithContext(ctx).Where("filename = ?", file).Select("upload_time").First(&result).Error; err != nil {
+		log.V(1).Info("failed to find package", "Error", err.Error())
+		return time.Time{}, returnErr(err, "failed to find PyPi package")
+	}
+	if result.UploadTime == nil {
+		return time.Time{}, nil
+	}
+	return *result.UploadTime, nil
+}
+
+// SaveIndex stores the simple index that was
+// generated for a package, so that it can be
+// served if the remotes are unreachable.
+func (r *PyPackageRepo) SaveIndex(ctx context.Context, ref, pkg, doc string) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg, "Refraction", ref)
+	log.V(1).Info("upserting package index")
+	tx := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "refraction"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"document", "updated_at"}),
+	})
+	if err := tx.Create(&schemas.PyIndex{Refraction: ref, Name: pkg, Document: doc}).Error; err != nil {
+		log.Error(err, "failed to upsert package index")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to update PyPi index")
+	}
+	return nil
+}
+
+// GetIndex returns the last simple index that was
+// generated for a package and when it was generated.
+func (r *PyPackageRepo) GetIndex(ctx context.Context, ref, pkg string) (string, time.Time, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg, "Refraction", ref)
+	log.V(1).Info("fetching package index")
+	var result schemas.PyIndex
+	if err := r.db.WithContext(ctx).Where("refraction = ? AND name = ?", ref, pkg).First(&result).Error; err != nil {
+		log.V(1).Info("failed to find package index", "Error", err.Error())
+		return "", time.Time{}, returnErr(err, "failed to find PyPi index")
+	}
+	return result.Document, result.UpdatedAt, nil
+}
+
+// ExpireIndex marks the index of a package as out
+// of date in every Refraction, so that the next
+// request regenerates it.
+func (r *PyPackageRepo) ExpireIndex(ctx context.Context, pkg string) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", pkg)
+	log.V(1).Info("expiring package index")
+	if err := r.db.WithContext(ctx).Model(&schemas.PyIndex{}).Where("name = ?", pkg).UpdateColumn("updated_at", time.Unix(0, 0)).Error; err != nil {
+		log.Error(err, "failed to expire package index")
+		sentry.CaptureException(err)
+		return returnErr(err, "failed to expire PyPi index")
+	}
+	return nil
+}
+
 func (r *PyPackageRepo) Count(ctx context.Context) (int64, error) {
 	log := logr.FromContextOrDiscard(ctx)
 	log.V(1).Info("counting PyPi packages")
@@ -0,0 +1,116 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package repo
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func NewQuarantineRepo(db *gorm.DB) *QuarantineRepo {
+	return &QuarantineRepo{
+		db: db,
+	}
+}
+
+// CreateExemption allows a package to skip the quarantine
+// of a refraction. If the version is empty, every version
+// of the package is exempt.
+func (r *QuarantineRepo) CreateExemption(ctx context.Context, in *model.NewQuarantineExemption, createdBy string) (*model.QuarantineExemption, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_quarantine_createExemption", trace.WithAttributes(
+		attribute.String("refraction", in.RefractionID),
+		attribute.String("package", in.Package),
+		attribute.String("version", in.Version),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Refraction", in.RefractionID, "Package", in.Package, "Version", in.Version)
+	log.V(1).Info("creating quarantine exemption")
+	pkg := strings.TrimSpace(in.Package)
+	if pkg == "" {
+		return nil, problem.New(http.StatusBadRequest).Errorf("package must be set")
+	}
+	e := &model.QuarantineExemption{
+		CreatedAt:    time.Now().Unix(),
+		RefractionID: in.RefractionID,
+		Package:      pkg,
+		Version:      strings.TrimSpace(in.Version),
+		CreatedBy:    createdBy,
+	}
+	if err := r.db.WithContext(ctx).Create(e).Error; err != nil {
+		log.Error(err, "failed to create quarantine exemption")
+		return nil, returnErr(err, "failed to create quarantine exemption")
+	}
+	return e, nil
+}
+
+// GetExemption returns a single exemption.
+func (r *QuarantineRepo) GetExemption(ctx context.Context, id string) (*model.QuarantineExemption, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_quarantine_getExemption", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id)
+	log.V(1).Info("fetching quarantine exemption")
+	var result model.QuarantineExemption
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&result).Error; err != nil {
+		log.Error(err, "failed to fetch quarantine exemption")
+		return nil, returnErr(err, "failed to fetch quarantine exemption")
+	}
+	return &result, nil
+}
+
+func (r *QuarantineRepo) DeleteExemption(ctx context.Context, id string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_quarantine_deleteExemption", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id)
+	log.V(1).Info("deleting quarantine exemption")
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.QuarantineExemption{}).Error; err != nil {
+		log.Error(err, "failed to delete quarantine exemption")
+		return returnErr(err, "failed to delete quarantine exemption")
+	}
+	return nil
+}
+
+// ListExemptions returns the exemptions
+// that have been granted in a refraction.
+func (r *QuarantineRepo) ListExemptions(ctx context.Context, refraction string) ([]*model.QuarantineExemption, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_quarantine_listExemptions", trace.WithAttributes(
+		attribute.String("refraction", refraction),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Refraction", refraction)
+	log.V(1).Info("listing quarantine exemptions")
+	var results []*model.QuarantineExemption
+	if err := r.db.WithContext(ctx).Where("refraction_id = ?", refraction).Order("package, version").Find(&results).Error; err != nil {
+		log.Error(err, "failed to list quarantine exemptions")
+		return nil, returnErr(err, "failed to list quarantine exemptions")
+	}
+	return results, nil
+}
@@ -22,14 +22,20 @@ import (
 	"fmt"
 	"github.com/getsentry/sentry-go"
 	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
 	"gitlab.com/go-prism/prism3/core/internal/errs"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/pkg/db"
+	"gitlab.com/go-prism/prism3/core/pkg/db/datatypes"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"net/http"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 )
@@ -63,7 +69,13 @@ func (r *RefractRepo) DeleteRefraction(ctx context.Context, id string) error {
 		return returnErr(errs.ErrForbidden, "reject request to delete Go refraction")
 	}
 
-	if err := r.db.WithContext(ctx).Delete(&model.Refraction{}, "id = ?", id).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.QuarantineExemption{}, "refraction_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.Refraction{}, "id = ?", id).Error
+	})
+	if err != nil {
 		log.Error(err, "failed to delete refraction")
 		sentry.CaptureException(err)
 		return returnErr(err, "failed to delete refraction")
@@ -85,24 +97,59 @@ func (r *RefractRepo) PatchRefraction(ctx context.Context, id string, in *model.
 		return nil, returnErr(err, "failed to fetch refraction")
 	}
 	// block changes to Go archetypes since
-	// they must be managed by Prism
+	// they must be managed by Prism. The only
+	// exception is who is allowed to read from it.
 	if ref.Archetype == model.ArchetypeGo {
-		log.Error(errs.ErrForbidden, "rejecting request to modify Go refraction")
-		return nil, errs.ErrForbidden
+		if ref.Private == in.Private {
+			log.Error(errs.ErrForbidden, "rejecting request to modify Go refraction")
+			return nil, errs.ErrForbidden
+		}
+		log.Info("updating access to Go refraction", "Private", in.Private)
+		ref.Private = in.Private
+		ref.UpdatedAt = time.Now().Unix()
+		if err := r.db.WithContext(ctx).Model(&ref).Select("Private", "UpdatedAt").Updates(&ref).Error; err != nil {
+			log.Error(err, "failed to update refraction")
+			sentry.CaptureException(err)
+			return nil, returnErr(err, "failed to update refraction")
+		}
+		return &ref, nil
 	}
 	// fetch the remotes
-	var remotes []*model.Remote
-	if err := r.db.WithContext(ctx).Omit("Security.DirectToken").Where("id = ANY(?::uuid[])", getAnyQuery(in.Remotes)).Find(&remotes).Error; err != nil {
-		log.Error(err, "failed to retrieve remotes")
-		sentry.CaptureException(err)
-		return nil, returnErr(err, "failed to retrieve remotes")
+	remotes, err := r.getRemotes(ctx, in.Remotes)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePins(in.Pins, remotes); err != nil {
+		log.Info("rejecting invalid pins", "Error", err.Error())
+		return nil, err
+	}
+	if in.QuarantineDays < 0 {
+		return nil, problem.New(http.StatusBadRequest).Errorf("quarantine cannot be negative")
 	}
 	// update the refraction
 	ref.Name = in.Name
 	ref.Remotes = remotes
+	ref.Strategy = in.Strategy
+	ref.Pins = pins(in.Pins)
+	ref.Offline = in.Offline
+	ref.QuarantineDays = in.QuarantineDays
+	ref.VulnerabilityAction = in.VulnerabilityAction
+	ref.Private = in.Private
 	ref.UpdatedAt = time.Now().Unix()
+	if ref.Strategy == "" {
+		ref.Strategy = model.ResolutionStrategyFastest
+	}
+	if ref.VulnerabilityAction == "" {
+		ref.VulnerabilityAction = model.VulnerabilityActionNone
+	}
 	// save the changes
-	if err := r.db.WithContext(ctx).Save(&ref).Error; err != nil {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Remotes").Save(&ref).Error; err != nil {
+			return err
+		}
+		return setRemotes(tx, ref.ID, remotes)
+	})
+	if err != nil {
 		log.Error(err, "failed to update refraction")
 		sentry.CaptureException(err)
 		return nil, returnErr(err, "failed to update refraction")
@@ -112,20 +159,43 @@ func (r *RefractRepo) PatchRefraction(ctx context.Context, id string, in *model.
 
 func (r *RefractRepo) CreateRefraction(ctx context.Context, in *model.NewRefract) (*model.Refraction, error) {
 	log := logr.FromContextOrDiscard(ctx)
-	var remotes []*model.Remote
-	if err := r.db.WithContext(ctx).Omit("Security.DirectToken").Where("id = ANY(?::uuid[])", getAnyQuery(in.Remotes)).Find(&remotes).Error; err != nil {
-		log.Error(err, "failed to retrieve remotes")
-		sentry.CaptureException(err)
-		return nil, returnErr(err, "failed to retrieve remotes")
+	remotes, err := r.getRemotes(ctx, in.Remotes)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePins(in.Pins, remotes); err != nil {
+		log.Info("rejecting invalid pins", "Error", err.Error())
+		return nil, err
+	}
+	if in.QuarantineDays < 0 {
+		return nil, problem.New(http.StatusBadRequest).Errorf("quarantine cannot be negative")
 	}
 	result := model.Refraction{
-		CreatedAt: time.Now().Unix(),
-		UpdatedAt: time.Now().Unix(),
-		Name:      in.Name,
-		Archetype: in.Archetype,
-		Remotes:   remotes,
+		CreatedAt:           time.Now().Unix(),
+		UpdatedAt:           time.Now().Unix(),
+		Name:                in.Name,
+		Archetype:           in.Archetype,
+		Remotes:             remotes,
+		Strategy:            in.Strategy,
+		Pins:                pins(in.Pins),
+		Offline:             in.Offline,
+		QuarantineDays:      in.QuarantineDays,
+		VulnerabilityAction: in.VulnerabilityAction,
+		Private:             in.Private,
+	}
+	if result.Strategy == "" {
+		result.Strategy = model.ResolutionStrategyFastest
+	}
+	if result.VulnerabilityAction == "" {
+		result.VulnerabilityAction = model.VulnerabilityActionNone
 	}
-	if err := r.db.WithContext(ctx).Create(&result).Error; err != nil {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Remotes").Create(&result).Error; err != nil {
+			return err
+		}
+		return setRemotes(tx, result.ID, remotes)
+	})
+	if err != nil {
 		log.Error(err, "failed to create refraction")
 		sentry.CaptureException(err)
 		return nil, returnErr(err, "failed to create refraction")
@@ -133,6 +203,105 @@ func (r *RefractRepo) CreateRefraction(ctx context.Context, in *model.NewRefract
 	return &result, nil
 }
 
+// getRemotes fetches the remotes with the given IDs,
+// in the order that the IDs were given.
+func (r *RefractRepo) getRemotes(ctx context.Context, ids []string) ([]*model.Remote, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	var remotes []*model.Remote
+	if err := r.db.WithContext(ctx).Omit("Security.DirectToken").Where("id = ANY(?::uuid[])", getAnyQuery(ids)).Find(&remotes).Error; err != nil {
+		log.Error(err, "failed to retrieve remotes")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to retrieve remotes")
+	}
+	positions := make(map[string]int, len(ids))
+	for i, id := range ids {
+		if _, ok := positions[id]; !ok {
+			positions[id] = i
+		}
+	}
+	sort.SliceStable(remotes, func(i, j int) bool {
+		return positions[remotes[i].ID] < positions[remotes[j].ID]
+	})
+	return remotes, nil
+}
+
+// setRemotes replaces the remotes of a refraction,
+// recording the order that they are given in.
+func setRemotes(tx *gorm.DB, id string, remotes []*model.Remote) error {
+	if err := tx.Where("refraction_id = ?", id).Delete(&schemas.RefRemote{}).Error; err != nil {
+		return err
+	}
+	if len(remotes) == 0 {
+		return nil
+	}
+	items := make([]schemas.RefRemote, len(remotes))
+	for i := range remotes {
+		items[i] = schemas.RefRemote{
+			RefractionID: id,
+			RemoteID:     remotes[i].ID,
+			Position:     i,
+		}
+	}
+	return tx.Create(&items).Error
+}
+
+// sortRemotes puts the remotes of each refraction
+// into the order that they were configured in.
+func (r *RefractRepo) sortRemotes(ctx context.Context, refs ...*model.Refraction) error {
+	if len(refs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(refs))
+	for i := range refs {
+		ids[i] = refs[i].ID
+	}
+	var items []schemas.RefRemote
+	if err := r.db.WithContext(ctx).Where("refraction_id = ANY(?::uuid[])", getAnyQuery(ids)).Find(&items).Error; err != nil {
+		return err
+	}
+	positions := make(map[string]int, len(items))
+	for _, item := range items {
+		positions[item.RefractionID+"/"+item.RemoteID] = item.Position
+	}
+	for _, ref := range refs {
+		sort.SliceStable(ref.Remotes, func(i, j int) bool {
+			return positions[ref.ID+"/"+ref.Remotes[i].ID] < positions[ref.ID+"/"+ref.Remotes[j].ID]
+		})
+	}
+	return nil
+}
+
+// validatePins checks that each pin is a valid
+// regular expression that refers to one of the
+// remotes in the refraction.
+func validatePins(pins map[string]string, remotes []*model.Remote) error {
+	for pattern, id := range pins {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return problem.New(http.StatusBadRequest).Errorf("pin %q is not a valid regular expression: %s", pattern, err)
+		}
+		found := false
+		for _, rm := range remotes {
+			if rm.ID == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return problem.New(http.StatusBadRequest).Errorf("pin %q refers to a remote that is not in the refraction", pattern)
+		}
+	}
+	return nil
+}
+
+// pins makes sure that we never
+// save a null value
+func pins(in datatypes.JSONMap) datatypes.JSONMap {
+	if in == nil {
+		return datatypes.JSONMap{}
+	}
+	return in
+}
+
 func (r *RefractRepo) GetRefractionByName(ctx context.Context, name string) (*model.Refraction, error) {
 	log := logr.FromContextOrDiscard(ctx).WithValues("Name", name)
 	log.V(1).Info("fetching refraction by name")
@@ -142,6 +311,11 @@ func (r *RefractRepo) GetRefractionByName(ctx context.Context, name string) (*mo
 		sentry.CaptureException(err)
 		return nil, returnErr(err, "failed to get refraction")
 	}
+	if err := r.sortRemotes(ctx, &result); err != nil {
+		log.Error(err, "failed to sort remotes")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to get refraction")
+	}
 	return &result, nil
 }
 
@@ -154,6 +328,11 @@ func (r *RefractRepo) GetRefraction(ctx context.Context, id string) (*model.Refr
 		sentry.CaptureException(err)
 		return nil, returnErr(err, "failed to get refraction")
 	}
+	if err := r.sortRemotes(ctx, &result); err != nil {
+		log.Error(err, "failed to sort remotes")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to get refraction")
+	}
 	return &result, nil
 }
 
@@ -185,6 +364,11 @@ func (r *RefractRepo) ListRefractions(ctx context.Context) ([]*model.Refraction,
 		sentry.CaptureException(err)
 		return nil, returnErr(err, "failed to list refractions")
 	}
+	if err := r.sortRemotes(ctx, result...); err != nil {
+		log.Error(err, "failed to sort remotes")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to list refractions")
+	}
 	return result, nil
 }
 
@@ -19,8 +19,10 @@ package repo
 
 import (
 	"context"
+	"github.com/djcass44/go-utils/utilities/sliceutils"
 	"github.com/getsentry/sentry-go"
 	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
 	"gitlab.com/go-prism/prism3/core/internal/errs"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/pkg/db"
@@ -29,10 +31,20 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"net/http"
 	"strings"
 	"time"
 )
 
+// HostedArchetypes are the archetypes that
+// can be used to create a hosted remote.
+var HostedArchetypes = []model.Archetype{
+	model.ArchetypeGeneric,
+	model.ArchetypeNpm,
+	model.ArchetypePip,
+	model.ArchetypeHelm,
+}
+
 func NewRemoteRepo(db *gorm.DB) *RemoteRepo {
 	return &RemoteRepo{
 		db: db,
@@ -102,17 +114,40 @@ func (r *RemoteRepo) PatchRemote(ctx context.Context, id string, in *model.Patch
 	// update the remote
 	rem.Security.Allowed = in.Allowed
 	rem.Security.Blocked = in.Blocked
+	rem.Security.Rules = in.Rules
+	rem.Security.PolicyMode = in.PolicyMode
 	rem.Security.AuthMode = in.AuthMode
 	rem.Security.DirectHeader = in.DirectHeader
 	rem.Security.DirectToken = in.DirectToken
 	rem.Security.AuthHeaders = in.AuthHeaders
 
+	if in.Timeout < 1 || in.BreakerThreshold < 0 || in.BreakerCooldown < 1 {
+		log.Info("rejecting invalid circuit breaker settings", "Timeout", in.Timeout, "Threshold", in.BreakerThreshold, "Cooldown", in.BreakerCooldown)
+		return nil, problem.New(http.StatusBadRequest).Errorf("timeout and cooldown must be at least 1 second and threshold cannot be negative")
+	}
+	rem.Timeout = in.Timeout
+	rem.BreakerThreshold = in.BreakerThreshold
+	rem.BreakerCooldown = in.BreakerCooldown
+
+	if in.RetentionMaxAge < 0 || in.RetentionMaxSize < 0 || in.RetentionKeepLatest < 0 {
+		log.Info("rejecting invalid retention settings", "MaxAge", in.RetentionMaxAge, "MaxSize", in.RetentionMaxSize, "KeepLatest", in.RetentionKeepLatest)
+		return nil, problem.New(http.StatusBadRequest).Errorf("retention settings cannot be negative")
+	}
+	rem.RetentionMaxAge = in.RetentionMaxAge
+	rem.RetentionMaxSize = in.RetentionMaxSize
+	rem.RetentionKeepLatest = in.RetentionKeepLatest
+
 	// save the changes
 	if err := r.db.WithContext(ctx).Save(&rem.Security).Error; err != nil {
 		log.Error(err, "failed to update remote security profile")
 		sentry.CaptureException(err)
 		return nil, returnErr(err, "failed to update remote security profile")
 	}
+	if err := r.db.WithContext(ctx).Model(&rem).Select("Timeout", "BreakerThreshold", "BreakerCooldown", "RetentionMaxAge", "RetentionMaxSize", "RetentionKeepLatest").Updates(&rem).Error; err != nil {
+		log.Error(err, "failed to update remote")
+		sentry.CaptureException(err)
+		return nil, returnErr(err, "failed to update remote")
+	}
 	return &rem, nil
 }
 
@@ -120,7 +155,19 @@ func (r *RemoteRepo) CreateRemote(ctx context.Context, in *model.NewRemote) (*mo
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_remote_createRemote")
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx)
-	log.V(1).Info("creating remote")
+	log.V(1).Info("creating remote", "Hosted", in.Hosted)
+	uri := strings.TrimSuffix(in.URI, "/")
+	if in.Hosted {
+		if !sliceutils.Includes(HostedArchetypes, in.Archetype) {
+			log.Info("rejecting hosted remote with unsupported archetype", "Archetype", in.Archetype)
+			return nil, problem.New(http.StatusBadRequest).Errorf("hosted remotes are not supported by this archetype")
+		}
+		// hosted remotes don't have an upstream
+		uri = ""
+	} else if uri == "" {
+		log.Info("rejecting remote without a uri")
+		return nil, problem.New(http.StatusBadRequest).Errorf("remote must have a uri")
+	}
 	var transport model.TransportSecurity
 	if in.Transport == "" {
 		log.V(1).Info("using default transport")
@@ -136,9 +183,10 @@ func (r *RemoteRepo) CreateRemote(ctx context.Context, in *model.NewRemote) (*mo
 		CreatedAt: time.Now().Unix(),
 		UpdatedAt: time.Now().Unix(),
 		Name:      in.Name,
-		URI:       strings.TrimSuffix(in.URI, "/"),
+		URI:       uri,
 		Archetype: in.Archetype,
 		Enabled:   true,
+		Hosted:    in.Hosted,
 		Security: &model.RemoteSecurity{
 			AuthMode: in.AuthMode,
 		},
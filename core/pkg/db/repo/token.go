@@ -0,0 +1,214 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package repo
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// GetTokenFunc finds an access token
+// by the hash of its secret.
+type GetTokenFunc = func(ctx context.Context, hash string) (*schemas.AccessToken, error)
+
+// TouchTokenFunc records that an access token was used.
+type TouchTokenFunc = func(ctx context.Context, id string, at time.Time) error
+
+func NewTokenRepo(db *gorm.DB) *TokenRepo {
+	return &TokenRepo{
+		db: db,
+	}
+}
+
+func (r *TokenRepo) CreateServiceAccount(ctx context.Context, in *model.NewServiceAccount, createdBy string) (*model.ServiceAccount, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_createServiceAccount", trace.WithAttributes(
+		attribute.String("name", in.Name),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Name", in.Name)
+	log.V(1).Info("creating service account")
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, problem.New(http.StatusBadRequest).Errorf("name must be set")
+	}
+	sa := &model.ServiceAccount{
+		CreatedAt:   time.Now().Unix(),
+		Name:        name,
+		Description: in.Description,
+		CreatedBy:   createdBy,
+	}
+	if err := r.db.WithContext(ctx).Create(sa).Error; err != nil {
+		log.Error(err, "failed to create service account")
+		return nil, returnErr(err, "failed to create service account")
+	}
+	return sa, nil
+}
+
+func (r *TokenRepo) GetServiceAccount(ctx context.Context, id string) (*model.ServiceAccount, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_getServiceAccount", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id)
+	log.V(1).Info("fetching service account")
+	var result model.ServiceAccount
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&result).Error; err != nil {
+		log.Error(err, "failed to fetch service account")
+		return nil, returnErr(err, "failed to fetch service account")
+	}
+	return &result, nil
+}
+
+func (r *TokenRepo) ListServiceAccounts(ctx context.Context) ([]*model.ServiceAccount, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_listServiceAccounts")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("listing service accounts")
+	var results []*model.ServiceAccount
+	if err := r.db.WithContext(ctx).Order("name").Find(&results).Error; err != nil {
+		log.Error(err, "failed to list service accounts")
+		return nil, returnErr(err, "failed to list service accounts")
+	}
+	return results, nil
+}
+
+// DeleteServiceAccount removes a service
+// account along with all of its tokens.
+func (r *TokenRepo) DeleteServiceAccount(ctx context.Context, id string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_deleteServiceAccount", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id)
+	log.V(1).Info("deleting service account")
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("service_account_id = ?", id).Delete(&schemas.AccessToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.ServiceAccount{}).Error
+	})
+	if err != nil {
+		log.Error(err, "failed to delete service account")
+		return returnErr(err, "failed to delete service account")
+	}
+	return nil
+}
+
+func (r *TokenRepo) CreateToken(ctx context.Context, token *schemas.AccessToken) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_createToken", trace.WithAttributes(
+		attribute.String("name", token.Name),
+		attribute.String("serviceAccount", token.ServiceAccountID),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Name", token.Name, "ServiceAccount", token.ServiceAccountID)
+	log.V(1).Info("creating access token")
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
+		log.Error(err, "failed to create access token")
+		return returnErr(err, "failed to create access token")
+	}
+	return nil
+}
+
+func (r *TokenRepo) GetToken(ctx context.Context, id string) (*schemas.AccessToken, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_getToken", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id)
+	log.V(1).Info("fetching access token")
+	var result schemas.AccessToken
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&result).Error; err != nil {
+		log.Error(err, "failed to fetch access token")
+		return nil, returnErr(err, "failed to fetch access token")
+	}
+	return &result, nil
+}
+
+// GetTokenByHash finds the access token
+// that a secret belongs to.
+func (r *TokenRepo) GetTokenByHash(ctx context.Context, hash string) (*schemas.AccessToken, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_getTokenByHash")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("fetching access token by hash")
+	var result schemas.AccessToken
+	if err := r.db.WithContext(ctx).Where("hash = ?", hash).First(&result).Error; err != nil {
+		log.V(1).Info("failed to fetch access token", "Error", err.Error())
+		return nil, returnErr(err, "failed to fetch access token")
+	}
+	return &result, nil
+}
+
+// ListTokens returns the access tokens
+// that authenticate as an identity.
+func (r *TokenRepo) ListTokens(ctx context.Context, iss, sub string) ([]*schemas.AccessToken, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_listTokens", trace.WithAttributes(
+		attribute.String("iss", iss),
+		attribute.String("sub", sub),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Iss", iss, "Sub", sub)
+	log.V(1).Info("listing access tokens")
+	var results []*schemas.AccessToken
+	if err := r.db.WithContext(ctx).Where("iss = ? AND sub = ?", iss, sub).Order("created_at DESC").Find(&results).Error; err != nil {
+		log.Error(err, "failed to list access tokens")
+		return nil, returnErr(err, "failed to list access tokens")
+	}
+	return results, nil
+}
+
+func (r *TokenRepo) DeleteToken(ctx context.Context, id string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_deleteToken", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id)
+	log.V(1).Info("deleting access token")
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Delete(&schemas.AccessToken{}).Error; err != nil {
+		log.Error(err, "failed to delete access token")
+		return returnErr(err, "failed to delete access token")
+	}
+	return nil
+}
+
+// TouchToken updates the time that an
+// access token was last used.
+func (r *TokenRepo) TouchToken(ctx context.Context, id string, at time.Time) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "repo_token_touchToken", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id)
+	log.V(2).Info("updating access token usage")
+	if err := r.db.WithContext(ctx).Model(&schemas.AccessToken{}).Where("id = ?", id).Update("last_used_at", at.Unix()).Error; err != nil {
+		log.Error(err, "failed to update access token usage")
+		return returnErr(err, "failed to update access token usage")
+	}
+	return nil
+}
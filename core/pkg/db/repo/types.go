@@ -68,6 +68,26 @@ type BandwidthRepo struct {
 	db *gorm.DB
 }
 
+type SigningKeyRepo struct {
+	db *gorm.DB
+}
+
+type QuarantineRepo struct {
+	db *gorm.DB
+}
+
+type AdvisoryRepo struct {
+	db *gorm.DB
+}
+
+type TokenRepo struct {
+	db *gorm.DB
+}
+
+type AuditRepo struct {
+	db *gorm.DB
+}
+
 type Repos struct {
 	RemoteRepo      *RemoteRepo
 	RefractRepo     *RefractRepo
@@ -78,4 +98,9 @@ type Repos struct {
 	HelmPackageRepo *HelmPackageRepo
 	UserRepo        *UserRepo
 	BandwidthRepo   *BandwidthRepo
+	SigningKeyRepo  *SigningKeyRepo
+	QuarantineRepo  *QuarantineRepo
+	AdvisoryRepo    *AdvisoryRepo
+	TokenRepo       *TokenRepo
+	AuditRepo       *AuditRepo
 }
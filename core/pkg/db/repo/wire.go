@@ -30,5 +30,10 @@ func NewRepos(db *gorm.DB) *Repos {
 		HelmPackageRepo: NewHelmRepo(db),
 		UserRepo:        NewUserRepo(db),
 		BandwidthRepo:   NewBandwidthRepo(db),
+		SigningKeyRepo:  NewSigningKeyRepo(db),
+		QuarantineRepo:  NewQuarantineRepo(db),
+		AdvisoryRepo:    NewAdvisoryRepo(db),
+		TokenRepo:       NewTokenRepo(db),
+		AuditRepo:       NewAuditRepo(db),
 	}
 }
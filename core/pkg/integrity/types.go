@@ -0,0 +1,59 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package integrity
+
+import (
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"time"
+)
+
+// minVerifyInterval is how long an artifact is trusted
+// after it was last hashed, so that large remotes
+// aren't read in their entirety every day.
+const minVerifyInterval = time.Hour * 24 * 7
+
+// Verifier re-hashes cached files to detect
+// bit-rot or tampering in storage.
+type Verifier struct {
+	repos *repo.Repos
+	store storage.Reader
+	now   func() time.Time
+}
+
+// Report describes the outcome
+// of verifying a remote.
+type Report struct {
+	// Checked is the number of artifacts
+	// that were hashed
+	Checked int
+	// Corrupt is the number of artifacts that
+	// didn't match and were evicted
+	Corrupt int
+}
+
+// target is an artifact whose
+// objects need to be hashed.
+type target struct {
+	artifact *model.Artifact
+	// keys are the objects that hold the
+	// artifact, including any partitions
+	keys []string
+	size int64
+}
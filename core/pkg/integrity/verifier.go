@@ -0,0 +1,206 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package integrity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/getsentry/sentry-go"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+func NewVerifier(repos *repo.Repos, store storage.Reader) *Verifier {
+	return &Verifier{
+		repos: repos,
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Verify re-hashes the cached files of a remote and
+// compares them with the SHA-256 that was recorded
+// when they were downloaded. Files that don't match
+// are evicted so that they are fetched from upstream
+// again.
+func (v *Verifier) Verify(ctx context.Context, id string) (*Report, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "integrity_verify", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id)
+	rem, err := v.repos.RemoteRepo.GetRemote(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	log = log.WithValues("Remote", rem.Name)
+	artifacts, err := v.repos.ArtifactRepo.ListArtifacts(ctx, []string{rem.ID})
+	if err != nil {
+		return nil, err
+	}
+	entries, err := v.store.List(ctx, rem.Name+"/")
+	if err != nil {
+		return nil, err
+	}
+	targets := plan(rem, artifacts, entries, v.now())
+	log.Info("planned verification", "Artifacts", len(artifacts), "Objects", len(entries), "Targets", len(targets))
+
+	report := &Report{}
+	var verified, corrupt []string
+	var released int64
+	for _, t := range targets {
+		ok, err := v.check(ctx, t)
+		if err != nil {
+			// storage may be temporarily unavailable,
+			// so try again next time
+			continue
+		}
+		report.Checked++
+		metricVerified.Add(ctx, 1, attribute.String(attrKeyRemote, rem.Name))
+		if ok {
+			verified = append(verified, t.artifact.ID)
+			continue
+		}
+		report.Corrupt++
+		metricCorrupt.Add(ctx, 1, attribute.String(attrKeyRemote, rem.Name))
+		if v.evict(ctx, t) {
+			corrupt = append(corrupt, t.artifact.ID)
+			released += t.size
+		}
+	}
+	if err := v.repos.ArtifactRepo.SetVerified(ctx, verified); err != nil {
+		return nil, err
+	}
+	if err := v.repos.ArtifactRepo.DeleteArtifacts(ctx, corrupt); err != nil {
+		return nil, err
+	}
+	// the files no longer count towards
+	// the storage quota
+	if err := v.repos.BandwidthRepo.ReleaseStorage(ctx, rem.ID, released); err != nil {
+		return nil, err
+	}
+	log.Info("verified artifacts", "Checked", report.Checked, "Corrupt", report.Corrupt)
+	return report, nil
+}
+
+// check hashes each object of an artifact and returns
+// false if any of them don't match its digest.
+func (v *Verifier) check(ctx context.Context, t *target) (bool, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Uri", t.artifact.URI)
+	for _, k := range t.keys {
+		sum, err := hashObject(ctx, v.store, k)
+		if err != nil {
+			log.Error(err, "failed to read object", "Key", k)
+			return false, err
+		}
+		if sum != t.artifact.Sha256 {
+			err = fmt.Errorf("object %s does not match its digest: expected %s but got %s", k, t.artifact.Sha256, sum)
+			log.Error(err, "detected corrupt object", "Key", k)
+			sentry.CaptureException(err)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// evict deletes the objects of a corrupt artifact.
+// It returns false if any of them couldn't be
+// deleted, in which case the artifact must be kept
+// so that we try again next time.
+func (v *Verifier) evict(ctx context.Context, t *target) bool {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Uri", t.artifact.URI)
+	log.V(1).Info("evicting corrupt artifact", "Objects", len(t.keys))
+	for _, k := range t.keys {
+		if err := v.store.Delete(ctx, k); err != nil {
+			log.Error(err, "failed to delete object", "Key", k)
+			return false
+		}
+	}
+	return true
+}
+
+// hashObject returns the hex-encoded
+// SHA-256 of an object in storage.
+func hashObject(ctx context.Context, store storage.Reader, key string) (string, error) {
+	r, _, err := store.Get(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// plan decides which artifacts to verify. Only
+// artifacts that have a recorded digest and
+// haven't been verified recently are included.
+func plan(rem *model.Remote, artifacts []*model.Artifact, entries []storage.Entry, now time.Time) []*target {
+	prefix := rem.Name + "/"
+	after := now.Add(-minVerifyInterval).Unix()
+	byURI := make(map[string]*target, len(artifacts))
+	var result []*target
+	for _, a := range artifacts {
+		if a.Sha256 == "" || a.VerifiedAt > after {
+			continue
+		}
+		t := &target{artifact: a}
+		byURI[a.URI] = t
+		result = append(result, t)
+	}
+	// match each object to its artifact. Partitioned
+	// objects are stored in a separate directory, but
+	// older versions stored them beneath the artifact's
+	// path.
+	for _, e := range entries {
+		rel := storage.TrimPartition(strings.TrimPrefix(e.Key, prefix))
+		t, ok := byURI[rel]
+		if !ok {
+			t, ok = byURI[path.Dir(rel)]
+		}
+		if !ok {
+			continue
+		}
+		t.keys = append(t.keys, e.Key)
+		t.size += e.Size
+	}
+	// drop anything that is
+	// no longer in storage
+	n := 0
+	for _, t := range result {
+		if len(t.keys) > 0 {
+			result[n] = t
+			n++
+		}
+	}
+	return result[:n]
+}
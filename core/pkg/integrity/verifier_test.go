@@ -0,0 +1,72 @@
+package integrity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"testing"
+	"time"
+)
+
+func sum(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := int64(60 * 60 * 24)
+
+	artifacts := []*model.Artifact{
+		{ID: "1", URI: "foo/bar.txt", Sha256: sum("bar"), VerifiedAt: now.Unix() - day*30},
+		{ID: "2", URI: "foo/baz.txt", Sha256: sum("baz"), VerifiedAt: now.Unix() - day},
+		{ID: "3", URI: "foo/qux.txt", Sha256: ""},
+		{ID: "4", URI: "foo/zoo.txt", Sha256: sum("zoo")},
+		{ID: "5", URI: "foo/gone.txt", Sha256: sum("gone")},
+	}
+	entries := []storage.Entry{
+		{Key: "generic/foo/bar.txt"},
+		{Key: "generic/foo/baz.txt"},
+		{Key: "generic/foo/qux.txt"},
+		{Key: "generic/.partitions/abc123/foo/zoo.txt", Size: 3},
+		{Key: "generic/foo/zoo.txt/def456", Size: 3},
+	}
+	targets := plan(&model.Remote{Name: "generic"}, artifacts, entries, now)
+
+	var ids []string
+	for _, tt := range targets {
+		ids = append(ids, tt.artifact.ID)
+	}
+	assert.ElementsMatch(t, []string{"1", "4"}, ids)
+	assert.ElementsMatch(t, []string{"generic/.partitions/abc123/foo/zoo.txt", "generic/foo/zoo.txt/def456"}, targets[1].keys)
+	assert.EqualValues(t, 6, targets[1].size)
+}
+
+func TestVerifier_Check(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	store := storage.NewNoOp()
+	store.Data["generic/foo/bar.txt"] = []byte("bar")
+	store.Data["generic/foo/baz.txt"] = []byte("tampered")
+	v := &Verifier{store: store}
+
+	t.Run("matching object", func(t *testing.T) {
+		ok, err := v.check(ctx, &target{artifact: &model.Artifact{Sha256: sum("bar")}, keys: []string{"generic/foo/bar.txt"}})
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("corrupt object", func(t *testing.T) {
+		ok, err := v.check(ctx, &target{artifact: &model.Artifact{Sha256: sum("baz")}, keys: []string{"generic/foo/baz.txt"}})
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("missing object", func(t *testing.T) {
+		_, err := v.check(ctx, &target{artifact: &model.Artifact{Sha256: sum("qux")}, keys: []string{"generic/foo/qux.txt"}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,97 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package quarantine
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"gitlab.com/go-prism/prism3/core/pkg/versions"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// NewDownloads creates the Downloads of a refraction.
+// It returns nil if the quarantine is disabled or
+// the archetype doesn't record publish times.
+func NewDownloads(ref *model.Refraction, list ListFunc, published PublishedFunc) *Downloads {
+	if ref.QuarantineDays <= 0 || !Supported(ref.Archetype) {
+		return nil
+	}
+	return &Downloads{
+		ref:       ref,
+		list:      list,
+		published: published,
+	}
+}
+
+// Supported returns true if the quarantine
+// can be applied to an archetype.
+func Supported(archetype model.Archetype) bool {
+	switch archetype {
+	case model.ArchetypeNpm, model.ArchetypePip, model.ArchetypeHelm:
+		return true
+	}
+	return false
+}
+
+// Check refuses the download of a quarantined version.
+// Files that aren't a version of a package are
+// always allowed.
+func (d *Downloads) Check(ctx context.Context, uri string) error {
+	if d == nil {
+		return nil
+	}
+	archetype := d.ref.Archetype
+	uri, _, _ = strings.Cut(strings.TrimPrefix(uri, "/"), "#")
+	name, version, ok := versions.Parse(archetype, uri)
+	if !ok {
+		return nil
+	}
+	// charts are matched by name, wherever
+	// they are kept in the repository
+	if archetype == model.ArchetypeHelm {
+		name = path.Base(name)
+	}
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "quarantine_check", trace.WithAttributes(
+		attribute.String("package", name),
+		attribute.String("version", version),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Package", name, "Version", version, "Refraction", d.ref.Name)
+	published, err := d.published(ctx, d.ref, name, version, path.Base(uri))
+	if err != nil {
+		// carry on, since an unknown publish
+		// time is refused anyway
+		log.V(1).Info("failed to retrieve publish time", "Error", err.Error())
+		span.RecordError(err)
+	}
+	pol := Load(ctx, d.ref, d.list)
+	if pol.Allow(ctx, archetype, name, version, published) {
+		return nil
+	}
+	log.Info("refusing download of quarantined version", "Published", published)
+	pol.Hide(ctx, archetype, name, 1)
+	return problem.New(http.StatusForbidden).Errorf("version %s of %s is quarantined", version, name)
+}
@@ -0,0 +1,63 @@
+package quarantine
+
+import (
+	"context"
+	"errors"
+	"github.com/lpar/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewDownloads(t *testing.T) {
+	assert.Nil(t, NewDownloads(&model.Refraction{Archetype: model.ArchetypeNpm}, nil, nil))
+	assert.Nil(t, NewDownloads(&model.Refraction{Archetype: model.ArchetypeMaven, QuarantineDays: 7}, nil, nil))
+	assert.NotNil(t, NewDownloads(&model.Refraction{Archetype: model.ArchetypeNpm, QuarantineDays: 7}, nil, nil))
+}
+
+func TestDownloads_Check(t *testing.T) {
+	times := map[string]time.Time{
+		"lodash@4.17.21": time.Now().Add(-time.Hour * 24 * 30),
+		"lodash@4.17.22": time.Now().Add(-time.Hour),
+	}
+	d := NewDownloads(&model.Refraction{
+		ID:             "ref",
+		Archetype:      model.ArchetypeNpm,
+		QuarantineDays: 7,
+	}, func(ctx context.Context, refraction string) ([]*model.QuarantineExemption, error) {
+		return []*model.QuarantineExemption{{Package: "left-pad"}}, nil
+	}, func(ctx context.Context, ref *model.Refraction, name, version, file string) (time.Time, error) {
+		if name == "missing" {
+			return time.Time{}, errors.New("not found")
+		}
+		return times[key(name, version)], nil
+	})
+
+	var cases = []struct {
+		name string
+		path string
+		ok   bool
+	}{
+		{"not a package", "/lodash", true},
+		{"old version", "/lodash/-/lodash-4.17.21.tgz", true},
+		{"new version", "/lodash/-/lodash-4.17.22.tgz#sha512=abc", false},
+		{"unknown time", "/lodash/-/lodash-4.17.23.tgz", false},
+		{"lookup failed", "/missing/-/missing-1.0.0.tgz", false},
+		{"exemption", "/left-pad/-/left-pad-1.3.0.tgz", true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := d.Check(context.TODO(), tt.path)
+			if tt.ok {
+				assert.NoError(t, err)
+				return
+			}
+			var httpErr problem.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.EqualValues(t, http.StatusForbidden, httpErr.GetStatus())
+		})
+	}
+}
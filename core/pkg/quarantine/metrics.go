@@ -0,0 +1,38 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package quarantine
+
+import (
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+var (
+	meter           = global.MeterProvider().Meter("prism")
+	metricHidden, _ = meter.SyncInt64().Counter(
+		"prism.core.quarantine.hidden.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total package versions that were hidden as they were published too recently."),
+	)
+	metricUnknown, _ = meter.SyncInt64().Counter(
+		"prism.core.quarantine.unknown.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total package versions that were refused as their publish time is unknown."),
+	)
+)
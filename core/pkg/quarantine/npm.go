@@ -0,0 +1,46 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package quarantine
+
+import (
+	"context"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"gitlab.com/go-prism/prism3/core/pkg/versions"
+	"go.opentelemetry.io/otel"
+	"time"
+)
+
+// FilterNPM removes quarantined versions from an NPM
+// package document, using the publish times in
+// its "time" field.
+func (p *Policy) FilterNPM(ctx context.Context, pkg, doc string) (string, error) {
+	if p == nil {
+		return doc, nil
+	}
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "quarantine_filterNpm")
+	defer span.End()
+	data, hidden, err := versions.FilterNPM(doc, func(version string, published time.Time) bool {
+		return p.Allow(ctx, model.ArchetypeNpm, pkg, version, published)
+	})
+	if err != nil {
+		return "", err
+	}
+	p.Hide(ctx, model.ArchetypeNpm, pkg, hidden)
+	return data, nil
+}
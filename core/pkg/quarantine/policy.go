@@ -0,0 +1,112 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package quarantine
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"strings"
+	"time"
+)
+
+// New creates a Policy that hides versions published
+// less than the given number of days before now. It
+// returns nil if the quarantine is disabled.
+func New(days int64, now time.Time, exemptions []*model.QuarantineExemption) *Policy {
+	if days <= 0 {
+		return nil
+	}
+	p := &Policy{
+		cutoff: now.Add(-time.Duration(days) * time.Hour * 24),
+		exempt: make(map[string]struct{}, len(exemptions)),
+	}
+	for _, e := range exemptions {
+		p.exempt[key(e.Package, e.Version)] = struct{}{}
+	}
+	return p
+}
+
+// Load creates the Policy of a refraction.
+//
+// If the exemptions cannot be retrieved, the quarantine
+// is applied to every package rather than being skipped.
+func Load(ctx context.Context, ref *model.Refraction, list ListFunc) *Policy {
+	if ref.QuarantineDays <= 0 {
+		return nil
+	}
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "quarantine_load", trace.WithAttributes(
+		attribute.String("refraction", ref.Name),
+		attribute.Int64("days", ref.QuarantineDays),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Refraction", ref.Name, "Days", ref.QuarantineDays)
+	exemptions, err := list(ctx, ref.ID)
+	if err != nil {
+		log.Error(err, "failed to retrieve quarantine exemptions")
+		span.RecordError(err)
+	}
+	log.V(1).Info("loaded quarantine policy", "Exemptions", len(exemptions))
+	return New(ref.QuarantineDays, time.Now(), exemptions)
+}
+
+// Enabled returns true if the
+// Policy may hide any versions.
+func (p *Policy) Enabled() bool {
+	return p != nil
+}
+
+// Allow returns true if a version of a package can
+// be served. Versions with an unknown publish time
+// are refused unless they have been exempted, since
+// we can't tell how old they are.
+func (p *Policy) Allow(ctx context.Context, archetype model.Archetype, name, version string, published time.Time) bool {
+	if p == nil {
+		return true
+	}
+	if _, ok := p.exempt[key(name, "")]; ok {
+		return true
+	}
+	if _, ok := p.exempt[key(name, version)]; ok {
+		return true
+	}
+	if published.IsZero() {
+		logr.FromContextOrDiscard(ctx).Info("refusing version with an unknown publish time", "Package", name, "Version", version)
+		metricUnknown.Add(ctx, 1, attribute.String("archetype", string(archetype)))
+		return false
+	}
+	return published.Before(p.cutoff)
+}
+
+// Hide records that versions of a package were
+// hidden from a response.
+func (p *Policy) Hide(ctx context.Context, archetype model.Archetype, name string, n int) {
+	if n == 0 {
+		return
+	}
+	logr.FromContextOrDiscard(ctx).Info("hiding quarantined versions", "Package", name, "Count", n)
+	metricHidden.Add(ctx, int64(n), attribute.String("archetype", string(archetype)))
+}
+
+func key(name, version string) string {
+	return strings.ToLower(name) + "@" + version
+}
@@ -0,0 +1,95 @@
+package quarantine
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2023, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+func TestPolicy_Allow(t *testing.T) {
+	p := New(7, now, []*model.QuarantineExemption{
+		{Package: "left-pad"},
+		{Package: "React", Version: "18.3.0"},
+	})
+	var cases = []struct {
+		name      string
+		pkg       string
+		version   string
+		published time.Time
+		ok        bool
+	}{
+		{"old version", "lodash", "4.17.21", now.Add(-time.Hour * 24 * 30), true},
+		{"new version", "lodash", "4.17.22", now.Add(-time.Hour), false},
+		{"unknown time", "lodash", "4.17.22", time.Time{}, false},
+		{"unknown time exemption", "left-pad", "1.3.1", time.Time{}, true},
+		{"package exemption", "left-pad", "1.3.1", now.Add(-time.Hour), true},
+		{"version exemption", "react", "18.3.0", now.Add(-time.Hour), true},
+		{"other version", "react", "18.3.1", now.Add(-time.Hour), false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualValues(t, tt.ok, p.Allow(context.TODO(), model.ArchetypeNpm, tt.pkg, tt.version, tt.published))
+		})
+	}
+}
+
+func TestPolicy_Disabled(t *testing.T) {
+	p := New(0, now, nil)
+	assert.False(t, p.Enabled())
+	assert.True(t, p.Allow(context.TODO(), model.ArchetypeNpm, "lodash", "4.17.22", now))
+}
+
+func TestPolicy_FilterNPM(t *testing.T) {
+	doc := `{
+		"name": "lodash",
+		"dist-tags": {"latest": "4.18.0", "next": "5.0.0-beta.1", "legacy": "3.10.1"},
+		"versions": {"3.10.1": {}, "4.17.21": {}, "4.18.0": {}, "5.0.0-beta.1": {}},
+		"time": {
+			"created": "2012-04-23T16:37:11.912Z",
+			"3.10.1": "2015-08-31T16:00:00.000Z",
+			"4.17.21": "2021-02-20T15:42:16.891Z",
+			"4.18.0": "2023-03-09T00:00:00.000Z",
+			"5.0.0-beta.1": "2023-03-08T00:00:00.000Z"
+		}
+	}`
+
+	t.Run("disabled", func(t *testing.T) {
+		var p *Policy
+		out, err := p.FilterNPM(context.TODO(), "lodash", doc)
+		require.NoError(t, err)
+		assert.EqualValues(t, doc, out)
+	})
+	t.Run("quarantined", func(t *testing.T) {
+		p := New(7, now, nil)
+		out, err := p.FilterNPM(context.TODO(), "lodash", doc)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"name": "lodash",
+			"dist-tags": {"latest": "4.17.21", "legacy": "3.10.1"},
+			"versions": {"3.10.1": {}, "4.17.21": {}},
+			"time": {
+				"created": "2012-04-23T16:37:11.912Z",
+				"3.10.1": "2015-08-31T16:00:00.000Z",
+				"4.17.21": "2021-02-20T15:42:16.891Z"
+			}
+		}`, out)
+	})
+	t.Run("exempt", func(t *testing.T) {
+		p := New(7, now, []*model.QuarantineExemption{{Package: "lodash", Version: "4.18.0"}})
+		out, err := p.FilterNPM(context.TODO(), "lodash", doc)
+		require.NoError(t, err)
+		var packument struct {
+			DistTags map[string]string `json:"dist-tags"`
+			Versions map[string]any    `json:"versions"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(out), &packument))
+		assert.EqualValues(t, "4.18.0", packument.DistTags["latest"])
+		assert.NotContains(t, packument.Versions, "5.0.0-beta.1")
+	})
+}
@@ -0,0 +1,52 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package quarantine
+
+import (
+	"context"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"time"
+)
+
+// ListFunc returns the exemptions
+// that were granted in a refraction.
+type ListFunc = func(ctx context.Context, refraction string) ([]*model.QuarantineExemption, error)
+
+// PublishedFunc returns when a file of a package was
+// published in a refraction. It returns the zero time
+// if it isn't known.
+type PublishedFunc = func(ctx context.Context, ref *model.Refraction, name, version, file string) (time.Time, error)
+
+// Downloads applies the quarantine of a refraction
+// to the files that are downloaded from it. A nil
+// Downloads allows every file.
+type Downloads struct {
+	ref       *model.Refraction
+	list      ListFunc
+	published PublishedFunc
+}
+
+// Policy hides versions of packages that were published
+// too recently to be trusted. A nil Policy allows
+// every version.
+type Policy struct {
+	cutoff time.Time
+	// exempt holds the packages (or specific
+	// versions of packages) that skip the quarantine
+	exempt map[string]struct{}
+}
@@ -49,29 +49,51 @@ func NewNetObserver(ctx context.Context, repo *repo.BandwidthRepo) *NetObserver
 
 func (o *NetObserver) Observe(resource string, usage int64, bandwidthType model.BandwidthType) {
 	o.log.V(5).Info("adding network observation", "Resource", resource, "Usage", usage)
+	o.bucketSync.Lock()
 	m, ok := o.buckets[bandwidthType]
 	if !ok {
 		m = map[string]int64{}
+		o.buckets[bandwidthType] = m
 	}
-	o.bucketSync.Lock()
 	m[resource] += usage
-	o.buckets[bandwidthType] = m
 	o.bucketSync.Unlock()
 
 	// capture metrics
 	metricNet.Record(context.Background(), usage, attribute.String(attrKeyClass, string(bandwidthType)), attribute.String(attrKeyRes, resource))
 }
 
+// Pending returns the usage of the resource that
+// hasn't been written to the database yet.
+func (o *NetObserver) Pending(resource string, bandwidthType model.BandwidthType) int64 {
+	o.bucketSync.Lock()
+	defer o.bucketSync.Unlock()
+	return o.buckets[bandwidthType][resource] + o.flushing[bandwidthType][resource]
+}
+
+// take swaps out the current buckets so that they can
+// be flushed while new observations are collected.
+func (o *NetObserver) take() map[model.BandwidthType]map[string]int64 {
+	o.bucketSync.Lock()
+	defer o.bucketSync.Unlock()
+	buckets := o.buckets
+	o.buckets = map[model.BandwidthType]map[string]int64{}
+	// keep counting the usage as pending until
+	// it has been written
+	o.flushing = buckets
+	return buckets
+}
+
 func (o *NetObserver) flush() {
 	log := o.log
 	ctx := logr.NewContext(context.TODO(), log)
-	log.V(3).Info("flushing cache", "Buckets", len(o.buckets))
-	for t, v := range o.buckets {
+	buckets := o.take()
+	log.V(3).Info("flushing cache", "Buckets", len(buckets))
+	for t, v := range buckets {
 		for k, vv := range v {
 			_ = o.repo.Create(ctx, k, vv, t)
 		}
 	}
 	o.bucketSync.Lock()
-	o.buckets = map[model.BandwidthType]map[string]int64{}
+	o.flushing = nil
 	o.bucketSync.Unlock()
 }
@@ -0,0 +1,53 @@
+package quota
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"sync"
+	"testing"
+)
+
+func TestNetObserver_take(t *testing.T) {
+	o := &NetObserver{
+		log:     logr.Discard(),
+		buckets: map[model.BandwidthType]map[string]int64{},
+	}
+	var wg sync.WaitGroup
+	var total int64
+	var mu sync.Mutex
+	// observations made while buckets are being
+	// taken must end up in exactly one of them
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				o.Observe("remote::a", 1, model.BandwidthTypeNetworkA)
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		buckets := o.take()
+		mu.Lock()
+		total += buckets[model.BandwidthTypeNetworkA]["remote::a"]
+		mu.Unlock()
+	}
+	wg.Wait()
+	total += o.take()[model.BandwidthTypeNetworkA]["remote::a"]
+	assert.EqualValues(t, 1000, total)
+}
+
+func TestNetObserver_Pending(t *testing.T) {
+	o := &NetObserver{
+		log:     logr.Discard(),
+		buckets: map[model.BandwidthType]map[string]int64{},
+	}
+	o.Observe("remote::a", 10, model.BandwidthTypeNetworkA)
+	_ = o.take()
+	o.Observe("remote::a", 5, model.BandwidthTypeNetworkA)
+
+	// usage that is being flushed is still pending
+	assert.EqualValues(t, 15, o.Pending("remote::a", model.BandwidthTypeNetworkA))
+	assert.EqualValues(t, 0, o.Pending("remote::a", model.BandwidthTypeStorage))
+}
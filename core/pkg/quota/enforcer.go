@@ -0,0 +1,212 @@
+/*
+ *    Copyright 2022 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"go.opentelemetry.io/otel/attribute"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// refreshInterval is how often quotas and usage
+// are read back from the database.
+const refreshInterval = time.Second * 30
+
+// thresholds are the percentages of a quota
+// that record an event when they are crossed.
+var thresholds = []int64{80, 100}
+
+func NewEnforcer(ctx context.Context, repo *repo.BandwidthRepo, o Observer) *Enforcer {
+	log := logr.FromContextOrDiscard(ctx).WithName("enforcer")
+	log.V(2).Info("starting quota enforcer")
+	e := newEnforcer(repo, o)
+
+	go func() {
+		ctx := logr.NewContext(context.TODO(), log)
+		for {
+			e.refresh(ctx)
+			time.Sleep(refreshInterval)
+		}
+	}()
+
+	return e
+}
+
+func newEnforcer(repo *repo.BandwidthRepo, o Observer) *Enforcer {
+	return &Enforcer{
+		Observer: o,
+		repo:     repo,
+		now:      time.Now,
+		quotas:   map[string]*model.Quota{},
+		usage:    map[string]int64{},
+		notified: map[string]struct{}{},
+	}
+}
+
+func key(resource string, bandwidthType model.BandwidthType) string {
+	return filepath.Join(resource, string(bandwidthType))
+}
+
+// Observe records the usage and counts it towards
+// any quota that the resource has.
+func (e *Enforcer) Observe(resource string, usage int64, bandwidthType model.BandwidthType) {
+	e.Observer.Observe(resource, usage, bandwidthType)
+	k := key(resource, bandwidthType)
+	e.mu.Lock()
+	if _, ok := e.quotas[k]; ok {
+		e.usage[k] += usage
+	}
+	e.mu.Unlock()
+}
+
+// Allow returns an error if any of the resources have used
+// up their quota for one of the given types. Quotas that
+// fall back to cached data don't reject cached requests.
+func (e *Enforcer) Allow(ctx context.Context, resources []string, cached bool, types ...model.BandwidthType) error {
+	if e == nil {
+		return nil
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for _, res := range resources {
+		for _, t := range types {
+			k := key(res, t)
+			q, ok := e.quotas[k]
+			if !ok || e.usage[k] < q.Limit {
+				continue
+			}
+			var err error
+			switch q.Action {
+			case model.QuotaActionCacheOnly:
+				if cached {
+					continue
+				}
+				err = problem.New(http.StatusNotFound).Errorf("file is not cached and the %s quota of %s has been exceeded", t, res)
+			case model.QuotaActionInsufficientStorage:
+				err = problem.New(http.StatusInsufficientStorage).Errorf("%s quota of %s has been exceeded", t, res)
+			default:
+				err = problem.New(http.StatusTooManyRequests).Errorf("%s quota of %s has been exceeded", t, res)
+			}
+			logr.FromContextOrDiscard(ctx).V(1).Info("rejecting request as quota has been exceeded", "Resource", res, "Type", t, "Action", q.Action)
+			metricRejected.Add(ctx, 1, attribute.String(attrKeyClass, string(t)), attribute.String(attrKeyRes, res))
+			return err
+		}
+	}
+	return nil
+}
+
+// update replaces the quotas and the usage counted
+// towards them. Usage that the Observer hasn't
+// written to the database yet is added on top.
+func (e *Enforcer) update(quotas []*model.Quota, usage []*model.BandwidthUsage) {
+	q := make(map[string]*model.Quota, len(quotas))
+	for _, v := range quotas {
+		q[key(v.Resource, v.Type)] = v
+	}
+	u := make(map[string]int64, len(usage))
+	for _, v := range usage {
+		u[key(v.Resource, v.Type)] = v.Usage
+	}
+	pending, _ := e.Observer.(PendingObserver)
+	// hold the lock while reading the pending usage so
+	// that observations made in the meantime aren't
+	// lost (they may be counted twice until the next
+	// refresh, which errs on the side of the quota)
+	e.mu.Lock()
+	if pending != nil {
+		for k, v := range q {
+			u[k] += pending.Pending(v.Resource, v.Type)
+		}
+	}
+	e.quotas = q
+	e.usage = u
+	e.mu.Unlock()
+}
+
+func (e *Enforcer) refresh(ctx context.Context) {
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(3).Info("refreshing quotas")
+	quotas, err := e.repo.ListQuotas(ctx, "")
+	if err != nil {
+		return
+	}
+	var resources []string
+	seen := map[string]struct{}{}
+	for _, q := range quotas {
+		if _, ok := seen[q.Resource]; ok {
+			continue
+		}
+		seen[q.Resource] = struct{}{}
+		resources = append(resources, q.Resource)
+	}
+	date := e.now().Format("200601")
+	usage, err := e.repo.GetUsage(ctx, date, resources)
+	if err != nil {
+		return
+	}
+	e.update(quotas, usage)
+	e.notify(ctx, date, quotas)
+}
+
+// notify records an event for each threshold that a
+// quota has crossed this month. Events are only
+// recorded once, regardless of the number of replicas.
+func (e *Enforcer) notify(ctx context.Context, date string, quotas []*model.Quota) {
+	log := logr.FromContextOrDiscard(ctx)
+	for _, q := range quotas {
+		e.mu.RLock()
+		usage := e.usage[key(q.Resource, q.Type)]
+		e.mu.RUnlock()
+		for _, t := range thresholds {
+			if usage*100 < q.Limit*t {
+				continue
+			}
+			id := filepath.Join(date, q.Resource, string(q.Type), strconv.FormatInt(t, 10))
+			if _, ok := e.notified[id]; ok {
+				continue
+			}
+			created, err := e.repo.CreateQuotaEvent(ctx, &model.QuotaEvent{
+				ID:        id,
+				CreatedAt: e.now().Unix(),
+				Date:      date,
+				Resource:  q.Resource,
+				Type:      q.Type,
+				Threshold: t,
+				Usage:     usage,
+				Limit:     q.Limit,
+			})
+			if err != nil {
+				continue
+			}
+			e.notified[id] = struct{}{}
+			if !created {
+				continue
+			}
+			log.Info(fmt.Sprintf("resource has used %d%% of its quota", t), "Resource", q.Resource, "Type", q.Type, "Usage", usage, "Limit", q.Limit)
+			metricThreshold.Add(ctx, 1, attribute.String(attrKeyClass, string(q.Type)), attribute.String(attrKeyRes, q.Resource), attribute.Int64(attrKeyThreshold, t))
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package quota
+
+import (
+	"context"
+	"github.com/lpar/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"net/http"
+	"testing"
+)
+
+func TestEnforcer_Allow(t *testing.T) {
+	e := newEnforcer(nil, &NoopObserver{})
+	e.update([]*model.Quota{
+		{Resource: "remote::a", Type: model.BandwidthTypeNetworkA, Limit: 100, Action: model.QuotaActionTooManyRequests},
+		{Resource: "remote::a", Type: model.BandwidthTypeStorage, Limit: 100, Action: model.QuotaActionInsufficientStorage},
+		{Resource: "refraction::b", Type: model.BandwidthTypeNetworkA, Limit: 100, Action: model.QuotaActionCacheOnly},
+	}, []*model.BandwidthUsage{
+		{Resource: "remote::a", Type: model.BandwidthTypeNetworkA, Usage: 50},
+	})
+
+	var cases = []struct {
+		name      string
+		resources []string
+		cached    bool
+		types     []model.BandwidthType
+		code      int
+	}{
+		{
+			"no quota",
+			[]string{"remote::c"},
+			false,
+			[]model.BandwidthType{model.BandwidthTypeNetworkA},
+			0,
+		},
+		{
+			"network exceeded",
+			[]string{"remote::c", "remote::a"},
+			false,
+			[]model.BandwidthType{model.BandwidthTypeNetworkA},
+			http.StatusTooManyRequests,
+		},
+		{
+			"storage exceeded",
+			[]string{"remote::a"},
+			false,
+			[]model.BandwidthType{model.BandwidthTypeStorage},
+			http.StatusInsufficientStorage,
+		},
+		{
+			"cache only serves cached data",
+			[]string{"refraction::b"},
+			true,
+			[]model.BandwidthType{model.BandwidthTypeNetworkA},
+			0,
+		},
+		{
+			"cache only rejects uncached data",
+			[]string{"refraction::b"},
+			false,
+			[]model.BandwidthType{model.BandwidthTypeNetworkA},
+			http.StatusNotFound,
+		},
+	}
+	// usage is below every quota
+	assert.NoError(t, e.Allow(context.TODO(), []string{"remote::a", "refraction::b"}, false, model.BandwidthTypeNetworkA, model.BandwidthTypeStorage))
+
+	// push the usage over each quota
+	e.Observe("remote::a", 50, model.BandwidthTypeNetworkA)
+	e.Observe("remote::a", 100, model.BandwidthTypeStorage)
+	e.Observe("refraction::b", 100, model.BandwidthTypeNetworkA)
+	e.Observe("remote::c", 1000, model.BandwidthTypeNetworkA)
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := e.Allow(context.TODO(), tt.resources, tt.cached, tt.types...)
+			if tt.code == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var httpErr problem.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.EqualValues(t, tt.code, httpErr.GetStatus())
+		})
+	}
+	// usage of resources without a
+	// quota isn't kept
+	assert.NotContains(t, e.usage, key("remote::c", model.BandwidthTypeNetworkA))
+
+	// a nil enforcer allows everything
+	var nilEnforcer *Enforcer
+	assert.NoError(t, nilEnforcer.Allow(context.TODO(), []string{"remote::a"}, false, model.BandwidthTypeNetworkA))
+}
+
+// pendingObserver holds usage that
+// hasn't been written yet.
+type pendingObserver struct {
+	NoopObserver
+	pending map[string]int64
+}
+
+func (o *pendingObserver) Pending(resource string, bandwidthType model.BandwidthType) int64 {
+	return o.pending[key(resource, bandwidthType)]
+}
+
+func TestEnforcer_update(t *testing.T) {
+	o := &pendingObserver{pending: map[string]int64{
+		key("remote::a", model.BandwidthTypeNetworkA): 60,
+	}}
+	e := newEnforcer(nil, o)
+	e.update([]*model.Quota{
+		{Resource: "remote::a", Type: model.BandwidthTypeNetworkA, Limit: 100, Action: model.QuotaActionTooManyRequests},
+	}, []*model.BandwidthUsage{
+		{Resource: "remote::a", Type: model.BandwidthTypeNetworkA, Usage: 50},
+	})
+	// usage that hasn't been written to the
+	// database yet is still counted
+	assert.EqualValues(t, 110, e.usage[key("remote::a", model.BandwidthTypeNetworkA)])
+	assert.Error(t, e.Allow(context.TODO(), []string{"remote::a"}, false, model.BandwidthTypeNetworkA))
+}
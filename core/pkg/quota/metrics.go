@@ -30,9 +30,22 @@ var (
 		instrument.WithUnit(unit.Bytes),
 		instrument.WithDescription("Measures the total number of bytes transferred."),
 	)
+	metricRejected, _ = meter.SyncInt64().Counter(
+		"prism.core.quota.rejected.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total requests that were rejected because a quota was exceeded."),
+	)
+	metricThreshold, _ = meter.SyncInt64().Counter(
+		"prism.core.quota.threshold.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total times that a resource crossed a threshold of its quota."),
+	)
 )
 
 const (
 	attrKeyClass = "quota.class"
 	attrKeyRes   = "quota.resource"
+	// attrKeyThreshold is the percentage
+	// of the quota that was crossed
+	attrKeyThreshold = "quota.threshold"
 )
@@ -18,20 +18,62 @@
 package quota
 
 import (
+	"context"
 	"github.com/go-logr/logr"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
 	"sync"
+	"time"
 )
 
 type NetObserver struct {
 	repo *repo.BandwidthRepo
 	log  logr.Logger
 
-	buckets    map[model.BandwidthType]map[string]int64
+	buckets map[model.BandwidthType]map[string]int64
+	// flushing holds the buckets that are
+	// being written to the database
+	flushing   map[model.BandwidthType]map[string]int64
 	bucketSync sync.Mutex
 }
 
 type Observer interface {
 	Observe(resource string, usage int64, bandwidthType model.BandwidthType)
 }
+
+// PendingObserver is an Observer that writes usage
+// to the database in batches, so that the usage
+// that it holds can be counted before it has
+// been written.
+type PendingObserver interface {
+	Observer
+	Pending(resource string, bandwidthType model.BandwidthType) int64
+}
+
+// Limiter decides whether a request is
+// allowed to use more bandwidth.
+type Limiter interface {
+	Allow(ctx context.Context, resources []string, cached bool, types ...model.BandwidthType) error
+}
+
+// Enforcer records bandwidth using an Observer and
+// rejects requests from resources that have used
+// up their quota.
+//
+// Usage is periodically read from the database so
+// that every replica is counted, along with any
+// usage that this replica hasn't written yet.
+// Requests that are in-flight may take a resource
+// over its quota.
+type Enforcer struct {
+	Observer
+	repo *repo.BandwidthRepo
+	now  func() time.Time
+
+	mu     sync.RWMutex
+	quotas map[string]*model.Quota
+	usage  map[string]int64
+	// notified holds the threshold events
+	// that have already been recorded
+	notified map[string]struct{}
+}
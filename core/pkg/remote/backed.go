@@ -18,19 +18,20 @@
 package remote
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"github.com/djcass44/go-utils/utilities/sliceutils"
 	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
 	"gitlab.com/go-prism/prism3/core/internal/partition"
 	"gitlab.com/go-prism/prism3/core/internal/policy"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
 	"gitlab.com/go-prism/prism3/core/pkg/httpclient"
+	"gitlab.com/go-prism/prism3/core/pkg/quarantine"
 	"gitlab.com/go-prism/prism3/core/pkg/quota"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
 	"gitlab.com/go-prism/prism3/core/pkg/storage"
@@ -39,9 +40,11 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"io"
+	"net/http"
 	"net/url"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 var partitions = []partition.Partition{
@@ -52,29 +55,57 @@ type BackedRemote struct {
 	rm          *model.Remote
 	eph         Remote
 	onCreate    repo.CreateArtifactFunc
+	onDigest    repo.SetDigestFunc
 	pol         policy.Enforcer
 	store       storage.Reader
 	netObserver quota.Observer
+	flight      *Coalescer
+	// offline stops files from being fetched
+	// from upstream on a cache miss
+	offline bool
+	// resources that bandwidth is counted
+	// towards (e.g., "remote::<id>")
+	resources []string
+	// advisories decides what happens to package
+	// versions that are known to be vulnerable
+	advisories *advisory.Policy
+	// quarantine refuses package versions that
+	// were published too recently
+	quarantine *quarantine.Downloads
 }
 
-func NewBackedRemote(ctx context.Context, rm *model.Remote, store storage.Reader, netObserver quota.Observer, onCreate repo.CreateArtifactFunc, getPyPi, getHelm repo.GetPackageFunc) *BackedRemote {
-	client := httpclient.GetConfigured(ctx, rm.Transport)
+func NewBackedRemote(ctx context.Context, rm *model.Remote, store storage.Reader, netObserver quota.Observer, flight *Coalescer, health *Health, onCreate repo.CreateArtifactFunc, onDigest repo.SetDigestFunc, getPyPi, getHelm repo.GetPackageFunc) *BackedRemote {
 	var eph Remote
-	switch rm.Archetype {
-	case model.ArchetypeHelm:
-		eph = NewHelmRemote(ctx, rm.URI, client, getHelm)
-	case model.ArchetypePip:
-		eph = NewPyPiRemote(ctx, rm.URI, client, getPyPi)
+	switch {
+	case rm.Hosted:
+		eph = NewHostedRemote(rm.Name, rm.Archetype, store)
+	case rm.Archetype == model.ArchetypeHelm:
+		h := NewHelmRemote(ctx, rm.URI, httpclient.GetConfigured(ctx, rm.Transport), getHelm)
+		h.rem.breaker = health.Breaker(rm)
+		eph = h
+	case rm.Archetype == model.ArchetypePip:
+		p := NewPyPiRemote(ctx, rm.URI, httpclient.GetConfigured(ctx, rm.Transport), getPyPi)
+		p.rem.breaker = health.Breaker(rm)
+		eph = p
+	case rm.Archetype == model.ArchetypeOci:
+		o := NewOCIRemote(ctx, rm.URI, httpclient.GetConfigured(ctx, rm.Transport))
+		o.breaker = health.Breaker(rm)
+		eph = o
 	default:
-		eph = NewEphemeralRemote(ctx, rm.URI, client)
+		e := NewEphemeralRemote(ctx, rm.URI, httpclient.GetConfigured(ctx, rm.Transport))
+		e.breaker = health.Breaker(rm)
+		eph = e
 	}
 	return &BackedRemote{
 		rm:          rm,
 		eph:         eph,
 		onCreate:    onCreate,
-		pol:         policy.NewRegexEnforcer(ctx, rm),
+		onDigest:    onDigest,
+		pol:         policy.NewRemoteEnforcer(ctx, rm),
 		store:       store,
 		netObserver: netObserver,
+		flight:      flight,
+		resources:   []string{fmt.Sprintf("%s::%s", repo.ResourceRemote, rm.ID)},
 	}
 }
 
@@ -86,6 +117,75 @@ func (b *BackedRemote) Model() *model.Remote {
 	return b.rm
 }
 
+// Timeout returns how long the remote
+// should be given to answer a probe.
+func (b *BackedRemote) Timeout() time.Duration {
+	return time.Duration(b.rm.Timeout) * time.Second
+}
+
+// SetOffline stops the remote from contacting its
+// upstream, so that only files that have already
+// been cached can be served.
+func (b *BackedRemote) SetOffline(offline bool) {
+	b.offline = offline
+}
+
+// SetRefraction counts the bandwidth used by the
+// remote towards the quotas of the Refraction.
+func (b *BackedRemote) SetRefraction(id string) {
+	b.resources = append(b.resources, fmt.Sprintf("%s::%s", repo.ResourceRefraction, id))
+}
+
+// SetQuarantine applies the quarantine
+// of the Refraction to downloads.
+func (b *BackedRemote) SetQuarantine(d *quarantine.Downloads) {
+	b.quarantine = d
+}
+
+// SetAdvisories applies the vulnerability
+// policy of the Refraction to downloads.
+func (b *BackedRemote) SetAdvisories(pol *advisory.Policy) {
+	b.advisories = pol
+}
+
+// observe records bandwidth against every
+// resource that the remote belongs to.
+func (b *BackedRemote) observe(usage int64, bandwidthType model.BandwidthType) {
+	for _, res := range b.resources {
+		b.netObserver.Observe(res, usage, bandwidthType)
+	}
+}
+
+// allow returns an error if the request would use
+// bandwidth that has exceeded its quota.
+func (b *BackedRemote) allow(ctx context.Context, cached bool, types ...model.BandwidthType) error {
+	l, ok := b.netObserver.(quota.Limiter)
+	if !ok {
+		return nil
+	}
+	return l.Allow(ctx, b.resources, cached, types...)
+}
+
+// upstreamTypes returns the types of bandwidth
+// used by fetching a file from upstream.
+func (b *BackedRemote) upstreamTypes(canCache bool) []model.BandwidthType {
+	if canCache {
+		return []model.BandwidthType{model.BandwidthTypeNetworkA, model.BandwidthTypeStorage}
+	}
+	return []model.BandwidthType{model.BandwidthTypeNetworkA}
+}
+
+// checkOffline returns an error if a file would need
+// to be fetched from upstream while the remote is offline.
+func (b *BackedRemote) checkOffline(ctx context.Context) error {
+	if !b.offline || b.rm.Hosted {
+		return nil
+	}
+	metricBackedOffline.Add(ctx, 1)
+	logr.FromContextOrDiscard(ctx).V(1).Info("refusing to contact upstream as the remote is offline", "Remote", b.rm.Name)
+	return problem.New(http.StatusNotFound).Errorf("file is not available offline")
+}
+
 func (b *BackedRemote) validateContext(ctx context.Context, rctx *schemas.RequestContext) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_backed_validateContext")
 	defer span.End()
@@ -158,12 +258,21 @@ func (b *BackedRemote) Exists(ctx context.Context, path string, rctx *schemas.Re
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path)
 	// check that this remote is allowed to receive the file
-	if !b.pol.CanReceive(ctx, path) {
-		return "", errors.New("blocked by policy")
+	if !b.pol.CanReceive(ctx, path, rctx) {
+		// the file can never come from this remote, so
+		// treat it as missing to let ordered refractions
+		// move on to the next remote
+		return "", problem.New(http.StatusNotFound).Errorf("blocked by policy")
+	}
+	if err := b.advisories.Check(ctx, path); err != nil {
+		return "", err
+	}
+	if err := b.quarantine.Check(ctx, path); err != nil {
+		return "", err
 	}
 	b.validateContext(ctx, rctx)
 	uploadPath, normalPath := b.getPath(ctx, path, rctx)
-	canCache := b.pol.CanCache(ctx, path)
+	canCache := b.canCache(ctx, path)
 	log = log.WithValues("Cache", canCache, "PathNormal", normalPath, "PathStore", uploadPath)
 	span.SetAttributes(
 		attribute.Bool("can_cache", canCache),
@@ -176,12 +285,23 @@ func (b *BackedRemote) Exists(ctx context.Context, path string, rctx *schemas.Re
 		if ok {
 			metricBackedCache.Add(ctx, 1, attribute.String(attributeCacheKey, cacheHit))
 			log.V(1).Info("located existing file in cache")
+			if err := b.allow(ctx, true, model.BandwidthTypeNetworkB); err != nil {
+				return "", err
+			}
 			return path, nil
 		}
 		metricBackedCache.Add(ctx, 1, attribute.String(attributeCacheKey, cacheMiss))
 	} else {
 		metricBackedCache.Add(ctx, 1, attribute.String(attributeCacheKey, cacheBypass))
 	}
+	if err := b.checkOffline(ctx); err != nil {
+		return "", err
+	}
+	if !b.rm.Hosted {
+		if err := b.allow(ctx, false, b.upstreamTypes(canCache)...); err != nil {
+			return "", err
+		}
+	}
 	// HEAD the remote
 	uri, err := b.eph.Exists(ctx, path, rctx)
 	if err != nil {
@@ -194,16 +314,28 @@ func (b *BackedRemote) Exists(ctx context.Context, path string, rctx *schemas.Re
 	return uri, nil
 }
 
-func (b *BackedRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+func (b *BackedRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_backed_download", trace.WithAttributes(attribute.String("path", path)))
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path)
 	log.V(2).Info("using request context", "RequestContext", rctx)
 
+	// remove the digest that upstream declared
+	// for the file so that we can check it
+	path, want := b.getDigest(path)
+	if want != nil {
+		log = log.WithValues("Digest", want.String())
+		span.SetAttributes(attribute.String("digest", want.String()))
+	}
+
+	// keep the context that the client sent, since it
+	// may be replaced by the credentials of the remote
+	// and the policy must only see the client's
+	clientCtx := rctx.Clone()
 	b.validateContext(ctx, rctx)
 
 	log.V(2).Info("using final request context", "RequestContext", rctx)
-	canCache := b.pol.CanCache(ctx, path)
+	canCache := b.canCache(ctx, path)
 	uploadPath, normalPath := b.getPath(ctx, path, rctx)
 	log = log.WithValues("Cache", canCache, "PathNormal", normalPath, "PathStore", uploadPath)
 	span.SetAttributes(
@@ -211,47 +343,180 @@ func (b *BackedRemote) Download(ctx context.Context, path string, rctx *schemas.
 		attribute.String("path_normal", normalPath),
 		attribute.String("path_store", uploadPath),
 	)
+	// check that this remote is allowed to receive the file.
+	// Files found in an upstream index are downloaded by their
+	// URL, so the policy is applied to the path within it.
+	if !b.pol.CanReceive(ctx, normalPath, clientCtx) {
+		return nil, problem.New(http.StatusNotFound).Errorf("blocked by policy")
+	}
+	if err := b.advisories.Check(ctx, normalPath); err != nil {
+		return nil, err
+	}
+	if err := b.quarantine.Check(ctx, normalPath); err != nil {
+		return nil, err
+	}
 	// check the cache first
+	var release func()
 	if canCache {
 		log.V(1).Info("checking cache for existing file")
 		ok, _ := b.store.Head(ctx, uploadPath)
 		if ok {
 			metricBackedCache.Add(ctx, 1, attribute.String(attributeCacheKey, cacheHit))
 			log.V(1).Info("located existing file in cache")
-			_ = b.onCreate(ctx, normalPath, b.rm.ID)
-			r, s, err := b.store.Get(ctx, uploadPath)
-			if s > 0 {
-				b.netObserver.Observe(fmt.Sprintf("remote::%s", b.rm.ID), s, model.BandwidthTypeNetworkB)
+			if err := b.allow(ctx, true, model.BandwidthTypeNetworkB); err != nil {
+				return nil, err
 			}
-			return r, err
+			return b.fromCache(ctx, uploadPath, normalPath)
 		}
 		metricBackedCache.Add(ctx, 1, attribute.String(attributeCacheKey, cacheMiss))
+		if err := b.checkOffline(ctx); err != nil {
+			return nil, err
+		}
+		if err := b.allow(ctx, false, b.upstreamTypes(true)...); err != nil {
+			return nil, err
+		}
+		// make sure that only one request downloads
+		// the file, so we don't hammer the upstream
+		// when lots of clients ask for it at once
+		if b.flight != nil {
+			var hit bool
+			var err error
+			release, hit, err = b.flight.Acquire(ctx, uploadPath, func(ctx context.Context) bool {
+				ok, _ := b.store.Head(ctx, uploadPath)
+				return ok
+			})
+			if err != nil {
+				return nil, err
+			}
+			if hit {
+				log.V(1).Info("located file in cache after waiting for in-flight request")
+				return b.fromCache(ctx, uploadPath, normalPath)
+			}
+		}
 	} else {
 		metricBackedCache.Add(ctx, 1, attribute.String(attributeCacheKey, cacheBypass))
+		if err := b.checkOffline(ctx); err != nil {
+			return nil, err
+		}
+		if !b.rm.Hosted {
+			if err := b.allow(ctx, false, b.upstreamTypes(false)...); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	r, err := b.eph.Download(ctx, path, rctx)
 	if err != nil {
+		if release != nil {
+			release()
+		}
 		return nil, err
 	}
+	if b.rm.Hosted {
+		_ = b.onCreate(ctx, normalPath, b.rm.ID)
+		return r, nil
+	}
 	// check that this remote is allowed to cache the file
 	if canCache {
 		log.V(1).Info("preparing to upload to cache")
-		_ = b.onCreate(ctx, normalPath, b.rm.ID)
-		buf := new(bytes.Buffer)
-		// duplicate the data, so we can upload it
-		// to storage and return it to the user
-		tee := io.TeeReader(r, buf)
-		// upload to storage
-		_ = b.store.Put(ctx, uploadPath, tee)
-		b.netObserver.Observe(fmt.Sprintf("remote::%s", b.rm.ID), int64(buf.Len()), model.BandwidthTypeNetworkA)
-		b.netObserver.Observe(fmt.Sprintf("remote::%s", b.rm.ID), int64(buf.Len()), model.BandwidthTypeStorage)
-		log.V(2).Info("successfully uploaded data to cache", "Count", buf.Len())
-		return buf, nil
+		// upload to storage while the
+		// user is receiving the file
+		fill, err := newCacheFill(ctx, r, uploadPath, b.store, want, func(n int64, sum string) {
+			_ = b.onCreate(ctx, normalPath, b.rm.ID)
+			_ = b.onDigest(ctx, normalPath, b.rm.ID, sum)
+			b.observe(n, model.BandwidthTypeNetworkA)
+			b.observe(n, model.BandwidthTypeStorage)
+		}, release)
+		if err != nil {
+			// the client can still receive
+			// the file, it just won't be cached
+			log.Error(err, "failed to prepare upload to cache")
+			if release != nil {
+				release()
+			}
+			return r, nil
+		}
+		return fill, nil
 	}
 	return r, nil
 }
 
+// Open returns the stored copy of a file without
+// reading it or contacting the upstream.
+func (b *BackedRemote) Open(ctx context.Context, path string, rctx *schemas.RequestContext) (*storage.Object, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_backed_open", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	path, _ = b.getDigest(path)
+	if hosted, ok := b.eph.(*HostedRemote); ok {
+		return storage.Open(ctx, hosted.store, hosted.key(path))
+	}
+	if !b.canCache(ctx, path) {
+		return nil, problem.New(http.StatusNotFound).Errorf("file is not stored by this remote")
+	}
+	b.validateContext(ctx, rctx)
+	uploadPath, _ := b.getPath(ctx, path, rctx)
+	return storage.Open(ctx, b.store, uploadPath)
+}
+
+// getDigest returns the digest that upstream declared
+// for a file. PyPI (and Helm) files carry it in the
+// fragment of their URL, and OCI blobs are addressed
+// by their digest.
+func (b *BackedRemote) getDigest(path string) (string, *Digest) {
+	path, want := SplitDigest(path)
+	if want != nil || b.rm.Archetype != model.ArchetypeOci {
+		return path, want
+	}
+	if digest, ok := BlobDigest(path); ok {
+		want, _ = ParseDigest(strings.Replace(digest, ":", "=", 1))
+	}
+	return path, want
+}
+
+// fromCache opens a file from storage. The object is
+// opened lazily so that the gateway can serve partial
+// content.
+func (b *BackedRemote) fromCache(ctx context.Context, uploadPath, normalPath string) (io.ReadCloser, error) {
+	_ = b.onCreate(ctx, normalPath, b.rm.ID)
+	obj, err := storage.Open(ctx, b.store, uploadPath)
+	if err != nil {
+		return nil, err
+	}
+	if s := obj.Info().Size; s > 0 {
+		b.observe(s, model.BandwidthTypeNetworkB)
+	}
+	return obj, nil
+}
+
+// Upload saves a file to the remote. Only
+// hosted remotes are able to receive uploads.
+func (b *BackedRemote) Upload(ctx context.Context, path string, r io.Reader) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_backed_upload", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path, "Remote", b.rm.Name)
+	hosted, ok := b.eph.(*HostedRemote)
+	if !ok {
+		log.Info("rejecting upload as remote is not hosted")
+		return problem.New(http.StatusMethodNotAllowed).Errorf("remote does not accept uploads")
+	}
+	// check that this remote is allowed to receive the file
+	if !b.pol.CanReceive(ctx, path, nil) {
+		log.Info("rejecting upload as it is blocked by policy")
+		return problem.New(http.StatusForbidden).Errorf("blocked by policy")
+	}
+	return hosted.Upload(ctx, path, r)
+}
+
+// canCache returns true if a file should be copied into
+// storage. Hosted remotes already live in storage, so
+// there is nothing to cache.
+func (b *BackedRemote) canCache(ctx context.Context, path string) bool {
+	if b.rm.Hosted {
+		return false
+	}
+	return b.pol.CanCache(ctx, path)
+}
+
 func (b *BackedRemote) getPath(ctx context.Context, path string, rctx *schemas.RequestContext) (string, string) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_backed_getPath")
 	defer span.End()
@@ -266,10 +531,17 @@ func (b *BackedRemote) getPath(ctx context.Context, path string, rctx *schemas.R
 			uploadPath = strings.TrimPrefix(uri.Path, "/")
 		}
 	}
+	// blobs are content-addressable, so they can be
+	// shared between all the images in the registry
+	if b.rm.Archetype == model.ArchetypeOci {
+		if digest, ok := BlobDigest(uploadPath); ok {
+			uploadPath = filepath.Join(OCIBlobs, digest)
+		}
+	}
 	// keep a copy of the path without any partition
 	// information, so we can update the database correctly (see #31)
 	normalPath := uploadPath
-	// create the cache partition by appending
+	// create the cache partition from
 	// the hash of the token
 	if rctx.Mode != httpclient.AuthNone && rctx.Token != "" {
 		// use the partition ID if present
@@ -282,7 +554,7 @@ func (b *BackedRemote) getPath(ctx context.Context, path string, rctx *schemas.R
 		partId = hash(partId)
 		log.V(1).Info("creating partition", "PartitionHash", partId, "PartitionID", rctx.PartitionID)
 		span.SetAttributes(attribute.String(attributeAuthPartitionHash, partId))
-		uploadPath = filepath.Join(uploadPath, partId)
+		uploadPath = storage.PartitionPath(uploadPath, partId)
 	}
 	log.V(1).Info("normalised path", "UploadPath", uploadPath, "NormalPath", normalPath)
 	return filepath.Join(b.rm.Name, uploadPath), normalPath
@@ -22,8 +22,11 @@ import (
 	_ "embed"
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
+	"github.com/lpar/problem"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/advisory"
 	"gitlab.com/go-prism/prism3/core/pkg/httpclient"
 	"gitlab.com/go-prism/prism3/core/pkg/quota"
 	"gitlab.com/go-prism/prism3/core/pkg/schemas"
@@ -31,6 +34,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 )
 
@@ -45,7 +49,9 @@ func TestBackedRemote_Exists(t *testing.T) {
 		Security: &model.RemoteSecurity{
 			Blocked: []string{"^/?(super-secret).+"},
 		},
-	}, storage.NewNoOp(), &quota.NoopObserver{}, func(ctx context.Context, path, remote string) error {
+	}, storage.NewNoOp(), &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
 		return nil
 	}, getPkg, getPkg)
 
@@ -83,7 +89,9 @@ func TestBackedRemote_Download(t *testing.T) {
 		URI:       ts.URL,
 		Security:  &model.RemoteSecurity{},
 		Archetype: model.ArchetypeGeneric,
-	}, store, &quota.NoopObserver{}, func(ctx context.Context, path, remote string) error {
+	}, store, &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
 		return nil
 	}, getPkg, getPkg)
 
@@ -138,7 +146,9 @@ func TestBackedRemote_DownloadDirect(t *testing.T) {
 			DirectToken:  token,
 		},
 		Archetype: model.ArchetypeGeneric,
-	}, store, &quota.NoopObserver{}, func(ctx context.Context, path, remote string) error {
+	}, store, &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
 		return nil
 	}, getPkg, getPkg)
 
@@ -160,3 +170,270 @@ func TestBackedRemote_DownloadDirect(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestBackedRemote_Partitions(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(dummyFile))
+	}))
+	defer ts.Close()
+
+	store := storage.NewNoOp()
+	rem := NewBackedRemote(ctx, &model.Remote{
+		Name:      "generic",
+		URI:       ts.URL,
+		Security:  &model.RemoteSecurity{},
+		Archetype: model.ArchetypeGeneric,
+	}, store, &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+
+	for _, rctx := range []*schemas.RequestContext{
+		{},
+		{AuthOpts: httpclient.AuthOpts{Mode: httpclient.AuthAuthorization, Token: "hunter2"}},
+	} {
+		resp, err := rem.Download(ctx, "/file.txt", rctx)
+		require.NoError(t, err)
+		_, err = io.ReadAll(resp)
+		assert.NoError(t, err)
+		assert.NoError(t, resp.Close())
+	}
+	// the partition isn't nested beneath the
+	// unpartitioned file
+	assert.Contains(t, store.Data, "generic/file.txt")
+	assert.Contains(t, store.Data, "generic/.partitions/"+hash("hunter2")+"/file.txt")
+}
+
+func TestBackedRemote_Offline(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	var count atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(dummyFile))
+	}))
+	defer ts.Close()
+
+	store := storage.NewNoOp()
+	store.Data["generic/cached.txt"] = []byte(dummyFile)
+	rem := NewBackedRemote(ctx, &model.Remote{
+		Name:      "generic",
+		URI:       ts.URL,
+		Security:  &model.RemoteSecurity{},
+		Archetype: model.ArchetypeGeneric,
+	}, store, &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+	rem.SetOffline(true)
+
+	t.Run("cached file is served", func(t *testing.T) {
+		resp, err := rem.Download(ctx, "/cached.txt", &schemas.RequestContext{})
+		require.NoError(t, err)
+		data, err := io.ReadAll(resp)
+		assert.NoError(t, err)
+		assert.EqualValues(t, dummyFile, string(data))
+
+		_, err = rem.Exists(ctx, "/cached.txt", &schemas.RequestContext{})
+		assert.NoError(t, err)
+	})
+	t.Run("uncached file is not fetched", func(t *testing.T) {
+		_, err := rem.Download(ctx, "/file.txt", &schemas.RequestContext{})
+		var httpErr problem.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.EqualValues(t, http.StatusNotFound, httpErr.GetStatus())
+
+		_, err = rem.Exists(ctx, "/file.txt", &schemas.RequestContext{})
+		assert.Error(t, err)
+	})
+	assert.EqualValues(t, 0, count.Load())
+}
+
+func TestBackedRemote_Policy(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	var count atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(dummyFile))
+	}))
+	defer ts.Close()
+
+	store := storage.NewNoOp()
+	store.Data["generic/cached.txt"] = []byte(dummyFile)
+	rem := NewBackedRemote(ctx, &model.Remote{
+		Name: "generic",
+		URI:  ts.URL,
+		Security: &model.RemoteSecurity{
+			Blocked: []string{".txt$"},
+		},
+		Archetype: model.ArchetypeGeneric,
+	}, store, &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+
+	for _, path := range []string{"/cached.txt", "/file.txt", ts.URL + "/file.txt"} {
+		t.Run(path, func(t *testing.T) {
+			_, err := rem.Download(ctx, path, &schemas.RequestContext{})
+			var httpErr problem.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.EqualValues(t, http.StatusNotFound, httpErr.GetStatus())
+
+			_, err = rem.Exists(ctx, path, &schemas.RequestContext{})
+			assert.Error(t, err)
+		})
+	}
+	assert.EqualValues(t, 0, count.Load())
+}
+
+func TestBackedRemote_PolicyDirect(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(dummyFile))
+	}))
+	defer ts.Close()
+
+	rem := NewBackedRemote(ctx, &model.Remote{
+		Name: "generic",
+		URI:  ts.URL,
+		Security: &model.RemoteSecurity{
+			AuthMode:     model.AuthModeDirect,
+			DirectHeader: "Authorization",
+			DirectToken:  "hunter2",
+			Rules:        []string{`header == "Private-Token"`},
+		},
+		Archetype: model.ArchetypeGeneric,
+	}, storage.NewNoOp(), &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+
+	// the rule must see the header that the client
+	// sent rather than the one used by the remote
+	var cases = []struct {
+		name   string
+		header string
+		ok     bool
+	}{
+		{"matching header", "Private-Token", true},
+		{"other header", "Authorization", false},
+		{"no header", "", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			rctx := func() *schemas.RequestContext {
+				if tt.header == "" {
+					return &schemas.RequestContext{}
+				}
+				return &schemas.RequestContext{AuthOpts: httpclient.AuthOpts{Mode: httpclient.AuthHeader, Header: tt.header, Token: "foobar"}}
+			}
+			_, err := rem.Download(ctx, "/file.txt", rctx())
+			_, existsErr := rem.Exists(ctx, "/file.txt", rctx())
+			if tt.ok {
+				assert.NoError(t, err)
+				assert.NoError(t, existsErr)
+				return
+			}
+			var httpErr problem.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.EqualValues(t, http.StatusNotFound, httpErr.GetStatus())
+			assert.Error(t, existsErr)
+		})
+	}
+}
+
+func TestBackedRemote_Advisories(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	var count atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(dummyFile))
+	}))
+	defer ts.Close()
+
+	rem := NewBackedRemote(ctx, &model.Remote{
+		Name:      "npm",
+		URI:       ts.URL,
+		Security:  &model.RemoteSecurity{},
+		Archetype: model.ArchetypeNpm,
+	}, storage.NewNoOp(), &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+	rem.SetAdvisories(advisory.New(model.ArchetypeNpm, model.VulnerabilityActionBlock, func(ctx context.Context, ecosystem string, names ...string) ([]*schemas.AdvisoryPackage, error) {
+		return []*schemas.AdvisoryPackage{{
+			AdvisoryID: "GHSA-1234",
+			Ecosystem:  ecosystem,
+			Name:       "lodash",
+			Affected:   []byte(`{"versions": ["4.17.20"]}`),
+		}}, nil
+	}))
+
+	// files found in a package document are
+	// downloaded by their URL
+	_, err := rem.Download(ctx, ts.URL+"/lodash/-/lodash-4.17.20.tgz", &schemas.RequestContext{})
+	var httpErr problem.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.EqualValues(t, http.StatusForbidden, httpErr.GetStatus())
+	assert.EqualValues(t, 0, count.Load())
+
+	resp, err := rem.Download(ctx, ts.URL+"/lodash/-/lodash-4.17.21.tgz", &schemas.RequestContext{})
+	require.NoError(t, err)
+	_ = resp.Close()
+}
+
+// limitObserver rejects every request
+// that isn't served from the cache.
+type limitObserver struct {
+	quota.NoopObserver
+	resources []string
+}
+
+func (l *limitObserver) Allow(_ context.Context, resources []string, cached bool, _ ...model.BandwidthType) error {
+	l.resources = resources
+	if cached {
+		return nil
+	}
+	return problem.New(http.StatusTooManyRequests).Errorf("quota exceeded")
+}
+
+func TestBackedRemote_Quota(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	var count atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(dummyFile))
+	}))
+	defer ts.Close()
+
+	store := storage.NewNoOp()
+	store.Data["generic/cached.txt"] = []byte(dummyFile)
+	limits := &limitObserver{}
+	rem := NewBackedRemote(ctx, &model.Remote{
+		ID:        "foo",
+		Name:      "generic",
+		URI:       ts.URL,
+		Security:  &model.RemoteSecurity{},
+		Archetype: model.ArchetypeGeneric,
+	}, store, limits, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+	rem.SetRefraction("bar")
+
+	_, err := rem.Download(ctx, "/cached.txt", &schemas.RequestContext{})
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{"remote::foo", "refraction::bar"}, limits.resources)
+
+	_, err = rem.Download(ctx, "/file.txt", &schemas.RequestContext{})
+	var httpErr problem.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.EqualValues(t, http.StatusTooManyRequests, httpErr.GetStatus())
+	assert.EqualValues(t, 0, count.Load())
+}
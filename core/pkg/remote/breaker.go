@@ -0,0 +1,323 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"go.opentelemetry.io/otel/attribute"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTimeout is how long a remote is given
+	// to answer a probe if it doesn't set its own.
+	DefaultTimeout = time.Second * 10
+	// DefaultBreakerCooldown is how long a circuit
+	// stays open before a probe is let through.
+	DefaultBreakerCooldown = time.Second * 30
+
+	// healthSamples is the number of recent requests
+	// used to calculate the error rate and latency.
+	healthSamples = 100
+	// healthEvents is the number of state changes
+	// that are kept for each remote.
+	healthEvents = 20
+)
+
+type sample struct {
+	ok      bool
+	latency time.Duration
+}
+
+// Breaker tracks the health of a single remote and
+// stops requests from reaching it once it has
+// failed too many times in a row.
+//
+// A Breaker starts closed. After threshold consecutive
+// failures it opens and rejects every request until
+// the cooldown has elapsed. It then lets a single probe
+// through (half-open), closing again if the probe
+// succeeds or re-opening if it fails.
+//
+// A nil Breaker allows every request.
+type Breaker struct {
+	id  string
+	now func() time.Time
+
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     model.BreakerState
+	// consecutive is the number of failures
+	// since the last success
+	consecutive int
+	openedAt    time.Time
+	// probing is true while the half-open
+	// probe is in-flight
+	probing bool
+
+	samples     []sample
+	next        int
+	lastErr     string
+	lastFailure time.Time
+	events      []*model.HealthEvent
+}
+
+func NewBreaker(id string, threshold int, cooldown time.Duration) *Breaker {
+	b := &Breaker{
+		id:      id,
+		now:     time.Now,
+		state:   model.BreakerStateClosed,
+		samples: make([]sample, 0, healthSamples),
+	}
+	b.configure(threshold, cooldown)
+	return b
+}
+
+func (b *Breaker) configure(threshold int, cooldown time.Duration) {
+	if cooldown <= 0 {
+		cooldown = DefaultBreakerCooldown
+	}
+	b.mu.Lock()
+	b.threshold = threshold
+	b.cooldown = cooldown
+	b.mu.Unlock()
+}
+
+// Allow returns an error if the request
+// should not be sent to the remote.
+func (b *Breaker) Allow(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case model.BreakerStateOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			break
+		}
+		b.transition(ctx, model.BreakerStateHalfOpen, "cooldown elapsed")
+		b.probing = true
+		return nil
+	case model.BreakerStateHalfOpen:
+		if b.probing {
+			break
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+	metricBreakerRejected.Add(ctx, 1)
+	return problem.New(http.StatusServiceUnavailable).Errorf("remote is unavailable")
+}
+
+// Success records a request that the
+// remote answered.
+func (b *Breaker) Success(ctx context.Context, latency time.Duration) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record(sample{ok: true, latency: latency})
+	b.consecutive = 0
+	b.probing = false
+	if b.state != model.BreakerStateClosed {
+		b.transition(ctx, model.BreakerStateClosed, "probe succeeded")
+	}
+}
+
+// Failure records a request that the remote
+// failed to answer.
+func (b *Breaker) Failure(ctx context.Context, latency time.Duration, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record(sample{ok: false, latency: latency})
+	b.consecutive++
+	b.probing = false
+	b.lastErr = err.Error()
+	b.lastFailure = b.now()
+	switch {
+	case b.state == model.BreakerStateHalfOpen:
+		b.transition(ctx, model.BreakerStateOpen, fmt.Sprintf("probe failed: %s", b.lastErr))
+	case b.state == model.BreakerStateClosed && b.threshold > 0 && b.consecutive >= b.threshold:
+		b.transition(ctx, model.BreakerStateOpen, fmt.Sprintf("%d consecutive failures: %s", b.consecutive, b.lastErr))
+	}
+}
+
+// Cancel records a request that was abandoned
+// before the remote could answer, so it says
+// nothing about the health of the remote.
+func (b *Breaker) Cancel() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+func (b *Breaker) record(s sample) {
+	if len(b.samples) < healthSamples {
+		b.samples = append(b.samples, s)
+		return
+	}
+	b.samples[b.next] = s
+	b.next = (b.next + 1) % healthSamples
+}
+
+func (b *Breaker) transition(ctx context.Context, state model.BreakerState, reason string) {
+	logr.FromContextOrDiscard(ctx).Info("circuit breaker changed state", "Remote", b.id, "From", b.state, "To", state, "Reason", reason)
+	metricBreakerTransition.Add(ctx, 1, attribute.String(attributeBreakerState, string(state)))
+	b.state = state
+	if state == model.BreakerStateOpen {
+		b.openedAt = b.now()
+	}
+	b.events = append(b.events, &model.HealthEvent{
+		Time:   b.now().Unix(),
+		State:  state,
+		Reason: reason,
+	})
+	if len(b.events) > healthEvents {
+		b.events = b.events[len(b.events)-healthEvents:]
+	}
+}
+
+// Health returns a snapshot of the
+// health of the remote.
+func (b *Breaker) Health() *model.RemoteHealth {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	h := &model.RemoteHealth{
+		ID:                  b.id,
+		State:               b.state,
+		Requests:            int64(len(b.samples)),
+		ConsecutiveFailures: int64(b.consecutive),
+		LastError:           b.lastErr,
+		History:             make([]*model.HealthEvent, len(b.events)),
+	}
+	if !b.lastFailure.IsZero() {
+		h.LastFailure = b.lastFailure.Unix()
+	}
+	for i := range b.events {
+		e := *b.events[i]
+		h.History[i] = &e
+	}
+	var total time.Duration
+	for _, s := range b.samples {
+		if !s.ok {
+			h.Failures++
+		}
+		total += s.latency
+		if ms := s.latency.Milliseconds(); ms > h.LatencyMax {
+			h.LatencyMax = ms
+		}
+	}
+	if h.Requests > 0 {
+		h.ErrorRate = float64(h.Failures) / float64(h.Requests)
+		h.Latency = (total / time.Duration(h.Requests)).Milliseconds()
+	}
+	return h
+}
+
+// Health keeps the circuit breaker for each
+// remote, so that every Refraction using a
+// remote sees the same state.
+//
+// State is held in memory, so each replica
+// tracks the health of remotes separately.
+type Health struct {
+	mu       sync.Mutex
+	breakers map[string]*Breaker
+	// replica identifies the replica that
+	// the health was observed by
+	replica string
+}
+
+func NewHealth() *Health {
+	replica, err := os.Hostname()
+	if err != nil {
+		replica = "unknown"
+	}
+	return &Health{
+		breakers: map[string]*Breaker{},
+		replica:  replica,
+	}
+}
+
+// Breaker returns the circuit breaker for the
+// remote, creating it if it doesn't exist. The
+// breaker is updated to use the current settings
+// of the remote.
+func (h *Health) Breaker(rm *model.Remote) *Breaker {
+	if h == nil {
+		return nil
+	}
+	cooldown := time.Duration(rm.BreakerCooldown) * time.Second
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	b, ok := h.breakers[rm.ID]
+	if !ok {
+		b = NewBreaker(rm.ID, int(rm.BreakerThreshold), cooldown)
+		h.breakers[rm.ID] = b
+		return b
+	}
+	b.configure(int(rm.BreakerThreshold), cooldown)
+	return b
+}
+
+// Get returns the health of the remote as seen
+// by this replica. Remotes that haven't received
+// any requests are healthy.
+func (h *Health) Get(id string) *model.RemoteHealth {
+	h.mu.Lock()
+	b, ok := h.breakers[id]
+	h.mu.Unlock()
+	if !ok {
+		return &model.RemoteHealth{
+			ID:      id,
+			Replica: h.replica,
+			State:   model.BreakerStateClosed,
+			History: []*model.HealthEvent{},
+		}
+	}
+	health := b.Health()
+	health.Replica = h.replica
+	return health
+}
+
+// Timeout returns how long the remote should
+// be given to answer a probe.
+func Timeout(r Remote) time.Duration {
+	if t, ok := r.(interface{ Timeout() time.Duration }); ok && t.Timeout() > 0 {
+		return t.Timeout()
+	}
+	return DefaultTimeout
+}
@@ -0,0 +1,196 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/lpar/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+
+	now := time.Now()
+	b := NewBreaker("test", 3, time.Minute)
+	b.now = func() time.Time {
+		return now
+	}
+	errUpstream := errors.New("connection refused")
+
+	// failures below the threshold
+	// keep the circuit closed
+	for i := 0; i < 2; i++ {
+		require.NoError(t, b.Allow(ctx))
+		b.Failure(ctx, time.Millisecond, errUpstream)
+	}
+	b.Success(ctx, time.Millisecond)
+	assert.EqualValues(t, model.BreakerStateClosed, b.Health().State)
+
+	// consecutive failures open the circuit
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.Allow(ctx))
+		b.Failure(ctx, time.Millisecond, errUpstream)
+	}
+	assert.EqualValues(t, model.BreakerStateOpen, b.Health().State)
+	err := b.Allow(ctx)
+	var httpErr problem.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.EqualValues(t, http.StatusServiceUnavailable, httpErr.GetStatus())
+
+	// a single probe is let through once
+	// the cooldown has elapsed
+	now = now.Add(time.Minute)
+	assert.NoError(t, b.Allow(ctx))
+	assert.EqualValues(t, model.BreakerStateHalfOpen, b.Health().State)
+	assert.Error(t, b.Allow(ctx))
+
+	// a failed probe re-opens the circuit
+	b.Failure(ctx, time.Millisecond, errUpstream)
+	assert.EqualValues(t, model.BreakerStateOpen, b.Health().State)
+	assert.Error(t, b.Allow(ctx))
+
+	// a cancelled probe lets another probe through
+	now = now.Add(time.Minute)
+	assert.NoError(t, b.Allow(ctx))
+	b.Cancel()
+	assert.NoError(t, b.Allow(ctx))
+
+	// a successful probe closes the circuit
+	b.Success(ctx, time.Millisecond)
+	h := b.Health()
+	assert.EqualValues(t, model.BreakerStateClosed, h.State)
+	assert.EqualValues(t, 8, h.Requests)
+	assert.EqualValues(t, 6, h.Failures)
+	assert.EqualValues(t, 0, h.ConsecutiveFailures)
+	assert.EqualValues(t, "connection refused", h.LastError)
+
+	var states []model.BreakerState
+	for _, e := range h.History {
+		states = append(states, e.State)
+	}
+	assert.EqualValues(t, []model.BreakerState{
+		model.BreakerStateOpen,
+		model.BreakerStateHalfOpen,
+		model.BreakerStateOpen,
+		model.BreakerStateHalfOpen,
+		model.BreakerStateClosed,
+	}, states)
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+
+	b := NewBreaker("test", 0, time.Minute)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, b.Allow(ctx))
+		b.Failure(ctx, time.Millisecond, errors.New("connection refused"))
+	}
+	h := b.Health()
+	assert.EqualValues(t, model.BreakerStateClosed, h.State)
+	assert.EqualValues(t, 1, h.ErrorRate)
+}
+
+func TestHealth_Breaker(t *testing.T) {
+	h := NewHealth()
+
+	rm := &model.Remote{ID: "foo", BreakerThreshold: 5, BreakerCooldown: 30}
+	b := h.Breaker(rm)
+	// the same remote shares a breaker
+	rm.BreakerThreshold = 1
+	assert.Same(t, b, h.Breaker(rm))
+	assert.EqualValues(t, 1, b.threshold)
+	assert.NotSame(t, b, h.Breaker(&model.Remote{ID: "bar"}))
+
+	// unknown remotes are healthy
+	assert.EqualValues(t, model.BreakerStateClosed, h.Get("zoo").State)
+
+	// a nil registry allows everything
+	var nilHealth *Health
+	assert.NoError(t, nilHealth.Breaker(rm).Allow(context.TODO()))
+}
+
+func TestEphemeralRemote_Breaker(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+
+	var count atomic.Int32
+	var code atomic.Int32
+	code.Store(http.StatusNotFound)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		w.WriteHeader(int(code.Load()))
+	}))
+	defer ts.Close()
+
+	rem := NewEphemeralRemote(ctx, ts.URL, ts.Client())
+	rem.breaker = NewBreaker("test", 2, time.Minute)
+
+	// missing files don't count as failures
+	for i := 0; i < 5; i++ {
+		_, err := rem.Exists(ctx, "foo.txt", &schemas.RequestContext{})
+		assert.Error(t, err)
+	}
+	assert.EqualValues(t, model.BreakerStateClosed, rem.breaker.Health().State)
+
+	// server errors do
+	code.Store(http.StatusBadGateway)
+	for i := 0; i < 2; i++ {
+		_, err := rem.Exists(ctx, "foo.txt", &schemas.RequestContext{})
+		assert.Error(t, err)
+	}
+	assert.EqualValues(t, model.BreakerStateOpen, rem.breaker.Health().State)
+	assert.EqualValues(t, 7, count.Load())
+
+	// requests are no longer sent to the remote
+	_, err := rem.Exists(ctx, "foo.txt", &schemas.RequestContext{})
+	assert.Error(t, err)
+	assert.EqualValues(t, 7, count.Load())
+}
+
+func TestOCIRemote_Breaker(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+
+	var count atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	rem := NewOCIRemote(ctx, ts.URL, ts.Client())
+	rem.breaker = NewBreaker("test", 2, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		_, err := rem.Exists(ctx, "foo/manifests/latest", &schemas.RequestContext{})
+		assert.Error(t, err)
+	}
+	assert.EqualValues(t, model.BreakerStateOpen, rem.breaker.Health().State)
+	assert.EqualValues(t, 2, count.Load())
+
+	// requests are no longer sent to the registry
+	_, err := rem.Exists(ctx, "foo/manifests/latest", &schemas.RequestContext{})
+	var httpErr problem.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.EqualValues(t, http.StatusServiceUnavailable, httpErr.GetStatus())
+	assert.EqualValues(t, 2, count.Load())
+}
+
+func TestHealth_Get(t *testing.T) {
+	h := NewHealth()
+	h.replica = "prism-0"
+	h.Breaker(&model.Remote{ID: "foo"})
+
+	// health is labelled with the replica
+	// that observed it
+	assert.EqualValues(t, "prism-0", h.Get("foo").Replica)
+	assert.EqualValues(t, "prism-0", h.Get("bar").Replica)
+}
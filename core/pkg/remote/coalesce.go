@@ -0,0 +1,152 @@
+package remote
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"sync"
+)
+
+// Locker serialises work on a key across replicas.
+type Locker interface {
+	// Lock blocks until the key is held, the context
+	// is cancelled or the implementation gives up.
+	// The returned function releases the key.
+	Lock(ctx context.Context, key string) (func(), error)
+}
+
+// MemoryLocker is a Locker that only serialises work
+// within a single replica. It is used when there is
+// no database to hold the lock.
+type MemoryLocker struct {
+	mu   sync.Mutex
+	keys map[string]chan struct{}
+}
+
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{
+		keys: map[string]chan struct{}{},
+	}
+}
+
+func (m *MemoryLocker) Lock(ctx context.Context, key string) (func(), error) {
+	for {
+		m.mu.Lock()
+		held, ok := m.keys[key]
+		if !ok {
+			done := make(chan struct{})
+			m.keys[key] = done
+			m.mu.Unlock()
+			var once sync.Once
+			return func() {
+				once.Do(func() {
+					m.mu.Lock()
+					delete(m.keys, key)
+					m.mu.Unlock()
+					close(done)
+				})
+			}, nil
+		}
+		m.mu.Unlock()
+		select {
+		case <-held:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Coalescer makes sure that only one request fills the
+// cache for a given path at a time. Other requests for
+// the same path wait for the fill to complete and are
+// then served from storage.
+//
+// Requests within the same replica wait in memory,
+// whereas requests on other replicas wait on the
+// Locker (if one is given).
+type Coalescer struct {
+	locker Locker
+	mu     sync.Mutex
+	calls  map[string]chan struct{}
+}
+
+func NewCoalescer(locker Locker) *Coalescer {
+	return &Coalescer{
+		locker: locker,
+		calls:  map[string]chan struct{}{},
+	}
+}
+
+// Acquire waits until the caller is allowed to fill the
+// cache for the key. If the key has been cached by the
+// time that happens, hit is true and there is nothing to
+// release. Otherwise, the caller must call release once
+// the fill has either completed or failed.
+func (c *Coalescer) Acquire(ctx context.Context, key string, cached func(ctx context.Context) bool) (release func(), hit bool, err error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_coalescer_acquire")
+	defer span.End()
+	span.SetAttributes(attribute.String("key", key))
+	log := logr.FromContextOrDiscard(ctx).WithValues("Key", key)
+	for {
+		c.mu.Lock()
+		done, ok := c.calls[key]
+		if !ok {
+			done = make(chan struct{})
+			c.calls[key] = done
+			c.mu.Unlock()
+			break
+		}
+		c.mu.Unlock()
+		// wait for the other request to finish
+		log.V(1).Info("waiting for in-flight request to fill the cache")
+		metricCoalesced.Add(ctx, 1)
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+		if cached(ctx) {
+			return nil, true, nil
+		}
+		// the other request failed, so try
+		// to fill the cache ourselves
+		log.V(1).Info("in-flight request did not fill the cache")
+	}
+	finish := func() {
+		c.mu.Lock()
+		close(c.calls[key])
+		delete(c.calls, key)
+		c.mu.Unlock()
+	}
+	unlock := func() {}
+	if c.locker != nil {
+		unlock, err = c.locker.Lock(ctx, key)
+		if err != nil {
+			if ctx.Err() != nil {
+				finish()
+				return nil, false, err
+			}
+			// another replica is taking too long (or the
+			// lock is unavailable), so fill the cache
+			// ourselves rather than failing the request
+			log.Info("filling cache without waiting for other replicas", "Error", err.Error())
+			unlock = func() {}
+		}
+	}
+	// another replica may have filled
+	// the cache while we were waiting
+	if cached(ctx) {
+		unlock()
+		finish()
+		return nil, true, nil
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			unlock()
+			finish()
+		})
+	}, false, nil
+}
@@ -0,0 +1,156 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/quota"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testLocker struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (l *testLocker) Lock(context.Context, string) (func(), error) {
+	l.mu.Lock()
+	l.count++
+	return l.mu.Unlock, nil
+}
+
+func TestBackedRemote_DownloadCoalesced(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 1}))
+	data := bytes.Repeat([]byte("prism"), 100_000)
+
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		// give the other clients time to pile up
+		time.Sleep(time.Millisecond * 100)
+		_, _ = w.Write(data)
+	}))
+	defer ts.Close()
+
+	store, err := storage.NewFilesystem(ctx, t.TempDir())
+	require.NoError(t, err)
+	locker := &testLocker{}
+	rem := NewBackedRemote(ctx, &model.Remote{
+		Name:      "test",
+		URI:       ts.URL,
+		Security:  &model.RemoteSecurity{},
+		Archetype: model.ArchetypeGeneric,
+	}, store, &quota.NoopObserver{}, NewCoalescer(locker), nil, func(context.Context, string, string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
+		return nil
+	}, getPkg, getPkg)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := rem.Download(ctx, "foo/bar.txt", &schemas.RequestContext{})
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer r.Close()
+			out, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, data, out)
+		}()
+	}
+	wg.Wait()
+	assert.EqualValues(t, 1, atomic.LoadInt32(&requests))
+	assert.EqualValues(t, 1, locker.count)
+}
+
+func TestCoalescer_Acquire(t *testing.T) {
+	ctx := context.TODO()
+	c := NewCoalescer(nil)
+	var cached bool
+	isCached := func(context.Context) bool {
+		return cached
+	}
+
+	release, hit, err := c.Acquire(ctx, "foo", isCached)
+	require.NoError(t, err)
+	assert.False(t, hit)
+
+	// a failed fill lets the next request take over
+	done := make(chan func())
+	go func() {
+		r, hit, err := c.Acquire(ctx, "foo", isCached)
+		assert.NoError(t, err)
+		assert.False(t, hit)
+		done <- r
+	}()
+	release()
+	release2 := <-done
+
+	// a successful fill is shared with waiting requests
+	go func() {
+		r, hit, err := c.Acquire(ctx, "foo", isCached)
+		assert.NoError(t, err)
+		assert.True(t, hit)
+		assert.Nil(t, r)
+		done <- nil
+	}()
+	cached = true
+	release2()
+	<-done
+
+	// waiting requests give up when cancelled
+	cached = false
+	release3, _, err := c.Acquire(ctx, "bar", isCached)
+	require.NoError(t, err)
+	defer release3()
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, _, err = c.Acquire(cctx, "bar", isCached)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMemoryLocker_Lock(t *testing.T) {
+	ctx := context.TODO()
+	l := NewMemoryLocker()
+
+	unlock, err := l.Lock(ctx, "foo")
+	require.NoError(t, err)
+
+	// other keys are not affected
+	unlockBar, err := l.Lock(ctx, "bar")
+	require.NoError(t, err)
+	unlockBar()
+
+	// waiting requests give up when cancelled
+	cctx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+	_, err = l.Lock(cctx, "foo")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the key can be taken once it is released
+	done := make(chan struct{})
+	go func() {
+		unlock, err := l.Lock(ctx, "foo")
+		assert.NoError(t, err)
+		unlock()
+		close(done)
+	}()
+	unlock()
+	unlock()
+	<-done
+}
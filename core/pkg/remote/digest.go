@@ -0,0 +1,99 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package remote
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	gohash "hash"
+	"strings"
+)
+
+// hashes contains the algorithms that upstream may
+// use to declare the digest of a file. They match
+// the algorithms allowed by PEP 503.
+var hashes = map[string]func() gohash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha224": sha256.New224,
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// Digest is a checksum that upstream
+// has declared for a file.
+type Digest struct {
+	Algorithm string
+	// Value is the hex-encoded checksum
+	Value string
+}
+
+func (d *Digest) String() string {
+	return d.Algorithm + "=" + d.Value
+}
+
+// New returns a hash that can be used
+// to check the file against the Digest.
+func (d *Digest) New() gohash.Hash {
+	return hashes[d.Algorithm]()
+}
+
+// Matches returns true if the sum of
+// h is the same as the Digest.
+func (d *Digest) Matches(h gohash.Hash) bool {
+	return hex.EncodeToString(h.Sum(nil)) == d.Value
+}
+
+// ParseDigest parses a digest in the
+// form "<algorithm>=<hex>".
+func ParseDigest(s string) (*Digest, bool) {
+	alg, val, ok := strings.Cut(s, "=")
+	if !ok {
+		return nil, false
+	}
+	alg = strings.ToLower(alg)
+	val = strings.ToLower(val)
+	fn, ok := hashes[alg]
+	if !ok {
+		return nil, false
+	}
+	if b, err := hex.DecodeString(val); err != nil || len(b) != fn().Size() {
+		return nil, false
+	}
+	return &Digest{Algorithm: alg, Value: val}, true
+}
+
+// SplitDigest removes the digest from the fragment
+// of a URL (e.g., "https://example.org/foo.whl#sha256=<hex>").
+// If the fragment isn't a digest, the URL is returned
+// unchanged.
+func SplitDigest(uri string) (string, *Digest) {
+	i := strings.LastIndex(uri, "#")
+	if i < 0 {
+		return uri, nil
+	}
+	d, ok := ParseDigest(uri[i+1:])
+	if !ok {
+		return uri, nil
+	}
+	return uri[:i], d
+}
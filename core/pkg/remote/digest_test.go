@@ -0,0 +1,54 @@
+package remote
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestSplitDigest(t *testing.T) {
+	sha256 := strings.Repeat("ab", 32)
+	var cases = []struct {
+		in   string
+		path string
+		want *Digest
+	}{
+		{
+			"https://files.pythonhosted.org/packages/foo-1.0.0.whl#sha256=" + sha256,
+			"https://files.pythonhosted.org/packages/foo-1.0.0.whl",
+			&Digest{Algorithm: "sha256", Value: sha256},
+		},
+		{
+			"https://example.org/charts/foo-1.0.0.tgz#SHA256=" + strings.ToUpper(sha256),
+			"https://example.org/charts/foo-1.0.0.tgz",
+			&Digest{Algorithm: "sha256", Value: sha256},
+		},
+		{
+			"https://files.pythonhosted.org/packages/foo-1.0.0.whl",
+			"https://files.pythonhosted.org/packages/foo-1.0.0.whl",
+			nil,
+		},
+		{
+			"https://example.org/foo.html#section",
+			"https://example.org/foo.html#section",
+			nil,
+		},
+		{
+			"https://example.org/foo.whl#crc32=abcdef12",
+			"https://example.org/foo.whl#crc32=abcdef12",
+			nil,
+		},
+		{
+			"https://example.org/foo.whl#sha256=abcdef12",
+			"https://example.org/foo.whl#sha256=abcdef12",
+			nil,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			path, want := SplitDigest(tt.in)
+			assert.EqualValues(t, tt.path, path)
+			assert.EqualValues(t, tt.want, want)
+		})
+	}
+}
@@ -36,14 +36,16 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
 
 type EphemeralRemote struct {
-	root   string
-	client *http.Client
-	cache  *ttlcache.Cache[string, string]
+	root    string
+	client  *http.Client
+	cache   *ttlcache.Cache[string, string]
+	breaker *Breaker
 }
 
 func NewEphemeralRemote(ctx context.Context, root string, client *http.Client) *EphemeralRemote {
@@ -83,11 +85,11 @@ func (r *EphemeralRemote) Exists(ctx context.Context, path string, rctx *schemas
 		return res.Value(), nil
 	}
 
-	target := fmt.Sprintf("%s/%s", r.root, path)
+	target, tctx := r.target(ctx, path, rctx)
 	log = log.WithValues("Target", target)
 	log.Info("probing remote")
 	_, err := r.getExecMethod(ctx, target, schemas.RequestOptions{
-		Context: rctx,
+		Context: tctx,
 	})()
 	if err != nil {
 		return "", err
@@ -129,24 +131,42 @@ func (r *EphemeralRemote) getExecMethod(ctx context.Context, target string, opt
 	}
 }
 
-func (r *EphemeralRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+func (r *EphemeralRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_ephemeral_download")
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithValues("Path", path)
 	log.V(2).Info("using request context", "RequestContext", rctx)
-	target := path
-	if !strings.HasPrefix(path, "https://") {
-		target = fmt.Sprintf("%s/%s", r.root, strings.TrimPrefix(path, "/"))
-	}
+	target, tctx := r.target(ctx, path, rctx)
 	log = log.WithValues("Target", target)
 	log.Info("downloading from remote")
-	resp, err := r.Do(ctx, http.MethodGet, target, schemas.RequestOptions{Context: rctx})
+	resp, err := r.Do(ctx, http.MethodGet, target, schemas.RequestOptions{Context: tctx})
 	if err != nil {
 		return nil, err
 	}
 	return resp.Body, nil
 }
 
+// target resolves the path against the root of the remote.
+// Absolute URLs (e.g. those found in an upstream index) are used
+// as-is, however the authentication context is dropped if they
+// point to a different host so that the remote's credentials
+// are never sent anywhere else.
+func (r *EphemeralRemote) target(ctx context.Context, path string, rctx *schemas.RequestContext) (string, *schemas.RequestContext) {
+	if !strings.HasPrefix(path, "https://") && !strings.HasPrefix(path, "http://") {
+		return fmt.Sprintf("%s/%s", r.root, strings.TrimPrefix(path, "/")), rctx
+	}
+	if rctx == nil {
+		return path, nil
+	}
+	target, err := url.Parse(path)
+	root, err2 := url.Parse(r.root)
+	if err == nil && err2 == nil && target.Scheme == root.Scheme && strings.EqualFold(target.Host, root.Host) {
+		return path, rctx
+	}
+	logr.FromContextOrDiscard(ctx).V(1).Info("removing authentication context as the target is not part of the remote", "Target", path, "Root", r.root)
+	return path, &schemas.RequestContext{}
+}
+
 func (r *EphemeralRemote) Do(ctx context.Context, method, target string, opt schemas.RequestOptions) (*http.Response, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_ephemeral_do")
 	defer span.End()
@@ -166,6 +186,12 @@ func (r *EphemeralRemote) Do(ctx context.Context, method, target string, opt sch
 	}
 	log.V(3).Info("request headers", "Headers", req.Header)
 
+	// skip the remote while it's unhealthy
+	if err := r.breaker.Allow(ctx); err != nil {
+		log.V(1).Info("skipping request as the remote circuit is open")
+		return nil, err
+	}
+
 	// start the clock
 	start := time.Now()
 	span.SetAttributes(attribute.String("time_start", start.String()))
@@ -185,13 +211,22 @@ func (r *EphemeralRemote) Do(ctx context.Context, method, target string, opt sch
 		// swallow the context-cancelled error
 		// since it will happen a lot
 		if errors.Is(err, context.Canceled) {
+			r.breaker.Cancel()
 			metricDoCancel.Add(ctx, 1)
 			log.V(1).Error(err, "cancelling request")
 			return nil, err
 		}
+		r.breaker.Failure(ctx, duration, err)
 		log.Error(err, "failed to execute request")
 		return nil, err
 	}
+	// client errors mean that the remote is
+	// healthy, even if it doesn't have the file
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		r.breaker.Failure(ctx, duration, fmt.Errorf("unexpected status code: %d", resp.StatusCode))
+	} else {
+		r.breaker.Success(ctx, duration)
+	}
 	log = log.WithValues("Code", resp.StatusCode, "Duration", duration, "Method", method)
 	log.Info("remote request completed")
 	log.V(3).Info("response headers", "Headers", resp.Header)
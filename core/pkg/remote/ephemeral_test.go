@@ -55,3 +55,39 @@ func TestEphemeralRemote_Do(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestEphemeralRemote_target(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.New(t))
+	rem := NewEphemeralRemote(ctx, "https://index.crates.io", nil)
+	rctx := &schemas.RequestContext{
+		AuthOpts: httpclient.AuthOpts{
+			Mode:   httpclient.AuthHeader,
+			Header: "Authorization",
+			Token:  "hunter2",
+		},
+	}
+
+	var cases = []struct {
+		name   string
+		path   string
+		target string
+		auth   bool
+	}{
+		{"relative path", "/config.json", "https://index.crates.io/config.json", true},
+		{"same host", "https://index.crates.io/api/v1/crates/foo/1.0.0/download", "https://index.crates.io/api/v1/crates/foo/1.0.0/download", true},
+		{"different host", "https://static.crates.io/crates/foo/1.0.0/download", "https://static.crates.io/crates/foo/1.0.0/download", false},
+		{"different scheme", "http://index.crates.io/config.json", "http://index.crates.io/config.json", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			target, tctx := rem.target(ctx, tt.path, rctx)
+			assert.EqualValues(t, tt.target, target)
+			if tt.auth {
+				assert.EqualValues(t, "hunter2", tctx.Token)
+			} else {
+				assert.Empty(t, tctx.Token)
+				assert.EqualValues(t, httpclient.AuthNone, tctx.Mode)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"go.opentelemetry.io/otel/attribute"
+	gohash "hash"
+	"io"
+	"os"
+	"sync"
+)
+
+// errIncomplete is given to storage when the client stops
+// reading before the end of the file, so that the partial
+// upload is thrown away.
+var errIncomplete = errors.New("stream closed before the end of the file")
+
+// ErrDigestMismatch is returned when a file doesn't
+// match the digest that upstream declared for it.
+var ErrDigestMismatch = errors.New("file does not match the expected digest")
+
+// fillBufferSize is how much of the file is read
+// from upstream at a time.
+const fillBufferSize = 32 * 1024
+
+// cacheFill streams a file to the client while writing it
+// to storage. Only a single read buffer is held in memory
+// at any time, regardless of the size of the file.
+//
+// The file is read from upstream as fast as storage will
+// accept it and spooled to a temporary file that the client
+// reads from at its own pace. This means that the fill (and
+// whoever is waiting for it) doesn't depend on how quickly
+// the client can receive the file.
+//
+// If storage fails, the client continues to receive the
+// file and it is simply not cached. If the upstream fails
+// or the client goes away before the file has been read
+// from upstream, the upload is aborted so that storage
+// never contains a partial file.
+//
+// The SHA-256 of the file is computed while it is
+// read. If upstream declared a digest for the file
+// and it doesn't match, the upload is aborted and the
+// client receives an error instead of the end of the
+// file.
+type cacheFill struct {
+	ctx  context.Context
+	src  io.ReadCloser
+	pw   *io.PipeWriter
+	done chan error
+
+	// sum is the SHA-256 of the file
+	sum gohash.Hash
+	// want is the digest that upstream declared
+	// for the file, if any, and wantSum is
+	// the hash used to check it.
+	want    *Digest
+	wantSum gohash.Hash
+
+	// onComplete is called once the file has been
+	// saved to storage with its hex-encoded SHA-256
+	onComplete func(n int64, sum string)
+	// release is called once the upload has
+	// finished, regardless of whether it
+	// succeeded
+	release func()
+
+	// spool holds everything that has been read from
+	// upstream so that the client can catch up
+	spool  *os.File
+	filled chan struct{}
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	// written is how much of the file is in the spool
+	// and offset is how much the client has read
+	written int64
+	offset  int64
+	// err is why the fill ended (io.EOF if it succeeded)
+	err    error
+	closed bool
+
+	n        int64
+	storeErr error
+	once     sync.Once
+}
+
+func newCacheFill(ctx context.Context, src io.ReadCloser, path string, store storage.Reader, want *Digest, onComplete func(n int64, sum string), release func()) (*cacheFill, error) {
+	spool, err := os.CreateTemp("", "prism-fill-*")
+	if err != nil {
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	c := &cacheFill{
+		ctx:        ctx,
+		src:        src,
+		pw:         pw,
+		done:       make(chan error, 1),
+		sum:        sha256.New(),
+		want:       want,
+		onComplete: onComplete,
+		release:    release,
+		spool:      spool,
+		filled:     make(chan struct{}),
+	}
+	c.cond = sync.NewCond(&c.mu)
+	if want != nil {
+		c.wantSum = c.sum
+		if want.Algorithm != "sha256" {
+			c.wantSum = want.New()
+		}
+	}
+	go func() {
+		err := store.Put(ctx, path, pr)
+		// make sure that writes don't block
+		// if storage gave up early
+		if err != nil {
+			_ = pr.CloseWithError(err)
+		}
+		c.done <- err
+	}()
+	go c.fill()
+	return c, nil
+}
+
+// fill copies the file from upstream into
+// storage and the spool.
+func (c *cacheFill) fill() {
+	defer close(c.filled)
+	buf := make([]byte, fillBufferSize)
+	var err error
+	for {
+		var n int
+		n, err = c.src.Read(buf)
+		if n > 0 {
+			if werr := c.write(buf[:n]); werr != nil {
+				err = werr
+				break
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			err = c.verify()
+			break
+		}
+		if err != nil {
+			break
+		}
+		c.mu.Lock()
+		closed := c.closed
+		c.mu.Unlock()
+		if closed {
+			err = errIncomplete
+			break
+		}
+	}
+	c.finish(err)
+	if err == nil {
+		err = io.EOF
+	}
+	c.mu.Lock()
+	c.err = err
+	c.cond.Broadcast()
+	c.mu.Unlock()
+}
+
+// write adds data read from upstream to
+// storage and the spool.
+func (c *cacheFill) write(p []byte) error {
+	c.n += int64(len(p))
+	c.sum.Write(p)
+	if c.wantSum != nil && c.wantSum != c.sum {
+		c.wantSum.Write(p)
+	}
+	if c.storeErr == nil {
+		if _, err := c.pw.Write(p); err != nil {
+			// storage has failed, but the client
+			// can still receive the file
+			c.storeErr = err
+		}
+	}
+	if _, err := c.spool.WriteAt(p, c.n-int64(len(p))); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.written = c.n
+	c.cond.Broadcast()
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *cacheFill) Read(p []byte) (int, error) {
+	c.mu.Lock()
+	for c.offset >= c.written && c.err == nil && !c.closed {
+		c.cond.Wait()
+	}
+	if c.closed {
+		c.mu.Unlock()
+		return 0, os.ErrClosed
+	}
+	if c.offset >= c.written {
+		err := c.err
+		c.mu.Unlock()
+		return 0, err
+	}
+	off, avail := c.offset, c.written-c.offset
+	c.mu.Unlock()
+	if int64(len(p)) > avail {
+		p = p[:avail]
+	}
+	n, err := c.spool.ReadAt(p, off)
+	c.mu.Lock()
+	c.offset += int64(n)
+	c.mu.Unlock()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, err
+	}
+	return n, nil
+}
+
+// verify checks the file against the
+// digest that upstream declared.
+func (c *cacheFill) verify() error {
+	if c.want == nil {
+		return nil
+	}
+	if c.want.Matches(c.wantSum) {
+		metricDigest.Add(c.ctx, 1, attribute.Bool(attributeDigestMatch, true))
+		return nil
+	}
+	metricDigest.Add(c.ctx, 1, attribute.Bool(attributeDigestMatch, false))
+	return fmt.Errorf("%w: expected %s but got %s", ErrDigestMismatch, c.want, hex.EncodeToString(c.wantSum.Sum(nil)))
+}
+
+// Close releases the upstream and the spool. If the file
+// hasn't been read from upstream in its entirety, the
+// upload is aborted.
+func (c *cacheFill) Close() error {
+	var err error
+	c.once.Do(func() {
+		c.mu.Lock()
+		c.closed = true
+		c.cond.Broadcast()
+		c.mu.Unlock()
+		// interrupt the fill if it's still running
+		select {
+		case <-c.filled:
+		default:
+			err = c.src.Close()
+			<-c.filled
+		}
+		if err == nil {
+			err = c.src.Close()
+		}
+		_ = c.spool.Close()
+		_ = os.Remove(c.spool.Name())
+	})
+	return err
+}
+
+// finish ends the upload and waits for storage to
+// either save or discard the file. A nil error
+// commits the upload.
+func (c *cacheFill) finish(err error) {
+	log := logr.FromContextOrDiscard(c.ctx)
+	_ = c.pw.CloseWithError(err)
+	putErr := <-c.done
+	switch {
+	case errors.Is(err, ErrDigestMismatch):
+		log.Error(err, "rejected upload to cache", "Count", c.n)
+	case err != nil:
+		log.Info("aborted upload to cache", "Error", err.Error(), "Count", c.n)
+	case putErr != nil:
+		log.Error(putErr, "failed to upload data to cache", "Count", c.n)
+	default:
+		log.V(2).Info("successfully uploaded data to cache", "Count", c.n)
+		c.onComplete(c.n, hex.EncodeToString(c.sum.Sum(nil)))
+	}
+	if c.release != nil {
+		c.release()
+	}
+}
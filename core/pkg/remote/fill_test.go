@@ -0,0 +1,142 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// brokenStore fails every upload
+// without reading anything.
+type brokenStore struct {
+	storage.NoOp
+}
+
+func (*brokenStore) Put(context.Context, string, io.Reader) error {
+	return errors.New("storage is unavailable")
+}
+
+func TestCacheFill(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	data := bytes.Repeat([]byte("prism"), 100_000)
+
+	t.Run("complete read is cached", func(t *testing.T) {
+		store := storage.NewNoOp()
+		var count int64
+		var digest string
+		c, err := newCacheFill(ctx, io.NopCloser(bytes.NewReader(data)), "test.txt", store, nil, func(n int64, sum string) {
+			count = n
+			digest = sum
+		}, nil)
+		require.NoError(t, err)
+		out, err := io.ReadAll(c)
+		require.NoError(t, err)
+		assert.NoError(t, c.Close())
+		assert.Equal(t, data, out)
+		assert.Equal(t, data, store.Data["test.txt"])
+		assert.EqualValues(t, len(data), count)
+		sum := sha256.Sum256(data)
+		assert.EqualValues(t, hex.EncodeToString(sum[:]), digest)
+	})
+	t.Run("matching digest is cached", func(t *testing.T) {
+		store := storage.NewNoOp()
+		sum := sha512.Sum512(data)
+		want := &Digest{Algorithm: "sha512", Value: hex.EncodeToString(sum[:])}
+		var completed bool
+		c, err := newCacheFill(ctx, io.NopCloser(bytes.NewReader(data)), "test.txt", store, want, func(int64, string) {
+			completed = true
+		}, nil)
+		require.NoError(t, err)
+		_, err = io.ReadAll(c)
+		require.NoError(t, err)
+		assert.NoError(t, c.Close())
+		assert.Contains(t, store.Data, "test.txt")
+		assert.True(t, completed)
+	})
+	t.Run("mismatched digest is rejected", func(t *testing.T) {
+		store := storage.NewNoOp()
+		want := &Digest{Algorithm: "sha256", Value: strings.Repeat("0", 64)}
+		c, err := newCacheFill(ctx, io.NopCloser(bytes.NewReader(data)), "test.txt", store, want, func(int64, string) {
+			t.Error("upload should not have completed")
+		}, nil)
+		require.NoError(t, err)
+		_, err = io.ReadAll(c)
+		assert.ErrorIs(t, err, ErrDigestMismatch)
+		assert.NoError(t, c.Close())
+		assert.NotContains(t, store.Data, "test.txt")
+	})
+	t.Run("upstream failure is not cached", func(t *testing.T) {
+		store := storage.NewNoOp()
+		src := io.MultiReader(bytes.NewReader(data[:1000]), iotest.ErrReader(errors.New("connection reset")))
+		c, err := newCacheFill(ctx, io.NopCloser(src), "test.txt", store, nil, func(int64, string) {
+			t.Error("upload should not have completed")
+		}, nil)
+		require.NoError(t, err)
+		_, err = io.ReadAll(c)
+		assert.Error(t, err)
+		assert.NoError(t, c.Close())
+		assert.NotContains(t, store.Data, "test.txt")
+	})
+	t.Run("partial read is not cached", func(t *testing.T) {
+		store := storage.NewNoOp()
+		var released bool
+		pr, pw := io.Pipe()
+		go func() {
+			_, _ = pw.Write(data[:1000])
+		}()
+		c, err := newCacheFill(ctx, pr, "test.txt", store, nil, func(int64, string) {
+			t.Error("upload should not have completed")
+		}, func() {
+			released = true
+		})
+		require.NoError(t, err)
+		_, err = io.ReadFull(c, make([]byte, 1000))
+		require.NoError(t, err)
+		assert.NoError(t, c.Close())
+		assert.NotContains(t, store.Data, "test.txt")
+		assert.True(t, released)
+	})
+	t.Run("slow client does not hold the fill", func(t *testing.T) {
+		store := storage.NewNoOp()
+		released := make(chan struct{})
+		c, err := newCacheFill(ctx, io.NopCloser(bytes.NewReader(data)), "test.txt", store, nil, func(int64, string) {}, func() {
+			close(released)
+		})
+		require.NoError(t, err)
+		_, err = io.ReadFull(c, make([]byte, 1000))
+		require.NoError(t, err)
+		select {
+		case <-released:
+		case <-time.After(5 * time.Second):
+			t.Fatal("fill was not released before the client finished reading")
+		}
+		assert.Equal(t, data, store.Data["test.txt"])
+		out, err := io.ReadAll(c)
+		require.NoError(t, err)
+		assert.NoError(t, c.Close())
+		assert.Equal(t, data[1000:], out)
+	})
+	t.Run("storage failure still serves the client", func(t *testing.T) {
+		c, err := newCacheFill(ctx, io.NopCloser(bytes.NewReader(data)), "test.txt", &brokenStore{}, nil, func(int64, string) {
+			t.Error("upload should not have completed")
+		}, nil)
+		require.NoError(t, err)
+		out, err := io.ReadAll(c)
+		require.NoError(t, err)
+		assert.NoError(t, c.Close())
+		assert.Equal(t, data, out)
+	})
+}
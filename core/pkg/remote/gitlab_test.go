@@ -71,7 +71,9 @@ func TestBackedRemote_DownloadGitLab(t *testing.T) {
 			AuthHeaders: []string{"Job-Token", "Private-Token", "Deploy-Token"},
 		},
 		Archetype: model.ArchetypeGeneric,
-	}, store, &quota.NoopObserver{}, func(ctx context.Context, path, remote string) error {
+	}, store, &quota.NoopObserver{}, nil, nil, func(ctx context.Context, path, remote string) error {
+		return nil
+	}, func(ctx context.Context, path, remote, digest string) error {
 		return nil
 	}, getPkg, getPkg)
 
@@ -25,10 +25,10 @@ func NewHelmRemote(ctx context.Context, root string, client *http.Client, getPac
 func (h *HelmRemote) Exists(ctx context.Context, path string, _ *schemas.RequestContext) (string, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_helm_exists")
 	defer span.End()
-	return h.getPackage(ctx, path)
+	return excludeHosted(h.getPackage(ctx, path))
 }
 
-func (h *HelmRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+func (h *HelmRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_helm_download")
 	defer span.End()
 	return h.rem.Download(ctx, path, rctx)
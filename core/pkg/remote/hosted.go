@@ -0,0 +1,119 @@
+package remote
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// HostedScheme is used in place of a URL
+	// when referring to files in a hosted remote.
+	HostedScheme = "hosted://"
+
+	fileIndexHTML   = "index.html"
+	filePackageJSON = "package.json"
+)
+
+// HostedRemote is a remote that has no upstream.
+// Its files are uploaded by users and live only
+// in storage.
+type HostedRemote struct {
+	name      string
+	archetype model.Archetype
+	store     storage.Reader
+}
+
+func NewHostedRemote(name string, archetype model.Archetype, store storage.Reader) *HostedRemote {
+	return &HostedRemote{
+		name:      name,
+		archetype: archetype,
+		store:     store,
+	}
+}
+
+// IsHosted returns true if the URL refers
+// to a file in a hosted remote.
+func IsHosted(uri string) bool {
+	return strings.HasPrefix(uri, HostedScheme)
+}
+
+// excludeHosted hides files that belong to a hosted
+// remote from remotes that download from an upstream.
+func excludeHosted(uri string, err error) (string, error) {
+	if err == nil && IsHosted(uri) {
+		return "", problem.New(http.StatusNotFound).Errorf("failed to retrieve object from remote")
+	}
+	return uri, err
+}
+
+func (h *HostedRemote) String() string {
+	return HostedScheme + h.name
+}
+
+// key returns the storage path of a file. Directories
+// (e.g., PyPI simple pages) and NPM package documents
+// are stored as files within the directory so that they
+// don't collide with the files that they refer to.
+func (h *HostedRemote) key(path string) string {
+	path = strings.TrimPrefix(path, h.String())
+	switch {
+	case strings.HasSuffix(path, "/"):
+		path = filepath.Join(path, fileIndexHTML)
+	case h.archetype == model.ArchetypeNpm && !strings.Contains(path, "/-/"):
+		path = filepath.Join(path, filePackageJSON)
+	}
+	return filepath.Join(h.name, strings.TrimPrefix(path, "/"))
+}
+
+func (h *HostedRemote) Exists(ctx context.Context, path string, _ *schemas.RequestContext) (string, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_hosted_exists")
+	defer span.End()
+	key := h.key(path)
+	span.SetAttributes(attribute.String("key", key))
+	log := logr.FromContextOrDiscard(ctx).WithName("hosted").WithValues("Path", path, "Key", key)
+	if ok, _ := h.store.Head(ctx, key); !ok {
+		log.V(1).Info("unable to locate file in hosted remote")
+		return "", problem.New(http.StatusNotFound).Errorf("failed to retrieve object from remote")
+	}
+	return path, nil
+}
+
+func (h *HostedRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_hosted_download")
+	defer span.End()
+	if _, err := h.Exists(ctx, path, rctx); err != nil {
+		return nil, err
+	}
+	obj, err := storage.Open(ctx, h.store, h.key(path))
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// Upload saves a file to the hosted remote,
+// replacing it if it already exists.
+func (h *HostedRemote) Upload(ctx context.Context, path string, r io.Reader) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_hosted_upload")
+	defer span.End()
+	key := h.key(path)
+	span.SetAttributes(attribute.String("key", key))
+	log := logr.FromContextOrDiscard(ctx).WithName("hosted").WithValues("Path", path, "Key", key)
+	log.Info("uploading file to hosted remote")
+	if err := h.store.Put(ctx, key, r); err != nil {
+		log.Error(err, "failed to upload file")
+		return err
+	}
+	return nil
+}
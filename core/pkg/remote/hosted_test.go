@@ -0,0 +1,116 @@
+package remote
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/lpar/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/quota"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestHostedRemote_key(t *testing.T) {
+	var cases = []struct {
+		archetype model.Archetype
+		path      string
+		key       string
+	}{
+		{model.ArchetypeGeneric, "/foo/bar.txt", "test/foo/bar.txt"},
+		{model.ArchetypeGeneric, "hosted://test/foo/bar.txt", "test/foo/bar.txt"},
+		{model.ArchetypePip, "/requests/", "test/requests/index.html"},
+		{model.ArchetypePip, "requests/requests-2.28.1.tar.gz", "test/requests/requests-2.28.1.tar.gz"},
+		{model.ArchetypeNpm, "react", "test/react/package.json"},
+		{model.ArchetypeNpm, "@types/react", "test/@types/react/package.json"},
+		{model.ArchetypeNpm, "@types/react/-/react-18.0.0.tgz", "test/@types/react/-/react-18.0.0.tgz"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.path, func(t *testing.T) {
+			h := NewHostedRemote("test", tt.archetype, storage.NewNoOp())
+			assert.EqualValues(t, tt.key, h.key(tt.path))
+		})
+	}
+}
+
+func TestHostedRemote_Download(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	store := storage.NewNoOp()
+	h := NewHostedRemote("test", model.ArchetypeGeneric, store)
+
+	_, err := h.Download(ctx, "foo.txt", &schemas.RequestContext{})
+	assert.Error(t, err)
+
+	require.NoError(t, h.Upload(ctx, "foo.txt", strings.NewReader("hello")))
+	assert.Contains(t, store.Data, "test/foo.txt")
+
+	uri, err := h.Exists(ctx, "foo.txt", &schemas.RequestContext{})
+	assert.NoError(t, err)
+	assert.EqualValues(t, "foo.txt", uri)
+
+	r, err := h.Download(ctx, "foo.txt", &schemas.RequestContext{})
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.EqualValues(t, "hello", string(data))
+}
+
+func TestBackedRemote_Upload(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	onCreate := func(ctx context.Context, path, remote string) error {
+		return nil
+	}
+
+	t.Run("hosted remote", func(t *testing.T) {
+		store := storage.NewNoOp()
+		rem := NewBackedRemote(ctx, &model.Remote{
+			Name:      "test",
+			Archetype: model.ArchetypeGeneric,
+			Hosted:    true,
+			Security: &model.RemoteSecurity{
+				Blocked: []string{"^/?(super-secret).+"},
+			},
+		}, store, &quota.NoopObserver{}, nil, nil, onCreate, nil, getPkg, getPkg)
+		assert.EqualValues(t, "hosted://test", rem.String())
+
+		assert.NoError(t, rem.Upload(ctx, "foo.txt", strings.NewReader("hello")))
+		r, err := rem.Download(ctx, "foo.txt", &schemas.RequestContext{})
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.EqualValues(t, "hello", string(data))
+
+		// policy is still respected
+		err = rem.Upload(ctx, "super-secret/secret.txt", strings.NewReader("hello"))
+		var p *problem.ProblemDetails
+		require.ErrorAs(t, err, &p)
+		assert.EqualValues(t, http.StatusForbidden, p.Status)
+	})
+	t.Run("proxied remote", func(t *testing.T) {
+		rem := NewBackedRemote(ctx, &model.Remote{
+			Name:      "test",
+			URI:       "https://example.org",
+			Archetype: model.ArchetypeGeneric,
+			Security:  &model.RemoteSecurity{},
+		}, storage.NewNoOp(), &quota.NoopObserver{}, nil, nil, onCreate, nil, getPkg, getPkg)
+		err := rem.Upload(ctx, "foo.txt", strings.NewReader("hello"))
+		var p *problem.ProblemDetails
+		require.ErrorAs(t, err, &p)
+		assert.EqualValues(t, http.StatusMethodNotAllowed, p.Status)
+	})
+}
+
+func TestExcludeHosted(t *testing.T) {
+	uri, err := excludeHosted("https://example.org/foo.tgz", nil)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "https://example.org/foo.tgz", uri)
+
+	_, err = excludeHosted("hosted://test/foo.tgz", nil)
+	assert.Error(t, err)
+}
@@ -48,6 +48,31 @@ var (
 		instrument.WithUnit(unit.Dimensionless),
 		instrument.WithDescription("Total requests that hit the cache and their status."),
 	)
+	metricCoalesced, _ = meter.SyncInt64().Counter(
+		"prism.core.remote.backed.coalesced.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total requests that waited for another request to fill the cache."),
+	)
+	metricBackedOffline, _ = meter.SyncInt64().Counter(
+		"prism.core.remote.backed.offline.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total cache misses that were not sent upstream because the remote is offline."),
+	)
+	metricDigest, _ = meter.SyncInt64().Counter(
+		"prism.core.remote.backed.digest.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total files that were checked against the digest declared by upstream."),
+	)
+	metricBreakerRejected, _ = meter.SyncInt64().Counter(
+		"prism.core.remote.breaker.rejected.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total requests that were not sent to a remote because its circuit was open."),
+	)
+	metricBreakerTransition, _ = meter.SyncInt64().Counter(
+		"prism.core.remote.breaker.transition.total",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Total circuit breaker state changes and the state that was entered."),
+	)
 )
 
 const (
@@ -55,6 +80,8 @@ const (
 	attributeAuthPartitionID   = "prism.auth.partition.id"
 	attributeAuthPartitionHash = "prism.auth.partition.hash"
 	attributeCacheKey          = "cache"
+	attributeBreakerState      = "breaker.state"
+	attributeDigestMatch       = "digest.match"
 
 	cacheHit    = "hit"
 	cacheMiss   = "miss"
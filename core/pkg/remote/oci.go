@@ -0,0 +1,345 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/djcass44/go-utils/utilities/httputils"
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/lpar/problem"
+	"gitlab.com/go-prism/prism3/core/pkg/httpclient"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	OCIManifests = "manifests"
+	OCIBlobs     = "blobs"
+
+	// defaultTokenExpiry is used when the token server
+	// doesn't tell us how long a token is valid for.
+	//
+	// https://docs.docker.com/registry/spec/auth/token/#requesting-a-token
+	defaultTokenExpiry = time.Second * 60
+)
+
+var (
+	regexOCIPath   = regexp.MustCompile(`^(.+)/(manifests|blobs)/([^/]+)$`)
+	regexChallenge = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+	// ManifestTypes are the manifest formats that
+	// we ask registries for, in order of preference.
+	ManifestTypes = []string{
+		"application/vnd.oci.image.index.v1+json",
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.docker.distribution.manifest.list.v2+json",
+		"application/vnd.docker.distribution.manifest.v2+json",
+	}
+
+	// dockerHubHosts are the hostnames of Docker Hub,
+	// which expects official images to be prefixed
+	// with "library/"
+	dockerHubHosts = []string{
+		"docker.io",
+		"index.docker.io",
+		"registry-1.docker.io",
+	}
+)
+
+// OCIRemote talks to a container registry using
+// the OCI Distribution API.
+//
+// https://github.com/opencontainers/distribution-spec/blob/main/spec.md
+type OCIRemote struct {
+	root    string
+	client  *http.Client
+	tokens  *ttlcache.Cache[string, string]
+	breaker *Breaker
+}
+
+type ociToken struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func NewOCIRemote(ctx context.Context, root string, client *http.Client) *OCIRemote {
+	log := logr.FromContextOrDiscard(ctx)
+	c := client
+	// use the default client if we're not given one
+	if client == nil {
+		log.Info("received nil client - using default")
+		c = http.DefaultClient
+		// make sure we use the OpenTelemetry transport
+		c.Transport = otelhttp.NewTransport(http.DefaultTransport)
+	}
+	return &OCIRemote{
+		root:   strings.TrimSuffix(root, "/"),
+		client: c,
+		tokens: ttlcache.New[string, string](ttlcache.WithCapacity[string, string](1000)),
+	}
+}
+
+// ParseOCIPath splits a path in the form of
+// "{name}/(manifests|blobs)/{reference}" into
+// its components.
+func ParseOCIPath(path string) (name, kind, reference string, ok bool) {
+	matches := regexOCIPath.FindStringSubmatch(strings.TrimPrefix(path, "/"))
+	if matches == nil {
+		return "", "", "", false
+	}
+	return matches[1], matches[2], matches[3], true
+}
+
+// BlobDigest returns the digest of the blob
+// that the path refers to.
+func BlobDigest(path string) (string, bool) {
+	_, kind, reference, ok := ParseOCIPath(path)
+	if !ok || kind != OCIBlobs {
+		return "", false
+	}
+	return reference, true
+}
+
+func (o *OCIRemote) String() string {
+	return o.root
+}
+
+func (o *OCIRemote) Exists(ctx context.Context, path string, rctx *schemas.RequestContext) (string, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_oci_exists")
+	defer span.End()
+	resp, err := o.do(ctx, http.MethodHead, path, rctx)
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+	// return the path rather than the URL so that
+	// the download goes through the same auth flow
+	return path, nil
+}
+
+func (o *OCIRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_oci_download")
+	defer span.End()
+	resp, err := o.do(ctx, http.MethodGet, path, rctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// normaliseName adds the implicit "library/"
+// namespace when talking to Docker Hub.
+func (o *OCIRemote) normaliseName(name string) string {
+	if strings.Contains(name, "/") {
+		return name
+	}
+	uri, err := url.Parse(o.root)
+	if err != nil {
+		return name
+	}
+	for _, h := range dockerHubHosts {
+		if uri.Hostname() == h {
+			return "library/" + name
+		}
+	}
+	return name
+}
+
+// do executes a request against the registry. If the
+// registry challenges us for a bearer token, we retrieve
+// one and retry the request.
+func (o *OCIRemote) do(ctx context.Context, method, path string, rctx *schemas.RequestContext) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_oci_do")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("oci").WithValues("Method", method, "Path", path)
+	name, kind, reference, ok := ParseOCIPath(path)
+	if !ok {
+		log.V(1).Info("path is not a manifest or blob")
+		return nil, problem.New(http.StatusNotFound).Errorf("failed to retrieve object from remote")
+	}
+	name = o.normaliseName(name)
+	target := fmt.Sprintf("%s/v2/%s/%s/%s", o.root, name, kind, reference)
+	span.SetAttributes(attribute.String("target", target))
+	log = log.WithValues("Target", target)
+
+	// tokens are scoped to the repository and
+	// to the credentials used to retrieve them
+	tokenKey := name
+	if rctx != nil {
+		tokenKey += rctx.Token
+	}
+	var token string
+	if item := o.tokens.Get(tokenKey); item != nil {
+		log.V(1).Info("using cached bearer token")
+		token = item.Value()
+	}
+	resp, err := o.exec(ctx, method, target, kind, token, rctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+			log.Info("registry rejected our credentials", "WWW-Authenticate", challenge)
+			return nil, problem.New(http.StatusUnauthorized).Errorf("failed to retrieve object from remote")
+		}
+		log.V(1).Info("received bearer challenge", "WWW-Authenticate", challenge)
+		var expiry time.Duration
+		token, expiry, err = o.authenticate(ctx, challenge, name, rctx)
+		if err != nil {
+			return nil, err
+		}
+		o.tokens.Delete(tokenKey)
+		resp, err = o.exec(ctx, method, target, kind, token, rctx)
+		if err != nil {
+			return nil, err
+		}
+		// only remember the token once we know
+		// the registry will accept it
+		if resp.StatusCode != http.StatusUnauthorized {
+			o.tokens.Set(tokenKey, token, expiry)
+		}
+	}
+	if httputils.IsHTTPError(resp.StatusCode) {
+		_ = resp.Body.Close()
+		log.Info("marking request as failure due to unexpected response code", "Code", resp.StatusCode)
+		return nil, problem.New(resp.StatusCode).Errorf("failed to retrieve object from remote")
+	}
+	return resp, nil
+}
+
+func (o *OCIRemote) exec(ctx context.Context, method, target, kind, token string, rctx *schemas.RequestContext) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_oci_exec")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("oci").WithValues("Method", method, "Url", target)
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		log.Error(err, "failed to prepare request")
+		return nil, problem.New(http.StatusBadRequest).Errorf("remote request cannot be created")
+	}
+	if kind == OCIManifests {
+		req.Header.Set("Accept", strings.Join(ManifestTypes, ", "))
+	}
+	// prefer the bearer token since the
+	// registry has explicitly asked for it
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if rctx != nil {
+		httpclient.ApplyAuth(ctx, req, rctx.AuthOpts)
+	}
+	// skip the registry while it's unhealthy
+	if err := o.breaker.Allow(ctx); err != nil {
+		log.V(1).Info("skipping request as the remote circuit is open")
+		return nil, err
+	}
+	start := time.Now()
+	log.V(1).Info("executing request")
+	resp, err := o.client.Do(req)
+	duration := time.Since(start)
+	captureRequestMetrics(ctx, duration, resp)
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, context.Canceled) {
+			o.breaker.Cancel()
+			metricDoCancel.Add(ctx, 1)
+			log.V(1).Error(err, "cancelling request")
+			return nil, err
+		}
+		o.breaker.Failure(ctx, duration, err)
+		log.Error(err, "failed to execute request")
+		return nil, err
+	}
+	// client errors (including auth challenges) mean
+	// that the registry is healthy
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		o.breaker.Failure(ctx, duration, fmt.Errorf("unexpected status code: %d", resp.StatusCode))
+	} else {
+		o.breaker.Success(ctx, duration)
+	}
+	log.Info("remote request completed", "Code", resp.StatusCode, "Duration", duration)
+	return resp, nil
+}
+
+// authenticate retrieves a bearer token from the realm
+// given in the challenge. The credentials of the request
+// context (if any) are passed on to the token server.
+//
+// https://docs.docker.com/registry/spec/auth/token/
+func (o *OCIRemote) authenticate(ctx context.Context, challenge, name string, rctx *schemas.RequestContext) (string, time.Duration, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_oci_authenticate")
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("oci")
+	params := map[string]string{}
+	for _, m := range regexChallenge.FindAllStringSubmatch(challenge, -1) {
+		params[strings.ToLower(m[1])] = m[2]
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		log.Info("challenge does not contain a valid realm", "WWW-Authenticate", challenge)
+		return "", 0, problem.New(http.StatusBadGateway).Errorf("registry sent an invalid authentication challenge")
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", name)
+	}
+	q := realm.Query()
+	if service := params["service"]; service != "" {
+		q.Set("service", service)
+	}
+	q.Set("scope", scope)
+	realm.RawQuery = q.Encode()
+	log = log.WithValues("Realm", realm.String())
+	span.SetAttributes(attribute.String("realm", realm.String()), attribute.String("scope", scope))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		log.Error(err, "failed to prepare token request")
+		return "", 0, problem.New(http.StatusBadGateway).Errorf("registry sent an invalid authentication challenge")
+	}
+	if rctx != nil {
+		httpclient.ApplyAuth(ctx, req, rctx.AuthOpts)
+	}
+	log.V(1).Info("requesting bearer token")
+	resp, err := o.client.Do(req)
+	if err != nil {
+		log.Error(err, "failed to execute token request")
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	if httputils.IsHTTPError(resp.StatusCode) {
+		log.Info("token server rejected our request", "Code", resp.StatusCode)
+		return "", 0, problem.New(http.StatusUnauthorized).Errorf("failed to retrieve object from remote")
+	}
+	var tok ociToken
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		log.Error(err, "failed to decode token response")
+		return "", 0, problem.New(http.StatusBadGateway).Errorf("registry sent an invalid token")
+	}
+	token := tok.Token
+	if token == "" {
+		token = tok.AccessToken
+	}
+	if token == "" {
+		log.Info("token server did not return a token")
+		return "", 0, problem.New(http.StatusBadGateway).Errorf("registry sent an invalid token")
+	}
+	expiry := defaultTokenExpiry
+	if tok.ExpiresIn > 0 {
+		expiry = time.Duration(tok.ExpiresIn) * time.Second
+	}
+	log.V(1).Info("retrieved bearer token", "Expiry", expiry)
+	return token, expiry, nil
+}
@@ -0,0 +1,125 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/pkg/httpclient"
+	"gitlab.com/go-prism/prism3/core/pkg/schemas"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newTestRegistry creates a registry that requires a
+// bearer token, which can be retrieved using Basic auth.
+func newTestRegistry(t *testing.T, tokenRequests *int32) *httptest.Server {
+	var ts *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(tokenRequests, 1)
+		assert.EqualValues(t, "registry.test", r.URL.Query().Get("service"))
+		assert.EqualValues(t, "repository:library/alpine:pull", r.URL.Query().Get("scope"))
+		if user, pass, _ := r.BasicAuth(); user != "user" || pass != "hunter2" {
+			http.Error(w, "Unauthorised.", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"letmein","expires_in":300}`))
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer letmein" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry.test",scope="repository:library/alpine:pull"`, ts.URL))
+			http.Error(w, "Unauthorised.", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/library/alpine/manifests/latest":
+			assert.Contains(t, r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json")
+			_, _ = w.Write([]byte(`{"schemaVersion":2}`))
+		case "/v2/library/alpine/blobs/sha256:abc":
+			_, _ = w.Write([]byte("blob"))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	ts = httptest.NewServer(mux)
+	return ts
+}
+
+func TestOCIRemote_Download(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.New(t))
+	var tokenRequests int32
+	ts := newTestRegistry(t, &tokenRequests)
+	defer ts.Close()
+
+	rctx := &schemas.RequestContext{
+		AuthOpts: httpclient.AuthOpts{
+			Mode:   httpclient.AuthAuthorization,
+			Header: "Authorization",
+			Token:  "Basic dXNlcjpodW50ZXIy",
+		},
+	}
+	rem := NewOCIRemote(ctx, ts.URL, ts.Client())
+
+	t.Run("manifest", func(t *testing.T) {
+		r, err := rem.Download(ctx, "library/alpine/manifests/latest", rctx.Clone())
+		assert.NoError(t, err)
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.EqualValues(t, `{"schemaVersion":2}`, string(data))
+	})
+	t.Run("blob", func(t *testing.T) {
+		uri, err := rem.Exists(ctx, "library/alpine/blobs/sha256:abc", rctx.Clone())
+		assert.NoError(t, err)
+		assert.EqualValues(t, "library/alpine/blobs/sha256:abc", uri)
+
+		r, err := rem.Download(ctx, uri, rctx.Clone())
+		assert.NoError(t, err)
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.EqualValues(t, "blob", string(data))
+	})
+	t.Run("missing blob", func(t *testing.T) {
+		_, err := rem.Exists(ctx, "library/alpine/blobs/sha256:def", rctx.Clone())
+		assert.Error(t, err)
+	})
+	t.Run("bad credentials", func(t *testing.T) {
+		_, err := rem.Download(ctx, "library/alpine/manifests/latest", nil)
+		assert.Error(t, err)
+	})
+	// the token should be reused until it expires
+	assert.EqualValues(t, 2, atomic.LoadInt32(&tokenRequests))
+}
+
+func TestOCIRemote_normaliseName(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.New(t))
+	var cases = []struct {
+		root string
+		name string
+		out  string
+	}{
+		{"https://registry-1.docker.io", "alpine", "library/alpine"},
+		{"https://registry-1.docker.io", "bitnami/redis", "bitnami/redis"},
+		{"https://ghcr.io", "alpine", "alpine"},
+	}
+	for _, tt := range cases {
+		t.Run(strings.Join([]string{tt.root, tt.name}, "/"), func(t *testing.T) {
+			rem := NewOCIRemote(ctx, tt.root, http.DefaultClient)
+			assert.EqualValues(t, tt.out, rem.normaliseName(tt.name))
+		})
+	}
+}
+
+func TestBlobDigest(t *testing.T) {
+	digest, ok := BlobDigest("library/alpine/blobs/sha256:abc")
+	assert.True(t, ok)
+	assert.EqualValues(t, "sha256:abc", digest)
+
+	_, ok = BlobDigest("library/alpine/manifests/sha256:abc")
+	assert.False(t, ok)
+}
@@ -35,12 +35,12 @@ func (p PyPiRemote) Exists(ctx context.Context, path string, _ *schemas.RequestC
 	_, filename, ok := strings.Cut(path, "/")
 	if ok {
 		log.V(1).Info("segmented path", "Filename", filename)
-		return p.getPackage(ctx, filename)
+		return excludeHosted(p.getPackage(ctx, filename))
 	}
-	return p.getPackage(ctx, path)
+	return excludeHosted(p.getPackage(ctx, path))
 }
 
-func (p PyPiRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.Reader, error) {
+func (p PyPiRemote) Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "remote_pypi_download")
 	defer span.End()
 	return p.rem.Download(ctx, path, rctx)
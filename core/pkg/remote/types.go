@@ -9,5 +9,5 @@ import (
 type Remote interface {
 	String() string
 	Exists(ctx context.Context, path string, rctx *schemas.RequestContext) (string, error)
-	Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.Reader, error)
+	Download(ctx context.Context, path string, rctx *schemas.RequestContext) (io.ReadCloser, error)
 }
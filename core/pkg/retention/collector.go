@@ -0,0 +1,287 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package retention
+
+import (
+	"context"
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"gitlab.com/go-prism/prism3/core/pkg/versions"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+func NewCollector(repos *repo.Repos, store storage.Reader) *Collector {
+	return &Collector{
+		repos: repos,
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Collect evicts the artifacts of a remote that fall
+// outside its retention policy. If dryRun is true,
+// nothing is deleted and the report describes what
+// would have been evicted.
+func (c *Collector) Collect(ctx context.Context, id string, dryRun bool) (*model.RetentionReport, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "retention_collect", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.Bool("dry_run", dryRun),
+	))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithValues("ID", id, "DryRun", dryRun)
+	rem, err := c.repos.RemoteRepo.GetRemote(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	log = log.WithValues("Remote", rem.Name)
+	report := &model.RetentionReport{
+		RemoteID:   rem.ID,
+		Candidates: []*model.RetentionCandidate{},
+	}
+	// files in hosted remotes have nowhere
+	// else to come from, so they are never
+	// evicted
+	if rem.Hosted || (rem.RetentionMaxAge == 0 && rem.RetentionMaxSize == 0 && rem.RetentionKeepLatest == 0) {
+		log.V(1).Info("skipping remote without a retention policy", "Hosted", rem.Hosted)
+		return report, nil
+	}
+	artifacts, err := c.repos.ArtifactRepo.ListArtifacts(ctx, []string{rem.ID})
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.store.List(ctx, rem.Name+"/")
+	if err != nil {
+		return nil, err
+	}
+	candidates := plan(rem, artifacts, entries, c.now())
+	log.Info("planned eviction", "Artifacts", len(artifacts), "Objects", len(entries), "Candidates", len(candidates))
+
+	var evicted []string
+	var released int64
+	for _, cd := range candidates {
+		if !dryRun {
+			if !c.evict(ctx, cd) {
+				continue
+			}
+			evicted = append(evicted, cd.artifact.ID)
+			released += cd.size
+			metricEvicted.Add(ctx, 1, attribute.String(attrKeyRemote, rem.Name), attribute.String(attrKeyReason, string(cd.reason)))
+			metricEvictedBytes.Add(ctx, cd.size, attribute.String(attrKeyRemote, rem.Name), attribute.String(attrKeyReason, string(cd.reason)))
+		}
+		report.Count++
+		report.Size += cd.size
+		if len(report.Candidates) < maxReportCandidates {
+			report.Candidates = append(report.Candidates, &model.RetentionCandidate{
+				ID:        cd.artifact.ID,
+				URI:       cd.artifact.URI,
+				Reason:    cd.reason,
+				Size:      cd.size,
+				Downloads: cd.artifact.Downloads,
+				UpdatedAt: cd.artifact.UpdatedAt,
+			})
+		}
+	}
+	if dryRun {
+		return report, nil
+	}
+	if err := c.repos.ArtifactRepo.DeleteArtifacts(ctx, evicted); err != nil {
+		return nil, err
+	}
+	// the files no longer count towards
+	// the storage quota
+	if err := c.repos.BandwidthRepo.ReleaseStorage(ctx, rem.ID, released); err != nil {
+		return nil, err
+	}
+	log.Info("evicted artifacts", "Count", report.Count, "Bytes", report.Size)
+	return report, nil
+}
+
+// evict deletes the objects of an artifact. It returns
+// false if any of them couldn't be deleted, in which
+// case the artifact must be kept so that we try again
+// next time.
+func (c *Collector) evict(ctx context.Context, cd *candidate) bool {
+	log := logr.FromContextOrDiscard(ctx).WithValues("Uri", cd.artifact.URI, "Reason", cd.reason)
+	log.V(1).Info("evicting artifact", "Objects", len(cd.keys))
+	for _, k := range cd.keys {
+		if err := c.store.Delete(ctx, k); err != nil {
+			log.Error(err, "failed to delete object", "Key", k)
+			return false
+		}
+	}
+	return true
+}
+
+// plan decides which artifacts to evict. Each
+// artifact is only evicted for the first rule that
+// it breaks, in the order keep-latest, max-age and
+// then max-size.
+func plan(rem *model.Remote, artifacts []*model.Artifact, entries []storage.Entry, now time.Time) []*candidate {
+	prefix := rem.Name + "/"
+	byURI := make(map[string]*candidate, len(artifacts))
+	for _, a := range artifacts {
+		byURI[a.URI] = &candidate{artifact: a}
+	}
+	// match each object to its artifact. Partitioned
+	// objects are stored in a separate directory, but
+	// older versions stored them beneath the artifact's
+	// path.
+	// Objects without an artifact can't be evicted, so
+	// they don't count towards the size of the remote
+	// either. Otherwise, they would keep the remote
+	// over its limit and every artifact would be
+	// evicted trying to make up the difference.
+	var total int64
+	for _, e := range entries {
+		rel := storage.TrimPartition(strings.TrimPrefix(e.Key, prefix))
+		cd, ok := byURI[rel]
+		if !ok {
+			cd, ok = byURI[path.Dir(rel)]
+		}
+		if !ok {
+			continue
+		}
+		total += e.Size
+		cd.keys = append(cd.keys, e.Key)
+		cd.size += e.Size
+	}
+
+	var result []*candidate
+	evict := func(cd *candidate, reason model.EvictionReason) {
+		if cd.reason != "" {
+			return
+		}
+		cd.reason = reason
+		total -= cd.size
+		result = append(result, cd)
+	}
+
+	// keep the newest versions of each package
+	if rem.RetentionKeepLatest > 0 {
+		packages := map[string]map[string][]*candidate{}
+		for _, a := range artifacts {
+			pkg, version, ok := versions.Parse(rem.Archetype, a.URI)
+			if !ok {
+				continue
+			}
+			if packages[pkg] == nil {
+				packages[pkg] = map[string][]*candidate{}
+			}
+			packages[pkg][version] = append(packages[pkg][version], byURI[a.URI])
+		}
+		// walk the packages in a stable order so
+		// that reports don't change between runs
+		names := make([]string, 0, len(packages))
+		for k := range packages {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			ordered := sortVersions(packages[name])
+			for _, v := range ordered[min(int64(len(ordered)), rem.RetentionKeepLatest):] {
+				for _, cd := range packages[name][v] {
+					evict(cd, model.EvictionReasonKeepLatest)
+				}
+			}
+		}
+	}
+
+	// evict anything that hasn't been
+	// downloaded for too long
+	if rem.RetentionMaxAge > 0 {
+		cutoff := now.Add(-time.Duration(rem.RetentionMaxAge) * time.Hour * 24).Unix()
+		for _, a := range artifacts {
+			if a.UpdatedAt < cutoff {
+				evict(byURI[a.URI], model.EvictionReasonMaxAge)
+			}
+		}
+	}
+
+	// evict the least-recently downloaded
+	// artifacts until we're under the limit
+	if rem.RetentionMaxSize > 0 && total > rem.RetentionMaxSize {
+		lru := make([]*candidate, 0, len(artifacts))
+		for _, a := range artifacts {
+			if cd := byURI[a.URI]; cd.reason == "" && cd.size > 0 {
+				lru = append(lru, cd)
+			}
+		}
+		sort.SliceStable(lru, func(i, j int) bool {
+			if lru[i].artifact.UpdatedAt != lru[j].artifact.UpdatedAt {
+				return lru[i].artifact.UpdatedAt < lru[j].artifact.UpdatedAt
+			}
+			return lru[i].artifact.Downloads < lru[j].artifact.Downloads
+		})
+		for _, cd := range lru {
+			if total <= rem.RetentionMaxSize {
+				break
+			}
+			evict(cd, model.EvictionReasonMaxSize)
+		}
+	}
+	return result
+}
+
+// sortVersions returns the versions of a package, newest
+// first. Semantic versions are compared directly,
+// otherwise we fall back to when each version was first
+// cached.
+func sortVersions(versions map[string][]*candidate) []string {
+	result := make([]string, 0, len(versions))
+	parsed := make(map[string]*semver.Version, len(versions))
+	created := make(map[string]int64, len(versions))
+	for v, cds := range versions {
+		result = append(result, v)
+		if sv, err := semver.NewVersion(v); err == nil {
+			parsed[v] = sv
+		}
+		for _, cd := range cds {
+			if cd.artifact.CreatedAt > created[v] {
+				created[v] = cd.artifact.CreatedAt
+			}
+		}
+	}
+	useSemver := len(parsed) == len(result)
+	sort.SliceStable(result, func(i, j int) bool {
+		if useSemver && !parsed[result[i]].Equal(parsed[result[j]]) {
+			return parsed[result[i]].GreaterThan(parsed[result[j]])
+		}
+		if created[result[i]] != created[result[j]] {
+			return created[result[i]] > created[result[j]]
+		}
+		return result[i] > result[j]
+	})
+	return result
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
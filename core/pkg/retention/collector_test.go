@@ -0,0 +1,92 @@
+package retention
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"testing"
+	"time"
+)
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := int64(60 * 60 * 24)
+
+	artifacts := []*model.Artifact{
+		{ID: "1", URI: "lodash/-/lodash-4.17.20.tgz", CreatedAt: now.Unix() - day*30, UpdatedAt: now.Unix() - day},
+		{ID: "2", URI: "lodash/-/lodash-4.17.21.tgz", CreatedAt: now.Unix() - day*20, UpdatedAt: now.Unix() - day},
+		{ID: "3", URI: "lodash/-/lodash-4.9.0.tgz", CreatedAt: now.Unix(), UpdatedAt: now.Unix() - day},
+		{ID: "4", URI: "react/-/react-18.0.0.tgz", CreatedAt: now.Unix() - day*100, UpdatedAt: now.Unix() - day*100},
+		{ID: "5", URI: "react/-/react-18.1.0.tgz", CreatedAt: now.Unix() - day*10, UpdatedAt: now.Unix() - day*5},
+		{ID: "6", URI: "react", CreatedAt: now.Unix(), UpdatedAt: now.Unix()},
+	}
+	entries := []storage.Entry{
+		{Key: "npm/lodash/-/lodash-4.17.20.tgz", Size: 10},
+		{Key: "npm/lodash/-/lodash-4.17.21.tgz", Size: 10},
+		{Key: "npm/lodash/-/lodash-4.9.0.tgz", Size: 10},
+		{Key: "npm/react/-/react-18.0.0.tgz", Size: 20},
+		{Key: "npm/react/-/react-18.1.0.tgz", Size: 20},
+		// partitioned copies of the same file
+		{Key: "npm/.partitions/abc123/react/-/react-18.1.0.tgz", Size: 20},
+		{Key: "npm/react/-/react-18.1.0.tgz/def456", Size: 20},
+		{Key: "npm/react", Size: 5},
+	}
+
+	reasons := func(candidates []*candidate) map[string]model.EvictionReason {
+		result := map[string]model.EvictionReason{}
+		for _, cd := range candidates {
+			result[cd.artifact.ID] = cd.reason
+		}
+		return result
+	}
+
+	t.Run("no policy", func(t *testing.T) {
+		rem := &model.Remote{Name: "npm", Archetype: model.ArchetypeNpm}
+		assert.Empty(t, plan(rem, artifacts, entries, now))
+	})
+	t.Run("keep latest", func(t *testing.T) {
+		rem := &model.Remote{Name: "npm", Archetype: model.ArchetypeNpm, RetentionKeepLatest: 1}
+		// semantic versions are compared, not
+		// the order they were cached in
+		assert.EqualValues(t, map[string]model.EvictionReason{
+			"1": model.EvictionReasonKeepLatest,
+			"3": model.EvictionReasonKeepLatest,
+			"4": model.EvictionReasonKeepLatest,
+		}, reasons(plan(rem, artifacts, entries, now)))
+	})
+	t.Run("max age", func(t *testing.T) {
+		rem := &model.Remote{Name: "npm", Archetype: model.ArchetypeNpm, RetentionMaxAge: 30}
+		assert.EqualValues(t, map[string]model.EvictionReason{
+			"4": model.EvictionReasonMaxAge,
+		}, reasons(plan(rem, artifacts, entries, now)))
+	})
+	t.Run("max size", func(t *testing.T) {
+		rem := &model.Remote{Name: "npm", Archetype: model.ArchetypeNpm, RetentionMaxSize: 50}
+		candidates := plan(rem, artifacts, entries, now)
+		// the least-recently downloaded artifacts
+		// are evicted first
+		assert.EqualValues(t, map[string]model.EvictionReason{
+			"4": model.EvictionReasonMaxSize,
+			"5": model.EvictionReasonMaxSize,
+		}, reasons(candidates))
+		// every partition is deleted
+		assert.ElementsMatch(t, []string{"npm/react/-/react-18.1.0.tgz", "npm/.partitions/abc123/react/-/react-18.1.0.tgz", "npm/react/-/react-18.1.0.tgz/def456"}, candidates[1].keys)
+		assert.EqualValues(t, 60, candidates[1].size)
+	})
+	t.Run("objects without an artifact are ignored", func(t *testing.T) {
+		rem := &model.Remote{Name: "npm", Archetype: model.ArchetypeNpm, RetentionMaxSize: 50}
+		orphans := append([]storage.Entry{{Key: "npm/left-pad/-/left-pad-1.0.0.tgz", Size: 1000}}, entries...)
+		assert.EqualValues(t, map[string]model.EvictionReason{
+			"4": model.EvictionReasonMaxSize,
+			"5": model.EvictionReasonMaxSize,
+		}, reasons(plan(rem, artifacts, orphans, now)))
+	})
+	t.Run("rules are combined", func(t *testing.T) {
+		rem := &model.Remote{Name: "npm", Archetype: model.ArchetypeNpm, RetentionKeepLatest: 2, RetentionMaxAge: 30, RetentionMaxSize: 40}
+		assert.EqualValues(t, map[string]model.EvictionReason{
+			"3": model.EvictionReasonKeepLatest,
+			"4": model.EvictionReasonMaxAge,
+			"5": model.EvictionReasonMaxSize,
+		}, reasons(plan(rem, artifacts, entries, now)))
+	})
+}
@@ -0,0 +1,49 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package retention
+
+import (
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"gitlab.com/go-prism/prism3/core/pkg/db/repo"
+	"gitlab.com/go-prism/prism3/core/pkg/storage"
+	"time"
+)
+
+// maxReportCandidates limits how many candidates
+// are included in a report, so that large remotes
+// don't produce enormous responses.
+const maxReportCandidates = 1000
+
+// Collector evicts cached files from remotes
+// according to their retention policy.
+type Collector struct {
+	repos *repo.Repos
+	store storage.Reader
+	now   func() time.Time
+}
+
+// candidate is an artifact that
+// will be evicted from storage.
+type candidate struct {
+	artifact *model.Artifact
+	reason   model.EvictionReason
+	// keys are the objects that hold the
+	// artifact, including any partitions
+	keys []string
+	size int64
+}
@@ -0,0 +1,19 @@
+package schemas
+
+import "gorm.io/datatypes"
+
+// AdvisoryPackage is a package that is affected
+// by an advisory. An advisory can affect many
+// packages, so they are stored separately in
+// order to be looked up by name.
+type AdvisoryPackage struct {
+	ID         uint   `gorm:"primaryKey"`
+	AdvisoryID string `gorm:"index"`
+	Ecosystem  string `gorm:"index:idx_advisory_package"`
+	// Name is normalised so that it can be
+	// compared with the names that clients use
+	Name string `gorm:"index:idx_advisory_package"`
+	// Affected is the OSV "affected" object that
+	// describes which versions are vulnerable
+	Affected datatypes.JSON
+}
@@ -16,6 +16,9 @@ type RequestContext struct {
 }
 
 func (r *RequestContext) Clone() *RequestContext {
+	if r == nil {
+		return nil
+	}
 	return &RequestContext{
 		AuthOpts: httpclient.AuthOpts{
 			Mode:   r.Mode,
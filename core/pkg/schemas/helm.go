@@ -1,6 +1,9 @@
 package schemas
 
-import "gorm.io/gorm"
+import (
+	"gorm.io/gorm"
+	"time"
+)
 
 type HelmPackage struct {
 	gorm.Model
@@ -15,6 +18,9 @@ type HelmPackage struct {
 	AppVersion  string
 	KubeVersion string
 	Type        string
+	// Created is when the chart was published,
+	// according to the index of the remote
+	Created time.Time
 
 	RemoteID string `gorm:"index"`
 }
@@ -0,0 +1,15 @@
+package schemas
+
+import "gorm.io/gorm"
+
+// SigningKey is an RSA key pair that Prism
+// uses to sign the indices that it generates.
+type SigningKey struct {
+	gorm.Model
+	Name string `gorm:"index;unique"`
+	// PrivateKey is the PEM-encoded PKCS#1 private key,
+	// encrypted with AES-GCM
+	PrivateKey string
+	// PublicKey is the PEM-encoded PKIX public key
+	PublicKey string
+}
@@ -7,4 +7,18 @@ var NotifyTables = []notify.ListenerOpts{
 		Table:   TableNameStoredUsers,
 		Columns: []string{"preferences"},
 	},
+	// changes to these tables affect
+	// refractions cached by the resolver
+	{
+		Table: TableNameRefractions,
+	},
+	{
+		Table: TableNameRemotes,
+	},
+	{
+		Table: TableNameRemoteSecurities,
+	},
+	{
+		Table: TableNameTransportSecurities,
+	},
 }
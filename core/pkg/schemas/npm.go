@@ -5,8 +5,11 @@ import (
 	"gorm.io/gorm"
 )
 
+// NPMPackage is the package document that was
+// merged from the remotes of a Refraction.
 type NPMPackage struct {
 	gorm.Model
-	Name     string `gorm:"index;unique"`
-	Document datatypes.JSON
+	Refraction string `gorm:"uniqueIndex:idx_npm_package_ref_name"`
+	Name       string `gorm:"index;uniqueIndex:idx_npm_package_ref_name"`
+	Document   datatypes.JSON
 }
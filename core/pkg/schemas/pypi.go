@@ -1,6 +1,9 @@
 package schemas
 
-import "gorm.io/gorm"
+import (
+	"gorm.io/gorm"
+	"time"
+)
 
 type PyPackage struct {
 	gorm.Model
@@ -9,4 +12,17 @@ type PyPackage struct {
 	URL            string
 	Signed         bool
 	RequiresPython string
+	// UploadTime is when the file was published,
+	// if the remote told us
+	UploadTime *time.Time
+}
+
+// PyIndex is the last simple index that was
+// successfully generated for a package in
+// a Refraction.
+type PyIndex struct {
+	gorm.Model
+	Refraction string `gorm:"uniqueIndex:idx_py_index_ref_name"`
+	Name       string `gorm:"uniqueIndex:idx_py_index_ref_name"`
+	Document   string
 }
@@ -0,0 +1,14 @@
+package schemas
+
+// RefRemote joins a refraction to its remotes. The
+// position records the order in which the remotes
+// were configured.
+type RefRemote struct {
+	RefractionID string `gorm:"primaryKey;type:uuid"`
+	RemoteID     string `gorm:"primaryKey;type:uuid"`
+	Position     int    `gorm:"not null;default:0"`
+}
+
+func (RefRemote) TableName() string {
+	return TableNameRefRemotes
+}
@@ -0,0 +1,16 @@
+package schemas
+
+import "gitlab.com/go-prism/prism3/core/internal/graph/model"
+
+// AccessToken is a token that Prism has issued to a
+// user or service account. Only the hash of the
+// secret is kept, so it can't be shown again.
+type AccessToken struct {
+	model.AccessToken `gorm:"embedded"`
+	// Hash is the SHA-256 of the secret
+	Hash string `gorm:"uniqueIndex;not null" json:"-"`
+}
+
+func (AccessToken) TableName() string {
+	return TableNameAccessTokens
+}
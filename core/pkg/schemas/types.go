@@ -1,5 +1,11 @@
 package schemas
 
 const (
-	TableNameStoredUsers = "stored_users"
+	TableNameStoredUsers         = "stored_users"
+	TableNameRefractions         = "refractions"
+	TableNameRemotes             = "remotes"
+	TableNameRemoteSecurities    = "remote_securities"
+	TableNameTransportSecurities = "transport_securities"
+	TableNameRefRemotes          = "ref_remotes"
+	TableNameAccessTokens        = "access_tokens"
 )
@@ -0,0 +1,379 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-logr/logr"
+	"gitlab.com/go-prism/prism3/core/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// tmpDir is where files are written before they
+	// are moved into place. It lives inside the root
+	// so that the rename never crosses filesystems.
+	tmpDir = ".tmp"
+	// tmpExpiry is how long a temporary file can exist
+	// before we assume that its writer has crashed.
+	tmpExpiry = time.Hour * 24
+)
+
+// Filesystem stores objects as files in a local
+// directory. Writes are atomic, so readers never
+// see a partially-written file.
+type Filesystem struct {
+	root string
+}
+
+func NewFilesystem(ctx context.Context, root string) (*Filesystem, error) {
+	log := logr.FromContextOrDiscard(ctx).WithName("fs")
+	if root == "" {
+		return nil, errors.New("filesystem storage requires a path")
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		log.Error(err, "failed to resolve storage path")
+		return nil, err
+	}
+	log.Info("creating filesystem storage", "Path", root)
+	if err := os.MkdirAll(filepath.Join(root, tmpDir), 0o755); err != nil {
+		log.Error(err, "failed to create storage directory")
+		return nil, err
+	}
+	f := &Filesystem{root: root}
+	f.clean(ctx)
+	return f, nil
+}
+
+// clean removes temporary files that were left
+// behind by a process that exited mid-write.
+func (f *Filesystem) clean(ctx context.Context) {
+	log := logr.FromContextOrDiscard(ctx).WithName("fs")
+	entries, err := os.ReadDir(filepath.Join(f.root, tmpDir))
+	if err != nil {
+		log.Error(err, "failed to list temporary files")
+		return
+	}
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil || time.Since(info.ModTime()) < tmpExpiry {
+			continue
+		}
+		log.V(1).Info("removing stale temporary file", "Name", e.Name())
+		_ = os.Remove(filepath.Join(f.root, tmpDir, e.Name()))
+	}
+}
+
+// resolve converts an object key into a path within
+// the root. Keys cannot escape the root directory.
+func (f *Filesystem) resolve(key string) (string, error) {
+	key = strings.TrimPrefix(path.Clean("/"+key), "/")
+	if key == "" || key == tmpDir || strings.HasPrefix(key, tmpDir+"/") {
+		return "", fmt.Errorf("invalid key: %w", fs.ErrInvalid)
+	}
+	return filepath.Join(f.root, filepath.FromSlash(key)), nil
+}
+
+func (f *Filesystem) Get(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_fs_get", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("fs").WithValues("Path", path)
+	log.V(1).Info("opening file")
+	target, err := f.resolve(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	file, err := os.Open(target)
+	if err != nil {
+		log.V(1).Info("failed to open file", "Error", err.Error())
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		log.Error(err, "failed to stat file")
+		return nil, 0, err
+	}
+	if info.IsDir() {
+		_ = file.Close()
+		return nil, 0, &fs.PathError{Op: "open", Path: target, Err: fs.ErrNotExist}
+	}
+	return file, info.Size(), nil
+}
+
+func (f *Filesystem) GetRange(ctx context.Context, path string, offset int64, etag string) (io.ReadCloser, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_fs_getRange", trace.WithAttributes(attribute.String("path", path), attribute.Int64("offset", offset)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("fs").WithValues("Path", path, "Offset", offset)
+	r, _, err := f.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	// Get always returns an *os.File
+	file := r.(*os.File)
+	if etag != "" {
+		// check the file that we've opened rather than
+		// the path, since it may have been replaced
+		info, err := file.Stat()
+		if err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		if fileETag(info) != etag {
+			_ = file.Close()
+			log.V(1).Info("file has been modified", "ETag", etag)
+			return nil, ErrModified
+		}
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		_ = file.Close()
+		log.Error(err, "failed to seek file")
+		return nil, err
+	}
+	return file, nil
+}
+
+// Stat returns the metadata of a file. The ETag is
+// derived from the size and modification time, which
+// change whenever Put replaces the file.
+func (f *Filesystem) Stat(ctx context.Context, path string) (*ObjectInfo, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_fs_stat", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("fs").WithValues("Path", path)
+	target, err := f.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		log.V(1).Info("failed to stat file", "Error", err.Error())
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, &fs.PathError{Op: "stat", Path: target, Err: fs.ErrNotExist}
+	}
+	return &ObjectInfo{
+		Size:    info.Size(),
+		ETag:    fileETag(info),
+		ModTime: info.ModTime(),
+	}, nil
+}
+
+func fileETag(info fs.FileInfo) string {
+	return fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size())
+}
+
+// Put writes the object to a temporary file and then
+// renames it into place. If the reader fails, the
+// temporary file is removed and the existing object
+// (if any) is left untouched.
+func (f *Filesystem) Put(ctx context.Context, path string, r io.Reader) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_fs_put", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("fs").WithValues("Path", path)
+	log.V(1).Info("writing file")
+	target, err := f.resolve(path)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Join(f.root, tmpDir), "upload-*")
+	if err != nil {
+		log.Error(err, "failed to create temporary file")
+		return err
+	}
+	// clean up if anything goes wrong. Once the
+	// rename succeeds, this does nothing.
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	n, err := io.Copy(tmp, r)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		log.Error(err, "failed to write temporary file")
+		return err
+	}
+	// CreateTemp only allows the owner to read the file
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		log.Error(err, "failed to set file permissions")
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		log.Error(err, "failed to create directory")
+		return err
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		log.Error(err, "failed to move file into place")
+		return err
+	}
+	log.V(1).Info("successfully wrote file", "Bytes", n)
+	return nil
+}
+
+func (f *Filesystem) Head(ctx context.Context, path string) (bool, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_fs_head", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("fs").WithValues("Path", path)
+	target, err := f.resolve(path)
+	if err != nil {
+		return false, err
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		log.Error(err, "failed to stat file")
+		return false, err
+	}
+	return !info.IsDir(), nil
+}
+
+// Size counts the objects whose key starts with
+// the given prefix (which doesn't need to be a
+// directory).
+func (f *Filesystem) Size(ctx context.Context, path string) (*BucketSize, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_fs_size", trace.WithAttributes(attribute.String("prefix", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("fs").WithValues("Prefix", path)
+	result := &BucketSize{}
+	err := f.list(path, func(_ string, info fs.FileInfo) {
+		result.Count++
+		result.Bytes += info.Size()
+	})
+	if err != nil {
+		log.Error(err, "failed to list files")
+		return nil, err
+	}
+	return result, nil
+}
+
+func (f *Filesystem) List(ctx context.Context, prefix string) ([]Entry, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_fs_list", trace.WithAttributes(attribute.String("prefix", prefix)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("fs").WithValues("Prefix", prefix)
+	var result []Entry
+	err := f.list(prefix, func(key string, info fs.FileInfo) {
+		result = append(result, Entry{Key: key, Size: info.Size()})
+	})
+	if err != nil {
+		log.Error(err, "failed to list files")
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete removes a file along with any directories
+// that are left empty, so that the tree doesn't fill
+// up with leftovers.
+func (f *Filesystem) Delete(ctx context.Context, path string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_fs_delete", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("fs").WithValues("Path", path)
+	log.V(1).Info("deleting file")
+	target, err := f.resolve(path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		log.Error(err, "failed to stat file")
+		return err
+	}
+	// don't remove directories, since they may
+	// contain other objects
+	if info.IsDir() {
+		return nil
+	}
+	if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Error(err, "failed to delete file")
+		return err
+	}
+	// os.Remove refuses to delete directories
+	// that aren't empty, so this stops as soon
+	// as it reaches one that is still in use
+	for dir := filepath.Dir(target); dir != f.root && strings.HasPrefix(dir, f.root); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+	return nil
+}
+
+// list walks every object whose key starts with the prefix.
+func (f *Filesystem) list(prefix string, iter func(key string, info fs.FileInfo)) error {
+	// only walk the directory that
+	// could contain matching keys
+	start := f.root
+	if dir := path.Dir(prefix); dir != "." && dir != "/" {
+		start = filepath.Join(f.root, filepath.FromSlash(strings.TrimPrefix(path.Clean("/"+dir), "/")))
+	}
+	err := filepath.WalkDir(start, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(f.root, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if d.IsDir() {
+			if key == tmpDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			// the file was removed
+			// while we were walking
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		iter(key, info)
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
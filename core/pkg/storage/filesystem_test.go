@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFilesystem(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	f, err := NewFilesystem(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	t.Run("missing file", func(t *testing.T) {
+		ok, err := f.Head(ctx, "foo/missing.txt")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		_, _, err = f.Get(ctx, "foo/missing.txt")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+	t.Run("put and get", func(t *testing.T) {
+		require.NoError(t, f.Put(ctx, "foo/bar.txt", strings.NewReader("hello")))
+		ok, err := f.Head(ctx, "foo/bar.txt")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		r, size, err := f.Get(ctx, "foo/bar.txt")
+		require.NoError(t, err)
+		defer r.Close()
+		assert.EqualValues(t, 5, size)
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.EqualValues(t, "hello", string(data))
+	})
+	t.Run("directories are not files", func(t *testing.T) {
+		ok, err := f.Head(ctx, "foo")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		_, _, err = f.Get(ctx, "foo")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+	t.Run("failed write keeps existing file", func(t *testing.T) {
+		err := f.Put(ctx, "foo/bar.txt", io.MultiReader(strings.NewReader("goodbye"), iotest.ErrReader(errors.New("connection reset"))))
+		assert.Error(t, err)
+
+		r, _, err := f.Get(ctx, "foo/bar.txt")
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.EqualValues(t, "hello", string(data))
+
+		// the temporary file is cleaned up
+		entries, err := os.ReadDir(filepath.Join(f.root, tmpDir))
+		assert.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+	t.Run("stat and get range", func(t *testing.T) {
+		info, err := f.Stat(ctx, "foo/bar.txt")
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, info.Size)
+		assert.NotEmpty(t, info.ETag)
+		assert.False(t, info.ModTime.IsZero())
+
+		r, err := f.GetRange(ctx, "foo/bar.txt", 2, info.ETag)
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.EqualValues(t, "llo", string(data))
+
+		_, err = f.GetRange(ctx, "foo/bar.txt", 2, `"not-the-etag"`)
+		assert.ErrorIs(t, err, ErrModified)
+
+		_, err = f.Stat(ctx, "foo")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+	t.Run("keys cannot escape the root", func(t *testing.T) {
+		require.NoError(t, f.Put(ctx, "../../escape.txt", strings.NewReader("hello")))
+		_, err := os.Stat(filepath.Join(f.root, "escape.txt"))
+		assert.NoError(t, err)
+
+		assert.Error(t, f.Put(ctx, ".tmp/foo.txt", strings.NewReader("hello")))
+	})
+	t.Run("concurrent writes", func(t *testing.T) {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			j := i
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, f.Put(ctx, "concurrent.txt", strings.NewReader(strings.Repeat(fmt.Sprint(j), 1000))))
+			}()
+		}
+		wg.Wait()
+		r, _, err := f.Get(ctx, "concurrent.txt")
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		// the file must come from exactly one writer
+		assert.Len(t, data, 1000)
+		assert.EqualValues(t, strings.Repeat(string(data[0]), 1000), string(data))
+	})
+}
+
+func TestFilesystem_Partitions(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	f, err := NewFilesystem(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	// the unpartitioned file and its partitioned
+	// copy must be able to exist at the same time
+	keys := map[string]string{
+		"generic/foo/bar.txt": "hello",
+		filepath.Join("generic", PartitionPath("foo/bar.txt", "abc123")): "world",
+	}
+	for k, v := range keys {
+		require.NoError(t, f.Put(ctx, k, strings.NewReader(v)))
+	}
+	for k, v := range keys {
+		r, _, err := f.Get(ctx, k)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		_ = r.Close()
+		assert.NoError(t, err)
+		assert.EqualValues(t, v, string(data))
+	}
+}
+
+func TestTrimPartition(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out string
+	}{
+		{"foo/bar.txt", "foo/bar.txt"},
+		{".partitions/abc123/foo/bar.txt", "foo/bar.txt"},
+		{".partitions/abc123/bar.txt", "bar.txt"},
+		{".partitions/bar.txt", ".partitions/bar.txt"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.EqualValues(t, tt.out, TrimPartition(tt.in))
+		})
+	}
+}
+
+func TestFilesystem_Size(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	f, err := NewFilesystem(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	for _, k := range []string{"remote-a/foo.txt", "remote-a/bar/baz.txt", "remote-ab/foo.txt", "remote-b/foo.txt"} {
+		require.NoError(t, f.Put(ctx, k, strings.NewReader("hello")))
+	}
+
+	var cases = []struct {
+		prefix string
+		count  int64
+	}{
+		{"", 4},
+		{"remote-a/", 2},
+		{"remote-a", 3},
+		{"remote-a/bar/", 1},
+		{"remote-c/", 0},
+	}
+	for _, tt := range cases {
+		t.Run(tt.prefix, func(t *testing.T) {
+			size, err := f.Size(ctx, tt.prefix)
+			require.NoError(t, err)
+			assert.EqualValues(t, tt.count, size.Count)
+			assert.EqualValues(t, tt.count*5, size.Bytes)
+		})
+	}
+}
+
+func TestFilesystem_Delete(t *testing.T) {
+	ctx := logr.NewContext(context.TODO(), testr.NewWithOptions(t, testr.Options{Verbosity: 10}))
+	f, err := NewFilesystem(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	for _, k := range []string{"remote-a/foo/bar.txt", "remote-a/foo/qux/abc123", "remote-a/baz.txt"} {
+		require.NoError(t, f.Put(ctx, k, strings.NewReader("hello")))
+	}
+
+	objects, err := f.List(ctx, "remote-a/foo/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []Entry{{Key: "remote-a/foo/bar.txt", Size: 5}, {Key: "remote-a/foo/qux/abc123", Size: 5}}, objects)
+
+	// missing files are ignored
+	assert.NoError(t, f.Delete(ctx, "remote-a/missing.txt"))
+	// directories are left alone
+	assert.NoError(t, f.Delete(ctx, "remote-a/foo"))
+
+	for _, o := range objects {
+		assert.NoError(t, f.Delete(ctx, o.Key))
+	}
+	ok, err := f.Head(ctx, "remote-a/baz.txt")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// empty directories are cleaned up
+	_, err = os.Stat(filepath.Join(f.root, "remote-a", "foo"))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = os.Stat(filepath.Join(f.root, "remote-a"))
+	assert.NoError(t, err)
+}
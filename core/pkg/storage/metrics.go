@@ -49,6 +49,14 @@ var (
 		"prism.core.storage.s3.head.error.total",
 		instrument.WithUnit(unit.Dimensionless),
 	)
+	metricDeleteCount, _ = meter.SyncInt64().Counter(
+		"prism.core.storage.s3.delete.total",
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	metricDeleteErrCount, _ = meter.SyncInt64().Counter(
+		"prism.core.storage.s3.delete.error.total",
+		instrument.WithUnit(unit.Dimensionless),
+	)
 )
 
 const (
@@ -20,9 +20,12 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"strings"
 )
 
 type NoOp struct {
@@ -35,12 +38,37 @@ func NewNoOp() *NoOp {
 	}
 }
 
-func (n *NoOp) Get(_ context.Context, path string) (io.Reader, int64, error) {
+func (n *NoOp) Get(_ context.Context, path string) (io.ReadCloser, int64, error) {
 	val, ok := n.Data[path]
 	if !ok {
 		return nil, 0, errors.New("not found")
 	}
-	return bytes.NewReader(val), int64(len(val)), nil
+	return io.NopCloser(bytes.NewReader(val)), int64(len(val)), nil
+}
+
+func (n *NoOp) GetRange(_ context.Context, path string, offset int64, etag string) (io.ReadCloser, error) {
+	val, ok := n.Data[path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	if etag != "" && etag != fmt.Sprintf(`"%x"`, sha256.Sum256(val)) {
+		return nil, ErrModified
+	}
+	if offset > int64(len(val)) {
+		offset = int64(len(val))
+	}
+	return io.NopCloser(bytes.NewReader(val[offset:])), nil
+}
+
+func (n *NoOp) Stat(_ context.Context, path string) (*ObjectInfo, error) {
+	val, ok := n.Data[path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &ObjectInfo{
+		Size: int64(len(val)),
+		ETag: fmt.Sprintf(`"%x"`, sha256.Sum256(val)),
+	}, nil
 }
 
 func (n *NoOp) Put(_ context.Context, path string, r io.Reader) error {
@@ -68,3 +96,18 @@ func (n *NoOp) Size(context.Context, string) (*BucketSize, error) {
 		Bytes: size,
 	}, nil
 }
+
+func (n *NoOp) List(_ context.Context, prefix string) ([]Entry, error) {
+	var result []Entry
+	for k, v := range n.Data {
+		if strings.HasPrefix(k, prefix) {
+			result = append(result, Entry{Key: k, Size: int64(len(v))})
+		}
+	}
+	return result, nil
+}
+
+func (n *NoOp) Delete(_ context.Context, path string) error {
+	delete(n.Data, path)
+	return nil
+}
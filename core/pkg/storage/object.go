@@ -0,0 +1,112 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var errInvalidSeek = errors.New("storage: invalid seek position")
+
+// Object is a lazily-opened view of an object in
+// storage. It knows the metadata of the object up
+// front and only streams the content once it is
+// read. Seeking discards the current stream so that
+// the next read starts at the new offset, which
+// allows clients to request partial content without
+// downloading the rest of the object.
+type Object struct {
+	ctx    context.Context
+	store  Reader
+	path   string
+	info   *ObjectInfo
+	offset int64
+	body   io.ReadCloser
+}
+
+// Open retrieves the metadata of an object and
+// prepares it to be read. The caller must close
+// the returned object.
+func Open(ctx context.Context, store Reader, path string) (*Object, error) {
+	info, err := store.Stat(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return &Object{
+		ctx:   ctx,
+		store: store,
+		path:  path,
+		info:  info,
+	}, nil
+}
+
+// Info returns the metadata of the object.
+func (o *Object) Info() *ObjectInfo {
+	return o.info
+}
+
+func (o *Object) Read(p []byte) (int, error) {
+	if o.offset >= o.info.Size {
+		return 0, io.EOF
+	}
+	if o.body == nil {
+		// make sure that every range comes
+		// from the same version of the object
+		body, err := o.store.GetRange(o.ctx, o.path, o.offset, o.info.ETag)
+		if err != nil {
+			return 0, err
+		}
+		o.body = body
+	}
+	n, err := o.body.Read(p)
+	o.offset += int64(n)
+	return n, err
+}
+
+func (o *Object) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += o.offset
+	case io.SeekEnd:
+		offset += o.info.Size
+	}
+	if offset < 0 {
+		return 0, errInvalidSeek
+	}
+	if offset != o.offset {
+		o.reset()
+		o.offset = offset
+	}
+	return offset, nil
+}
+
+func (o *Object) Close() error {
+	o.reset()
+	return nil
+}
+
+// reset closes the current stream (if any)
+// so that the next read opens a new one.
+func (o *Object) reset() {
+	if o.body != nil {
+		_ = o.body.Close()
+		o.body = nil
+	}
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"testing"
+)
+
+func TestObject(t *testing.T) {
+	ctx := context.TODO()
+	store := NewNoOp()
+	store.Data["foo.txt"] = []byte("hello world")
+
+	obj, err := Open(ctx, store, "foo.txt")
+	require.NoError(t, err)
+	defer obj.Close()
+	assert.EqualValues(t, 11, obj.Info().Size)
+	assert.NotEmpty(t, obj.Info().ETag)
+
+	// read part of the object
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(obj, buf)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "hello", string(buf))
+
+	// seek to the middle of the object
+	n, err := obj.Seek(6, io.SeekStart)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, n)
+	data, err := io.ReadAll(obj)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "world", string(data))
+
+	// seek relative to the end
+	n, err = obj.Seek(-5, io.SeekEnd)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, n)
+
+	_, err = obj.Seek(-1, io.SeekStart)
+	assert.Error(t, err)
+}
+
+func TestOpen_Missing(t *testing.T) {
+	_, err := Open(context.TODO(), NewNoOp(), "missing.txt")
+	assert.Error(t, err)
+}
+
+func TestObject_Modified(t *testing.T) {
+	ctx := context.TODO()
+	store := NewNoOp()
+	store.Data["foo.txt"] = []byte("hello world")
+
+	obj, err := Open(ctx, store, "foo.txt")
+	require.NoError(t, err)
+	defer obj.Close()
+
+	// ranges must not mix two versions of the object
+	store.Data["foo.txt"] = []byte("HELLO WORLD")
+	_, err = obj.Seek(6, io.SeekStart)
+	assert.NoError(t, err)
+	_, err = io.ReadAll(obj)
+	assert.ErrorIs(t, err, ErrModified)
+}
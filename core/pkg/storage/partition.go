@@ -0,0 +1,46 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+package storage
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// PartitionDir is the directory within a remote that
+// partitioned copies of files are stored in. Keeping them
+// apart from the other files means that a partition is
+// never nested beneath the unpartitioned copy of the same
+// file, which a filesystem is unable to store.
+const PartitionDir = ".partitions"
+
+// PartitionPath returns the path of the partitioned
+// copy of a file relative to its remote
+// (i.e., .partitions/<partition>/<path>).
+func PartitionPath(path, partition string) string {
+	return filepath.Join(PartitionDir, partition, path)
+}
+
+// TrimPartition returns the path of a file relative
+// to its remote with the partition removed.
+func TrimPartition(path string) string {
+	parts := strings.SplitN(path, "/", 3)
+	if len(parts) == 3 && parts[0] == PartitionDir {
+		return parts[2]
+	}
+	return path
+}
@@ -18,9 +18,10 @@
 package storage
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -32,13 +33,14 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"io"
+	"net/http"
+	"strconv"
 )
 
 type S3 struct {
 	bucket     *string
 	client     *s3.Client
 	signClient *s3.PresignClient
-	downloader *manager.Downloader
 	uploader   *manager.Uploader
 }
 
@@ -74,32 +76,94 @@ func NewS3(ctx context.Context, opt S3Options) (*S3, error) {
 		bucket:     aws.String(opt.Bucket),
 		client:     client,
 		signClient: s3.NewPresignClient(client),
-		downloader: manager.NewDownloader(client),
-		uploader:   manager.NewUploader(client),
+		// the uploader only buffers one part per
+		// goroutine, so memory use is bounded
+		// regardless of the size of the object
+		uploader: manager.NewUploader(client),
 	}, nil
 }
 
-func (s *S3) Get(ctx context.Context, path string) (io.Reader, int64, error) {
+func (s *S3) Get(ctx context.Context, path string) (io.ReadCloser, int64, error) {
 	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_s3_get", trace.WithAttributes(attribute.String("path", path)))
 	defer span.End()
 	log := logr.FromContextOrDiscard(ctx).WithName("s3").WithValues("Path", path, "Bucket", s.bucket)
 	log.V(1).Info("downloading object")
-	// create a temporary buffer
-	buf := manager.NewWriteAtBuffer(nil)
-	// download the file
-	n, err := s.downloader.Download(ctx, buf, &s3.GetObjectInput{
+	// stream the object rather than buffering
+	// it, so that large objects don't need to
+	// fit in memory
+	result, err := s.client.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: s.bucket,
 		Key:    aws.String(path),
 	})
-	metricGetSize.Add(ctx, n, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
-	log.V(1).Info("completed download", "Bytes", n)
 	if err != nil {
 		metricGetErrCount.Add(ctx, 1, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
 		log.Error(err, "failed to successfully download object")
-		return nil, n, err
+		return nil, 0, err
 	}
-	log.V(1).Info("successfully downloaded object")
-	return bytes.NewReader(buf.Bytes()), n, nil
+	metricGetSize.Add(ctx, result.ContentLength, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
+	log.V(1).Info("successfully opened object", "Bytes", result.ContentLength)
+	return result.Body, result.ContentLength, nil
+}
+
+func (s *S3) GetRange(ctx context.Context, path string, offset int64, etag string) (io.ReadCloser, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_s3_getRange", trace.WithAttributes(attribute.String("path", path), attribute.Int64("offset", offset)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("s3").WithValues("Path", path, "Bucket", s.bucket, "Offset", offset)
+	log.V(1).Info("downloading object range")
+	input := &s3.GetObjectInput{
+		Bucket: s.bucket,
+		Key:    aws.String(path),
+	}
+	// S3 rejects ranges that start past the end of the
+	// object, so only ask for one if we need to
+	if offset > 0 {
+		input.Range = aws.String("bytes=" + strconv.FormatInt(offset, 10) + "-")
+	}
+	// make sure that the object hasn't been
+	// replaced since it was last read
+	if etag != "" {
+		input.IfMatch = aws.String(etag)
+	}
+	result, err := s.client.GetObject(ctx, input)
+	if err != nil {
+		var respErr *awshttp.ResponseError
+		if errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusPreconditionFailed {
+			log.V(1).Info("object has been modified", "ETag", etag)
+			return nil, ErrModified
+		}
+		metricGetErrCount.Add(ctx, 1, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
+		log.Error(err, "failed to successfully download object range")
+		return nil, err
+	}
+	metricGetSize.Add(ctx, result.ContentLength, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
+	log.V(1).Info("successfully opened object range", "Bytes", result.ContentLength)
+	return result.Body, nil
+}
+
+func (s *S3) Stat(ctx context.Context, path string) (*ObjectInfo, error) {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_s3_stat", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("s3").WithValues("Path", path, "Bucket", s.bucket)
+	log.V(1).Info("retrieving object metadata")
+	result, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: s.bucket,
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		metricHeadErrCount.Add(ctx, 1, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
+		log.Error(err, "failed to HEAD s3 object")
+		return nil, err
+	}
+	metricHeadCount.Add(ctx, 1, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
+	info := &ObjectInfo{
+		Size: result.ContentLength,
+		// S3 returns the ETag already quoted
+		ETag: aws.ToString(result.ETag),
+	}
+	if result.LastModified != nil {
+		info.ModTime = *result.LastModified
+	}
+	return info, nil
 }
 
 func (s *S3) Put(ctx context.Context, path string, r io.Reader) error {
@@ -158,6 +222,41 @@ func (s *S3) Size(ctx context.Context, path string) (*BucketSize, error) {
 	}, nil
 }
 
+func (s *S3) List(ctx context.Context, prefix string) ([]Entry, error) {
+	var result []Entry
+	err := s.listObjectsV2(ctx, prefix, func(t types.Object) {
+		result = append(result, Entry{
+			Key:  aws.ToString(t.Key),
+			Size: t.Size,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete removes an object from the bucket. S3
+// doesn't complain if the object is missing.
+func (s *S3) Delete(ctx context.Context, path string) error {
+	ctx, span := otel.Tracer(tracing.DefaultTracerName).Start(ctx, "storage_s3_delete", trace.WithAttributes(attribute.String("path", path)))
+	defer span.End()
+	log := logr.FromContextOrDiscard(ctx).WithName("s3").WithValues("Path", path, "Bucket", s.bucket)
+	log.V(1).Info("deleting object")
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: s.bucket,
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		metricDeleteErrCount.Add(ctx, 1, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
+		log.Error(err, "failed to delete object")
+		return err
+	}
+	metricDeleteCount.Add(ctx, 1, attribute.String(attributeKeyPath, path), attribute.String(attributeKeyBucket, *s.bucket))
+	log.V(1).Info("successfully deleted object")
+	return nil
+}
+
 // listObjectsV2 lists an entire S3 bucket and
 // allows the caller to do something with each
 // object.
@@ -0,0 +1,51 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+)
+
+const (
+	DriverS3         = "s3"
+	DriverFilesystem = "filesystem"
+)
+
+// Options selects the storage backend.
+type Options struct {
+	Driver string `split_words:"true" default:"s3"`
+	// Path is the directory that the
+	// filesystem driver saves files to.
+	Path string `split_words:"true"`
+}
+
+// New creates the storage backend
+// selected by the options.
+func New(ctx context.Context, opt Options, s3 S3Options) (Reader, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("creating storage backend", "Driver", opt.Driver)
+	switch opt.Driver {
+	case DriverS3, "":
+		return NewS3(ctx, s3)
+	case DriverFilesystem:
+		return NewFilesystem(ctx, opt.Path)
+	}
+	return nil, fmt.Errorf("unknown storage driver: %s", opt.Driver)
+}
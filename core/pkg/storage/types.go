@@ -19,17 +19,56 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"io"
+	"time"
 )
 
+// ErrModified is returned by GetRange when the object
+// no longer matches the ETag that was given.
+var ErrModified = errors.New("storage: object has been modified")
+
 type BucketSize struct {
 	Count int64
 	Bytes int64
 }
 
+// ObjectInfo describes an object without
+// needing to read its content.
+type ObjectInfo struct {
+	Size int64
+	// ETag is a quoted entity tag that changes
+	// whenever the content of the object does.
+	ETag    string
+	ModTime time.Time
+}
+
+// Entry is an object found while listing storage.
+type Entry struct {
+	Key  string
+	Size int64
+}
+
 type Reader interface {
-	Get(ctx context.Context, path string) (io.Reader, int64, error)
+	// Get streams an object from storage along with its
+	// size. The caller must close the returned reader.
+	Get(ctx context.Context, path string) (io.ReadCloser, int64, error)
+	// Put streams an object into storage. If the reader
+	// returns an error, the object must not be saved.
 	Put(ctx context.Context, path string, r io.Reader) error
+	// GetRange streams an object from storage starting
+	// at the given offset. If etag is set, ErrModified is
+	// returned unless the object still matches it. The
+	// caller must close the returned reader.
+	GetRange(ctx context.Context, path string, offset int64, etag string) (io.ReadCloser, error)
+	// Stat returns the metadata of an object.
+	Stat(ctx context.Context, path string) (*ObjectInfo, error)
 	Head(ctx context.Context, path string) (bool, error)
 	Size(ctx context.Context, path string) (*BucketSize, error)
+	// List returns every object whose key
+	// starts with the given prefix.
+	List(ctx context.Context, prefix string) ([]Entry, error)
+	// Delete removes an object. Deleting an object
+	// that doesn't exist is not an error.
+	Delete(ctx context.Context, path string) error
 }
@@ -0,0 +1,9 @@
+package tasks
+
+const (
+	TypeImportAdvisories = "import@advisories"
+)
+
+type ImportAdvisoriesPayload struct {
+	Sources []string
+}
@@ -0,0 +1,12 @@
+package tasks
+
+const (
+	TypeVerifyRemote    = "verify@remote"
+	TypeVerifyRemoteAll = "verify@remote-all"
+)
+
+type VerifyRemotePayload struct {
+	RemoteID string
+}
+
+type VerifyRemoteAllPayload struct{}
@@ -0,0 +1,12 @@
+package tasks
+
+const (
+	TypeCollectGarbage    = "gc@remote"
+	TypeCollectGarbageAll = "gc@remote-all"
+)
+
+type CollectGarbagePayload struct {
+	RemoteID string
+}
+
+type CollectGarbageAllPayload struct{}
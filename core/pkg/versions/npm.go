@@ -0,0 +1,121 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package versions
+
+import (
+	"encoding/json"
+	"github.com/Masterminds/semver/v3"
+	"time"
+)
+
+// KeepFunc decides whether a version of a package
+// should be kept. The publish time is zero if it
+// isn't known.
+type KeepFunc = func(version string, published time.Time) bool
+
+// FilterNPM removes versions from an NPM package
+// document and returns the number that were removed.
+//
+// Dist-tags that point to a removed version are
+// dropped, except for "latest" which is moved to
+// the newest version that remains.
+func FilterNPM(doc string, keep KeepFunc) (string, int, error) {
+	var packument map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(doc), &packument); err != nil {
+		return "", 0, err
+	}
+	var versions map[string]json.RawMessage
+	var times map[string]string
+	var tags map[string]string
+	for k, v := range map[string]any{"versions": &versions, "time": &times, "dist-tags": &tags} {
+		if raw, ok := packument[k]; ok {
+			if err := json.Unmarshal(raw, v); err != nil {
+				return "", 0, err
+			}
+		}
+	}
+	var removed int
+	for version := range versions {
+		published, _ := time.Parse(time.RFC3339, times[version])
+		if keep(version, published) {
+			continue
+		}
+		delete(versions, version)
+		delete(times, version)
+		removed++
+	}
+	if removed == 0 {
+		return doc, 0, nil
+	}
+	for tag, version := range tags {
+		if _, ok := versions[version]; ok {
+			continue
+		}
+		delete(tags, tag)
+		if tag == "latest" {
+			if v := Newest(versions); v != "" {
+				tags[tag] = v
+			}
+		}
+	}
+	for k, v := range map[string]any{"versions": versions, "time": times, "dist-tags": tags} {
+		if _, ok := packument[k]; !ok {
+			continue
+		}
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return "", 0, err
+		}
+		packument[k] = raw
+	}
+	data, err := json.Marshal(packument)
+	if err != nil {
+		return "", 0, err
+	}
+	return string(data), removed, nil
+}
+
+// Newest returns the highest version that isn't
+// a pre-release, or the highest pre-release if
+// there are no other versions.
+func Newest[T any](versions map[string]T) string {
+	var best, bestPre *semver.Version
+	for v := range versions {
+		sv, err := semver.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		if sv.Prerelease() != "" {
+			if bestPre == nil || sv.GreaterThan(bestPre) {
+				bestPre = sv
+			}
+			continue
+		}
+		if best == nil || sv.GreaterThan(best) {
+			best = sv
+		}
+	}
+	switch {
+	case best != nil:
+		return best.Original()
+	case bestPre != nil:
+		return bestPre.Original()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+package versions
+
+import (
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"path"
+	"strings"
+	"unicode"
+)
+
+// Parse extracts the package and version that a
+// file belongs to. It returns false if the archetype
+// doesn't have versions or the path isn't a
+// package file.
+func Parse(archetype model.Archetype, uri string) (string, string, bool) {
+	file := path.Base(uri)
+	switch archetype {
+	case model.ArchetypeMaven:
+		// <group>/<artifact>/<version>/<artifact>-<version>[-classifier].<ext>
+		parts := strings.Split(uri, "/")
+		if len(parts) < 4 {
+			return "", "", false
+		}
+		version := parts[len(parts)-2]
+		artifact := parts[len(parts)-3]
+		if !strings.HasPrefix(file, artifact+"-"+version) {
+			return "", "", false
+		}
+		return strings.Join(parts[:len(parts)-2], "/"), version, true
+	case model.ArchetypeNpm:
+		// [@scope/]<name>/-/<name>-<version>.tgz
+		pkg, _, ok := strings.Cut(uri, "/-/")
+		if !ok || !strings.HasSuffix(file, ".tgz") {
+			return "", "", false
+		}
+		version, ok := strings.CutPrefix(strings.TrimSuffix(file, ".tgz"), path.Base(pkg)+"-")
+		if !ok || version == "" {
+			return "", "", false
+		}
+		return pkg, version, true
+	case model.ArchetypeGo:
+		// <module>/@v/<version>.<ext>
+		mod, _, ok := strings.Cut(uri, "/@v/")
+		if !ok {
+			return "", "", false
+		}
+		version := strings.TrimSuffix(file, path.Ext(file))
+		if version == "list" || version == "" {
+			return "", "", false
+		}
+		return mod, version, true
+	case model.ArchetypePip:
+		// <name>-<version>-<tags>.whl or <name>-<version>.tar.gz
+		var name, version string
+		if strings.HasSuffix(file, ".whl") {
+			parts := strings.Split(file, "-")
+			if len(parts) < 3 {
+				return "", "", false
+			}
+			name, version = parts[0], parts[1]
+		} else {
+			base, ok := trimSuffixes(file, ".tar.gz", ".zip")
+			if !ok {
+				return "", "", false
+			}
+			i := strings.LastIndex(base, "-")
+			if i < 1 {
+				return "", "", false
+			}
+			name, version = base[:i], base[i+1:]
+		}
+		// names are compared the same way that
+		// pip does (PEP 503)
+		name = strings.ToLower(strings.NewReplacer("_", "-", ".", "-").Replace(name))
+		return name, version, true
+	case model.ArchetypeHelm:
+		// <name>-<version>.tgz, where the name can
+		// contain dashes but the version starts with
+		// a digit
+		base, ok := trimSuffixes(file, ".tgz")
+		if !ok {
+			return "", "", false
+		}
+		for i := 0; i < len(base)-1; i++ {
+			if base[i] == '-' && unicode.IsDigit(rune(base[i+1])) {
+				return path.Join(path.Dir(uri), base[:i]), base[i+1:], true
+			}
+		}
+		return "", "", false
+	}
+	return "", "", false
+}
+
+func trimSuffixes(s string, suffixes ...string) (string, bool) {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return strings.TrimSuffix(s, suffix), true
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,39 @@
+package versions
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/go-prism/prism3/core/internal/graph/model"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	var cases = []struct {
+		archetype model.Archetype
+		uri       string
+		pkg       string
+		version   string
+		ok        bool
+	}{
+		{model.ArchetypeMaven, "org/example/foo/1.2.3/foo-1.2.3.jar", "org/example/foo", "1.2.3", true},
+		{model.ArchetypeMaven, "org/example/foo/1.2.3/foo-1.2.3-sources.jar", "org/example/foo", "1.2.3", true},
+		{model.ArchetypeMaven, "org/example/foo/maven-metadata.xml", "", "", false},
+		{model.ArchetypeNpm, "lodash/-/lodash-4.17.21.tgz", "lodash", "4.17.21", true},
+		{model.ArchetypeNpm, "@types/node/-/node-18.0.0.tgz", "@types/node", "18.0.0", true},
+		{model.ArchetypeNpm, "lodash", "", "", false},
+		{model.ArchetypeGo, "github.com/foo/bar/@v/v1.0.0.zip", "github.com/foo/bar", "v1.0.0", true},
+		{model.ArchetypeGo, "github.com/foo/bar/@v/list", "", "", false},
+		{model.ArchetypePip, "packages/ab/cd/Foo_Bar-1.0.0-py3-none-any.whl", "foo-bar", "1.0.0", true},
+		{model.ArchetypePip, "packages/ab/cd/foo-bar-1.0.0.tar.gz", "foo-bar", "1.0.0", true},
+		{model.ArchetypeHelm, "charts/cert-manager-v1.2.3.tgz", "", "", false},
+		{model.ArchetypeHelm, "charts/cert-manager-1.2.3-rc.1.tgz", "charts/cert-manager", "1.2.3-rc.1", true},
+		{model.ArchetypeGeneric, "foo/bar-1.0.0.tgz", "", "", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.uri, func(t *testing.T) {
+			pkg, version, ok := Parse(tt.archetype, tt.uri)
+			assert.EqualValues(t, tt.ok, ok)
+			assert.EqualValues(t, tt.pkg, pkg)
+			assert.EqualValues(t, tt.version, version)
+		})
+	}
+}
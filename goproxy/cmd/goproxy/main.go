@@ -46,8 +46,9 @@ type environment struct {
 		Level int `split_words:"true"`
 	}
 
-	S3  storage.S3Options
-	Dev struct {
+	S3      storage.S3Options
+	Storage storage.Options
+	Dev     struct {
 		Handlers bool `split_words:"true" default:"true"`
 	}
 	Flag flag.Options
@@ -72,7 +73,7 @@ func main() {
 		Env:   e.Flag.Env,
 	})
 
-	s3, err := storage.NewS3(ctx, e.S3)
+	store, err := storage.New(ctx, e.Storage, e.S3)
 	if err != nil {
 		log.Error(err, "failed to connect to object storage")
 		os.Exit(1)
@@ -109,7 +110,7 @@ func main() {
 	})
 	router.HandleFunc("/metrics", prom.ServeHTTP)
 	router.PathPrefix("/").Handler(&goproxy.Goproxy{
-		Cacher:        cache.NewCacher(s3),
+		Cacher:        cache.NewCacher(store),
 		ProxiedSUMDBs: nil,
 		Transport:     otelhttp.NewTransport(http.DefaultTransport),
 		//ErrorLogger:   stdlog.New(log, "", 0),
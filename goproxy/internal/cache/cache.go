@@ -28,7 +28,6 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"io"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 )
@@ -61,13 +60,21 @@ func (c *Cacher) Get(ctx context.Context, name string) (io.ReadCloser, error) {
 			metricGetCount.Add(ctx, 1, append(attributes, attribute.String("cache", "miss"))...)
 			return nil, os.ErrNotExist
 		}
+		// the filesystem driver returns
+		// os.ErrNotExist directly
+		if errors.Is(err, os.ErrNotExist) {
+			span.SetAttributes(attribute.Bool("cached", false))
+			log.V(1).Info("unable to locate file in storage")
+			metricGetCount.Add(ctx, 1, append(attributes, attribute.String("cache", "miss"))...)
+			return nil, os.ErrNotExist
+		}
 		metricGetCount.Add(ctx, 1, append(attributes, attribute.String("cache", "error"))...)
 		return nil, err
 	}
 	log.V(1).Info("successfully retrieved cached file from object storage")
 	span.SetAttributes(attribute.Bool("cached", true))
 	metricGetCount.Add(ctx, 1, append(attributes, attribute.String("cache", "hit"))...)
-	return ioutil.NopCloser(r), nil
+	return r, nil
 }
 
 func (c *Cacher) Set(ctx context.Context, name string, content io.ReadSeeker) error {
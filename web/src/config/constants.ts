@@ -26,7 +26,19 @@ export const REMOTE_ARCHETYPES: {name: string, value: Archetype, stable: boolean
 	{name: "Alpine", value: Archetype.Alpine, stable: true},
 	{name: "Helm", value: Archetype.Helm, stable: true},
 	{name: "Debian", value: Archetype.Debian, stable: false},
-	{name: "PyPI", value: Archetype.Pip, stable: true}
+	{name: "PyPI", value: Archetype.Pip, stable: true},
+	{name: "Rust", value: Archetype.Rust, stable: false},
+	{name: "Dnf/Yum", value: Archetype.Rpm, stable: false},
+	{name: "OCI", value: Archetype.Oci, stable: false}
+];
+
+// archetypes that support uploading
+// files to a hosted remote
+export const HOSTED_ARCHETYPES: Archetype[] = [
+	Archetype.Generic,
+	Archetype.Helm,
+	Archetype.Npm,
+	Archetype.Pip
 ];
 
 export const ARCHETYPE_SAMPLES: SimpleMap<string> = {
@@ -36,7 +48,10 @@ export const ARCHETYPE_SAMPLES: SimpleMap<string> = {
 	[Archetype.Helm]: "https://charts.bitnami.com/bitnami",
 	[Archetype.Maven]: "https://repo1.maven.org/maven2",
 	[Archetype.Npm]: "https://registry.npmjs.org",
-	[Archetype.Pip]: "https://pypi.org/simple"
+	[Archetype.Pip]: "https://pypi.org/simple",
+	[Archetype.Rust]: "https://index.crates.io",
+	[Archetype.Rpm]: "https://dl.rockylinux.org/pub/rocky",
+	[Archetype.Oci]: "https://registry-1.docker.io"
 };
 
 export const PREF_DARK_THEME = "dark-theme";
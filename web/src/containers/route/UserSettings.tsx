@@ -5,6 +5,7 @@ import SimpleSidebar, {SidebarItem} from "../layout/SimpleSidebar";
 import SidebarLayout from "../layout/SidebarLayout";
 import Profile from "./Profile";
 import UserPreferences from "./user/UserPreferences";
+import AccessTokens from "./user/AccessTokens";
 
 const UserSettings: React.FC = (): JSX.Element => {
 	useEffect(() => {
@@ -21,6 +22,11 @@ const UserSettings: React.FC = (): JSX.Element => {
 			id: "preferences",
 			label: "Preferences",
 			to: "/profile/preferences"
+		},
+		{
+			id: "tokens",
+			label: "Access tokens",
+			to: "/profile/tokens"
 		}
 	];
 	
@@ -43,6 +49,11 @@ const UserSettings: React.FC = (): JSX.Element => {
 				path="/profile/preferences"
 				component={UserPreferences}
 			/>
+			<Route
+				exact
+				path="/profile/tokens"
+				component={AccessTokens}
+			/>
 		</Switch>
 	</SidebarLayout>
 }
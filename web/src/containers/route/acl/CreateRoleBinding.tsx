@@ -36,7 +36,7 @@ import {
 	Typography,
 } from "@mui/material";
 import {makeStyles} from "tss-react/mui";
-import {Link, useHistory} from "react-router-dom";
+import {Link, useHistory, useLocation} from "react-router-dom";
 import {useTheme} from "@mui/material/styles";
 import {Code, ValidatedData, ValidatedTextField} from "jmp-coreui";
 import StandardLayout from "../../layout/StandardLayout";
@@ -89,24 +89,38 @@ const initialID: ValidatedData = {
 const ROLE_GLOBAL = "Global";
 const ROLE_SCOPED = "Scoped";
 
+const SUBJECT_USER = "User";
+const SUBJECT_GROUP = "Group";
+
+// must match permissions.GroupPrefix
+const GROUP_PREFIX = "group:";
+
 const RESOURCES = [RESOURCE_REFRACT, RESOURCE_REMOTE, RESOURCE_TRANSPORT];
 const ROLES = [ROLE_GLOBAL, ROLE_SCOPED];
+const SUBJECTS = [SUBJECT_USER, SUBJECT_GROUP];
 
 const CreateRoleBinding: React.FC = (): JSX.Element => {
 	// hooks
 	const {classes} = useStyles();
 	const theme = useTheme();
 	const history = useHistory();
+	const location = useLocation();
+	const query = new URLSearchParams(location.search);
+	// where to go once we're done, so that resource owners
+	// are sent back to the resource that they came from
+	const returnTo = query.get("from") || "/settings/sys/acl";
 
 	// global state
 	const [createRoleBinding, {loading, error}] = useCreateRoleBindingMutation();
 	const listUsers = useListUsersQuery();
 
 	// local state
+	const [subjectType, setSubjectType] = useState<string>(SUBJECT_USER);
 	const [user, setUser] = useState<string>("");
-	const [id, setID] = useState<ValidatedData>(initialID);
+	const [group, setGroup] = useState<string>("");
+	const [id, setID] = useState<ValidatedData>({...initialID, value: query.get("id") || ""});
 	const [role, setRole] = useState<string>(ROLE_SCOPED);
-	const [resource, setResource] = useState<string>("");
+	const [resource, setResource] = useState<string>(query.get("resource") || "");
 	const [verb, setVerb] = useState<Verb>(Verb.Read);
 
 	const handleRoleChange = (e: React.ChangeEvent<HTMLInputElement>): void => {
@@ -121,14 +135,16 @@ const CreateRoleBinding: React.FC = (): JSX.Element => {
 		setVerb(() => Verb.Read);
 	}, [role]);
 
+	const subject = subjectType === SUBJECT_GROUP ? `${GROUP_PREFIX}${group.trim()}` : user;
+
 	const handleCreate = (): void => {
 		createRoleBinding({variables: {
-			subject: user,
+			subject: subject,
 			resource: role === ROLE_SCOPED ? `${resource}::${id.value}` : resource,
 			verb: verb
 		}}).then(r => {
 			if (!r.errors) {
-				history.push("/settings/sys/acl");
+				history.push(returnTo);
 			}
 		});
 	}
@@ -173,7 +189,30 @@ const CreateRoleBinding: React.FC = (): JSX.Element => {
 							value={r}
 						/>)}
 					</RadioGroup>
-					<Autocomplete
+					<RadioGroup
+						className={classes.form}
+						row
+						aria-label="subject"
+						name="subject"
+						value={subjectType}
+						onChange={e => setSubjectType(e.target.value)}>
+						{SUBJECTS.map(s => <FormControlLabel
+							key={s}
+							control={<Radio color="primary"/>}
+							label={s}
+							value={s}
+						/>)}
+					</RadioGroup>
+					{subjectType === SUBJECT_GROUP && <TextField
+						sx={{mb: 2, mt: 2}}
+						label="Group"
+						size="small"
+						placeholder="developers"
+						helperText="Users are members of the groups listed in their OIDC claims."
+						value={group}
+						onChange={e => setGroup(e.target.value)}
+					/>}
+					{subjectType === SUBJECT_USER && <Autocomplete
 						sx={{mb: 2, mt: 2}}
 						disablePortal
 						options={listUsers.data?.listUsers || []}
@@ -187,7 +226,7 @@ const CreateRoleBinding: React.FC = (): JSX.Element => {
 						loading={loading}
 						loadingText="Loading users..."
 						noOptionsText="No users"
-					/>
+					/>}
 					{role === ROLE_GLOBAL && <FormControl
 						fullWidth>
 						<InputLabel
@@ -301,7 +340,7 @@ const CreateRoleBinding: React.FC = (): JSX.Element => {
 						<Button
 							className={classes.button}
 							component={Link}
-							to="/settings/sys/acl"
+							to={returnTo}
 							variant="outlined">
 							Cancel
 						</Button>
@@ -309,7 +348,7 @@ const CreateRoleBinding: React.FC = (): JSX.Element => {
 						<Button
 							className={classes.button}
 							style={{color: theme.palette.success.contrastText, backgroundColor: theme.palette.success.main}}
-							disabled={user === "" || resource === "" || !DataIsValid(id) || loading || role == null}
+							disabled={(subjectType === SUBJECT_GROUP ? group.trim() === "" : user === "") || resource === "" || !DataIsValid(id) || loading || role == null}
 							onClick={handleCreate}
 							variant="contained">
 							Create
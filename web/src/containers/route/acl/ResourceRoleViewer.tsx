@@ -16,10 +16,10 @@
  */
 
 import React, {ReactNode} from "react";
-import {Skeleton, Table, TableBody, TableCell, TableContainer, TableHead, TableRow} from "@mui/material";
+import {Button, Skeleton, Table, TableBody, TableCell, TableContainer, TableHead, TableRow} from "@mui/material";
 import {useTheme} from "@mui/material/styles";
 import {Help} from "tabler-icons-react";
-import {Link} from "react-router-dom";
+import {Link, useLocation} from "react-router-dom";
 import InlineError from "../../alert/InlineError";
 import InlineNotFound from "../../widgets/InlineNotFound";
 import {parseUsername} from "../../../utils/parse";
@@ -34,6 +34,7 @@ interface Props {
 const ResourceRoleViewer: React.FC<Props> = ({type, id}): JSX.Element => {
 	// hooks
 	const theme = useTheme();
+	const location = useLocation();
 	const {data, loading, error} = useGetUsersQuery({variables: {resource: `${type}::${id}`}});
 	const roles: RoleBinding[] = data?.getUsers || [];
 
@@ -68,6 +69,11 @@ const ResourceRoleViewer: React.FC<Props> = ({type, id}): JSX.Element => {
 		return items;
 	}
 	
+	const grantURL = (): string => {
+		const params = new URLSearchParams({resource: type, id, from: `${location.pathname}${location.hash}`});
+		return `/settings/acl/new?${params.toString()}`;
+	}
+
 	return <TableContainer>
 		<Table
 			size="small">
@@ -117,6 +123,12 @@ const ResourceRoleViewer: React.FC<Props> = ({type, id}): JSX.Element => {
 				</TableRow>)}
 			</TableBody>
 		</Table>
+		<Button
+			sx={{m: 1}}
+			component={Link}
+			to={grantURL()}>
+			Grant access
+		</Button>
 	</TableContainer>
 }
 export default ResourceRoleViewer;
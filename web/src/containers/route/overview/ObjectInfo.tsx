@@ -47,6 +47,11 @@ const useStyles = makeStyles()((theme: Theme) => ({
 	headerCard: {
 		padding: theme.spacing(1),
 		borderRadius: theme.spacing(0.75)
+	},
+	digest: {
+		margin: theme.spacing(1),
+		fontFamily: "monospace",
+		wordBreak: "break-all"
 	}
 }));
 
@@ -142,6 +147,13 @@ const ObjectInfo: React.FC<ObjectInfoProps> = ({item, refraction}): JSX.Element
 					{name}
 				</Typography>
 				{chips}
+				{item.sha256 && <Typography
+					className={classes.digest}
+					title={item.verifiedAt > 0 ? `Verified ${formatDistanceToNow(new Date(item.verifiedAt * 1000), {addSuffix: true})}` : undefined}
+					color="textSecondary"
+					variant="body2">
+					sha256:{item.sha256}
+				</Typography>}
 			</Card>
 			<TabContext
 				value={selected}>
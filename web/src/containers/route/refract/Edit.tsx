@@ -32,16 +32,23 @@ import {
 	Archetype,
 	Refraction,
 	Remote,
+	ResolutionStrategy,
 	useGetRefractionLazyQuery,
 	usePatchRefractMutation,
-	Verb
+	Verb,
+	VulnerabilityAction
 } from "../../../generated/graphql";
 import ResourceRoleViewer from "../acl/ResourceRoleViewer";
 import useCanRBAC from "../../../hooks/useRBAC";
 import {RESOURCE_REFRACT} from "../../../config/constants";
 import Error from "../../alert/Error";
 import Setup from "./options/Setup";
+import Resolution from "./options/Resolution";
 import RemoteSelect from "./RemoteSelect";
+import BandwidthOpts from "../remote/options/BandwidthOpts";
+import Quarantine from "./options/Quarantine";
+import Vulnerabilities from "./options/Vulnerabilities";
+import Access from "./options/Access";
 
 const useStyles = makeStyles()((theme: Theme) => ({
 	title: {
@@ -88,6 +95,7 @@ const EditRefract: React.FC = (): JSX.Element => {
 
 	// global state
 	const canPatch = useCanRBAC({type: RESOURCE_REFRACT, id, verb: Verb.Update});
+	const canSudo = useCanRBAC({type: RESOURCE_REFRACT, id, verb: Verb.Sudo});
 	const [patchRefraction, {error: patchErr}] = usePatchRefractMutation();
 	const [getRefraction, {data, loading}] = useGetRefractionLazyQuery();
 
@@ -95,6 +103,12 @@ const EditRefract: React.FC = (): JSX.Element => {
 	// local state
 	const [name, setName] = useState<ValidatedData>(initialName);
 	const [remotes, setRemotes] = useState<Remote[]>([]);
+	const [strategy, setStrategy] = useState<ResolutionStrategy>(ResolutionStrategy.Fastest);
+	const [pins, setPins] = useState<Record<string, string>>({});
+	const [offline, setOffline] = useState<boolean>(false);
+	const [quarantineDays, setQuarantineDays] = useState<number>(0);
+	const [vulnerabilityAction, setVulnerabilityAction] = useState<VulnerabilityAction>(VulnerabilityAction.None);
+	const [isPrivate, setPrivate] = useState<boolean>(false);
 	const [success, setSuccess] = useState<boolean>(false);
 	const [readOnly, setReadOnly] = useState<boolean>(false);
 
@@ -111,6 +125,12 @@ const EditRefract: React.FC = (): JSX.Element => {
 			return;
 		setName({...name, value: data.getRefraction.name});
 		setRemotes(data.getRefraction.remotes as Remote[]);
+		setStrategy(data.getRefraction.strategy);
+		setPins(data.getRefraction.pins || {});
+		setOffline(data.getRefraction.offline);
+		setQuarantineDays(data.getRefraction.quarantineDays);
+		setVulnerabilityAction(data.getRefraction.vulnerabilityAction);
+		setPrivate(data.getRefraction.private);
 		// go refractions are system-managed
 		setReadOnly(data.getRefraction.archetype === Archetype.Go);
 	}, [data?.getRefraction]);
@@ -122,7 +142,13 @@ const EditRefract: React.FC = (): JSX.Element => {
 		patchRefraction({variables: {
 			id: id,
 			name: name.value,
-			remotes: remotes.map(r => r.id)
+			remotes: remotes.map(r => r.id),
+			strategy: strategy,
+			pins: pins,
+			offline: offline,
+			quarantineDays: quarantineDays,
+			vulnerabilityAction: vulnerabilityAction,
+			private: isPrivate
 		}}).then(r => {
 			if (!r.errors) {
 				setSuccess(true);
@@ -148,12 +174,82 @@ const EditRefract: React.FC = (): JSX.Element => {
 				disabled: data?.getRefraction == null || loading,
 				hidden: false
 			},
+			{
+				id: "resolution",
+				primary: "Resolution",
+				secondary: "Control which remotes artifacts are served from.",
+				children: <Resolution
+					strategy={strategy}
+					setStrategy={setStrategy}
+					pins={pins}
+					setPins={setPins}
+					offline={offline}
+					setOffline={setOffline}
+					remotes={remotes}
+					loading={loading}
+					disabled={readOnly || !canPatch}
+				/>,
+				disabled: data?.getRefraction == null || loading,
+				hidden: false
+			},
+			{
+				id: "quarantine",
+				primary: "Quarantine",
+				secondary: "Hold back package versions until they have been published for a while.",
+				children: data?.getRefraction == null ? <CircularProgress/> : <Quarantine
+					id={data.getRefraction.id}
+					days={quarantineDays}
+					setDays={setQuarantineDays}
+					canExempt={canSudo}
+					loading={loading}
+					disabled={readOnly || !canPatch}
+				/>,
+				disabled: data?.getRefraction == null || loading,
+				hidden: false
+			},
+			{
+				id: "vulnerabilities",
+				primary: "Vulnerabilities",
+				secondary: "Decide what happens to package versions that are affected by a known advisory.",
+				children: data?.getRefraction == null ? <CircularProgress/> : <Vulnerabilities
+					id={data.getRefraction.id}
+					action={vulnerabilityAction}
+					setAction={setVulnerabilityAction}
+					loading={loading}
+					disabled={readOnly || !canPatch}
+				/>,
+				disabled: data?.getRefraction == null || loading,
+				hidden: false
+			},
+			{
+				id: "access",
+				primary: "Access",
+				secondary: "Control who can download from this refraction.",
+				children: <Access
+					isPrivate={isPrivate}
+					setPrivate={setPrivate}
+					loading={loading}
+					disabled={!canPatch}
+				/>,
+				disabled: data?.getRefraction == null || loading,
+				hidden: false
+			},
 			{
 				id: "rbac",
 				primary: "Permissions",
 				secondary: "Control who can view and modify refractions.",
 				children: data?.getRefraction == null ? <CircularProgress/> : <ResourceRoleViewer type={RESOURCE_REFRACT} id={data.getRefraction.name}/>,
 				hidden: !canPatch
+			},
+			{
+				id: "usage",
+				primary: "Usage quotas",
+				secondary: "View usage of compute and network resources for this calendar month.",
+				children: data?.getRefraction == null ? <CircularProgress/> : <BandwidthOpts
+					type={RESOURCE_REFRACT}
+					id={data.getRefraction.id}
+				/>,
+				hidden: !canSudo
 			}
 		];
 		return items.filter(d => !d.hidden).map(d => <ExpandableListItem
@@ -168,7 +264,7 @@ const EditRefract: React.FC = (): JSX.Element => {
 				{d.children}
 			</ErrorBoundary>
 		</ExpandableListItem>);
-	}, [open, data?.getRefraction]);
+	}, [open, data?.getRefraction, strategy, pins, offline, quarantineDays, vulnerabilityAction, isPrivate, remotes, readOnly, canPatch, canSudo]);
 
 	return (
 		<div>
@@ -197,7 +293,7 @@ const EditRefract: React.FC = (): JSX.Element => {
 						size="small">
 						Help
 					</Button>}>
-					This Refraction is read-only and cannot be modified.
+					This Refraction is read-only. Only its access settings can be modified.
 				</Alert>}
 				<ValidatedTextField
 					data={name}
@@ -236,7 +332,7 @@ const EditRefract: React.FC = (): JSX.Element => {
 					<Button
 						className={classes.button}
 						style={{color: theme.palette.success.contrastText, backgroundColor: theme.palette.success.main}}
-						disabled={!DataIsValid(name) || loading || readOnly || !canPatch}
+						disabled={!DataIsValid(name) || loading || !canPatch || (readOnly && isPrivate === data?.getRefraction.private)}
 						onClick={handleUpdate}
 						variant="contained">
 						Save changes
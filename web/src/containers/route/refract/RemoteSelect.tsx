@@ -29,7 +29,7 @@ import {
 	Theme,
 } from "@mui/material";
 import {makeStyles} from "tss-react/mui";
-import {ChevronLeft, ChevronRight, ChevronsLeft, ChevronsRight} from "tabler-icons-react";
+import {ChevronDown, ChevronLeft, ChevronRight, ChevronsLeft, ChevronsRight, ChevronUp} from "tabler-icons-react";
 import {Archetype, Remote, useListRemotesLazyQuery} from "../../../generated/graphql";
 
 const useStyles = makeStyles()((theme: Theme) => ({
@@ -64,8 +64,10 @@ const intersection = (a: Remote[], b: Remote[]) => {
 	return a.filter((value) => bid.indexOf(value.id) !== -1);
 }
 
+// intersectionID returns the items of a that are in b,
+// preserving the order of b
 const intersectionID = (a: Remote[], b: Remote[]) => {
-	return a.filter((value) => b.find(i => i.id === value.id) != null);
+	return b.map(value => a.find(i => i.id === value.id)).filter(i => i != null) as Remote[];
 }
 
 interface RemoteSelectProps {
@@ -137,6 +139,19 @@ const RemoteSelect: React.FC<RemoteSelectProps> = ({arch, setRemotes, defaultRem
 		setRight([]);
 	};
 
+	// handleMove shifts the selected remote up or down
+	// in priority. The selected remotes are ordered
+	// highest-priority first.
+	const handleMove = (offset: number) => () => {
+		const idx = left.indexOf(leftChecked[0]);
+		const next = idx + offset;
+		if (idx === -1 || next < 0 || next >= left.length)
+			return;
+		const items = [...left];
+		[items[idx], items[next]] = [items[next], items[idx]];
+		setLeft(items);
+	};
+
 	const customList = (items: Remote[]) => (
 		<Card
 			className={classes.paper}
@@ -188,6 +203,24 @@ const RemoteSelect: React.FC<RemoteSelectProps> = ({arch, setRemotes, defaultRem
 				className={classes.item}
 				item>
 				<Grid container direction="column" alignItems="center">
+					<Button
+						variant="outlined"
+						size="small"
+						className={classes.button}
+						onClick={handleMove(-1)}
+						disabled={leftChecked.length !== 1 || left.indexOf(leftChecked[0]) === 0 || disabled}
+						aria-label="increase priority">
+						<ChevronUp/>
+					</Button>
+					<Button
+						variant="outlined"
+						size="small"
+						className={classes.button}
+						onClick={handleMove(1)}
+						disabled={leftChecked.length !== 1 || left.indexOf(leftChecked[0]) === left.length - 1 || disabled}
+						aria-label="decrease priority">
+						<ChevronDown/>
+					</Button>
 					<Button
 						variant="outlined"
 						size="small"
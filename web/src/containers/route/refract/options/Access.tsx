@@ -0,0 +1,54 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React from "react";
+import {Card, FormControlLabel, Switch, Typography} from "@mui/material";
+import {useTheme} from "@mui/material/styles";
+
+interface AccessProps {
+	isPrivate: boolean;
+	setPrivate: (v: boolean) => void;
+	loading?: boolean;
+	disabled?: boolean;
+}
+
+const Access: React.FC<AccessProps> = ({isPrivate, setPrivate, loading, disabled = false}): JSX.Element => {
+	const theme = useTheme();
+
+	return <Card
+		style={{padding: theme.spacing(1)}}
+		variant="outlined">
+		<FormControlLabel
+			sx={{m: 1}}
+			control={<Switch
+				checked={isPrivate}
+				onChange={(_, checked) => setPrivate(checked)}
+				disabled={loading || disabled}
+			/>}
+			label="Private"
+		/>
+		<Typography
+			sx={{m: 1}}
+			color="textSecondary"
+			variant="body2">
+			{isPrivate
+				? "Only users, service accounts and access tokens with READ access can download from this Refraction."
+				: "Anyone who can reach Prism can download from this Refraction."}
+		</Typography>
+	</Card>
+}
+export default Access;
@@ -0,0 +1,175 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React, {useState} from "react";
+import {
+	Button,
+	Card,
+	List,
+	ListItem,
+	ListItemSecondaryAction,
+	ListItemText,
+	ListSubheader,
+	TextField,
+	Theme,
+	Typography
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {useTheme} from "@mui/material/styles";
+import {Code, GenericIconButton, ListItemSkeleton} from "jmp-coreui";
+import {mdiDeleteOutline} from "@mdi/js";
+import {formatDistanceToNow} from "date-fns";
+import {
+	useCreateQuarantineExemptionMutation,
+	useDeleteQuarantineExemptionMutation,
+	useListQuarantineExemptionsQuery
+} from "../../../../generated/graphql";
+import InlineError from "../../../alert/InlineError";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	field: {
+		margin: theme.spacing(1)
+	},
+	button: {
+		margin: theme.spacing(1),
+		fontFamily: "Manrope",
+		fontWeight: 600,
+		textTransform: "none"
+	}
+}));
+
+interface QuarantineProps {
+	id: string;
+	days: number;
+	setDays: (v: number) => void;
+	canExempt?: boolean;
+	loading?: boolean;
+	disabled?: boolean;
+}
+
+const Quarantine: React.FC<QuarantineProps> = ({
+	id,
+	days,
+	setDays,
+	canExempt = false,
+	loading,
+	disabled = false
+}): JSX.Element => {
+	// hooks
+	const theme = useTheme();
+	const {classes} = useStyles();
+	const {data, loading: listLoading, error, refetch} = useListQuarantineExemptionsQuery({variables: {refract: id}, skip: !canExempt});
+	const [createExemption, {loading: createLoading, error: createErr}] = useCreateQuarantineExemptionMutation();
+	const [deleteExemption, {loading: deleteLoading, error: deleteErr}] = useDeleteQuarantineExemptionMutation();
+
+	// local state
+	const [pkg, setPkg] = useState<string>("");
+	const [version, setVersion] = useState<string>("");
+
+	const handleCreate = (): void => {
+		void createExemption({variables: {refractionID: id, package: pkg.trim(), version: version.trim()}}).then(r => {
+			if (!r.errors) {
+				setPkg("");
+				setVersion("");
+				void refetch();
+			}
+		});
+	}
+
+	const handleDelete = (exemption: string): void => {
+		void deleteExemption({variables: {id: exemption}}).then(r => {
+			if (!r.errors)
+				void refetch();
+		});
+	}
+
+	const busy = listLoading || createLoading || deleteLoading;
+
+	return <div>
+		<Card
+			style={{padding: theme.spacing(1)}}
+			variant="outlined">
+			<TextField
+				className={classes.field}
+				label="Minimum age (days)"
+				helperText="Hide package versions that were published more recently than this. Set to 0 to disable."
+				type="number"
+				size="small"
+				value={days}
+				onChange={e => setDays(Number(e.target.value))}
+				inputProps={{min: 0}}
+				disabled={loading || disabled}
+			/>
+		</Card>
+		{canExempt && <React.Fragment>
+			<ListSubheader>Exemptions ({data?.listQuarantineExemptions.length || 0})</ListSubheader>
+			{(error || createErr || deleteErr) && <InlineError error={error || createErr || deleteErr}/>}
+			<div>
+				<TextField
+					className={classes.field}
+					label="Package"
+					size="small"
+					value={pkg}
+					onChange={e => setPkg(e.target.value)}
+					disabled={busy}
+				/>
+				<TextField
+					className={classes.field}
+					label="Version"
+					helperText="Leave empty to exempt every version."
+					size="small"
+					value={version}
+					onChange={e => setVersion(e.target.value)}
+					disabled={busy}
+				/>
+				<Button
+					className={classes.button}
+					variant="outlined"
+					disabled={busy || pkg.trim() === ""}
+					onClick={handleCreate}>
+					Exempt
+				</Button>
+			</div>
+			{data == null && error == null && <ListItemSkeleton/>}
+			{data?.listQuarantineExemptions.length === 0 && <Typography
+				className={classes.field}
+				color="textSecondary"
+				variant="body2">
+				No packages are exempt from the quarantine.
+			</Typography>}
+			<List dense>
+				{data?.listQuarantineExemptions.map(e => <ListItem
+					key={e.id}>
+					<ListItemText
+						primary={<Code>{e.version === "" ? e.package : `${e.package}@${e.version}`}</Code>}
+						secondary={`Added by ${e.createdBy} ${formatDistanceToNow(new Date(e.createdAt * 1000), {addSuffix: true})}`}
+					/>
+					<ListItemSecondaryAction>
+						<GenericIconButton
+							title="Delete"
+							icon={mdiDeleteOutline}
+							colour={theme.palette.error.main}
+							disabled={busy}
+							onClick={() => handleDelete(e.id)}
+						/>
+					</ListItemSecondaryAction>
+				</ListItem>)}
+			</List>
+		</React.Fragment>}
+	</div>
+}
+export default Quarantine;
@@ -0,0 +1,237 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React, {useMemo, useState} from "react";
+import {
+	Alert,
+	Button,
+	Card,
+	List,
+	ListItem,
+	ListItemSecondaryAction,
+	ListItemText,
+	ListSubheader,
+	MenuItem,
+	Select,
+	Switch,
+	Theme,
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {useTheme} from "@mui/material/styles";
+import {Code, GenericIconButton, ValidatedData, ValidatedTextField} from "jmp-coreui";
+import {mdiDeleteOutline} from "@mdi/js";
+import {DataIsValid} from "../../../../utils/data";
+import {Remote, ResolutionStrategy} from "../../../../generated/graphql";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	button: {
+		textTransform: "none",
+		fontFamily: "Manrope",
+		fontWeight: 600,
+		marginTop: theme.spacing(1)
+	},
+	select: {
+		minWidth: 300,
+		borderRadius: theme.spacing(1)
+	}
+}));
+
+const strategies = [
+	{
+		value: ResolutionStrategy.Fastest,
+		primary: "Fastest",
+		secondary: "Serve from whichever remote responds first."
+	},
+	{
+		value: ResolutionStrategy.Ordered,
+		primary: "Ordered",
+		secondary: "Serve from the highest-priority remote that has the artifact."
+	},
+	{
+		value: ResolutionStrategy.Pinned,
+		primary: "Pinned",
+		secondary: "Serve matching paths from a single remote, and everything else in priority order."
+	}
+];
+
+interface ResolutionProps {
+	strategy: ResolutionStrategy;
+	setStrategy: (s: ResolutionStrategy) => void;
+	pins: Record<string, string>;
+	setPins: (p: Record<string, string>) => void;
+	offline: boolean;
+	setOffline: (o: boolean) => void;
+	remotes: Remote[];
+	loading?: boolean;
+	disabled?: boolean;
+}
+
+const initialPattern: ValidatedData = {
+	value: "",
+	error: "",
+	regex: new RegExp(/.+/)
+}
+
+const Resolution: React.FC<ResolutionProps> = ({
+	strategy,
+	setStrategy,
+	pins,
+	setPins,
+	offline,
+	setOffline,
+	remotes,
+	loading,
+	disabled = false
+}): JSX.Element => {
+	// hooks
+	const theme = useTheme();
+	const {classes} = useStyles();
+
+	// local state
+	const [pattern, setPattern] = useState<ValidatedData>(initialPattern);
+	const [remote, setRemote] = useState<string>("");
+
+	const onCreatePin = (): void => {
+		setPins({...pins, [pattern.value]: remote});
+		setPattern(initialPattern);
+	}
+
+	const onDeletePin = (key: string): void => {
+		const next = {...pins};
+		delete next[key];
+		setPins(next);
+	}
+
+	const pinItems = useMemo(() => {
+		return Object.keys(pins).sort().map(k => <ListItem
+			key={k}
+			dense>
+			<ListItemText
+				primary={<Code>{k}</Code>}
+				secondary={remotes.find(r => r.id === pins[k])?.name || "Unknown remote"}
+			/>
+			<ListItemSecondaryAction>
+				<GenericIconButton
+					title="Delete"
+					icon={mdiDeleteOutline}
+					colour={theme.palette.error.main}
+					disabled={loading || disabled}
+					onClick={() => onDeletePin(k)}
+				/>
+			</ListItemSecondaryAction>
+		</ListItem>);
+	}, [pins, remotes, loading, disabled]);
+
+	return (
+		<div>
+			<List>
+				<ListItem>
+					<ListItemText
+						primary="Strategy"
+						secondary={strategies.find(s => s.value === strategy)?.secondary}
+					/>
+					<Select
+						className={classes.select}
+						disabled={loading || disabled}
+						value={strategy}
+						variant="outlined"
+						size="small"
+						onChange={e => setStrategy(e.target.value as ResolutionStrategy)}>
+						{strategies.map(s => <MenuItem
+							key={s.value}
+							value={s.value}>
+							{s.primary}
+						</MenuItem>)}
+					</Select>
+				</ListItem>
+				<ListItem>
+					<ListItemText
+						primary="Offline"
+						secondary={offline ? "Only cached artifacts and metadata are served. Remotes are never contacted." : "Artifacts and metadata are fetched from remotes when they aren't cached."}
+					/>
+					<Switch
+						checked={offline}
+						disabled={loading || disabled}
+						onChange={(_, checked) => setOffline(checked)}
+					/>
+				</ListItem>
+			</List>
+			{strategy !== ResolutionStrategy.Fastest && <Alert
+				severity="info">
+				Remotes are tried in the order they are listed in the selected remotes list.
+			</Alert>}
+			{strategy === ResolutionStrategy.Pinned && <React.Fragment>
+				<Card
+					style={{padding: theme.spacing(2), marginTop: theme.spacing(1)}}
+					variant="outlined">
+					<ValidatedTextField
+						data={pattern}
+						setData={setPattern}
+						invalidLabel="Must be set"
+						fieldProps={{
+							required: true,
+							label: "Path regex",
+							variant: "outlined",
+							id: "txt-pin",
+							size: "small",
+							fullWidth: true,
+							disabled: loading || disabled
+						}}
+					/>
+					<Select
+						className={classes.select}
+						style={{marginTop: theme.spacing(1)}}
+						disabled={loading || disabled}
+						value={remote}
+						displayEmpty
+						variant="outlined"
+						size="small"
+						onChange={e => setRemote(e.target.value)}>
+						<MenuItem
+							value=""
+							disabled>
+							Select a remote
+						</MenuItem>
+						{remotes.map(r => <MenuItem
+							key={r.id}
+							value={r.id}>
+							{r.name}
+						</MenuItem>)}
+					</Select>
+					<br/>
+					<Button
+						className={classes.button}
+						variant="contained"
+						color="primary"
+						onClick={onCreatePin}
+						disabled={!DataIsValid(pattern) || remotes.find(r => r.id === remote) == null || loading || disabled}>
+						Create
+					</Button>
+				</Card>
+				<ListSubheader>Pins ({Object.keys(pins).length})</ListSubheader>
+				{Object.keys(pins).length === 0 && <Alert
+					severity="info">
+					No pins found.
+				</Alert>}
+				<List>
+					{pinItems}
+				</List>
+			</React.Fragment>}
+		</div>
+	);
+}
+export default Resolution;
@@ -79,6 +79,9 @@ echo "registry=${API_URL}/api/npm/${refract.name.toLocaleLowerCase()}/" >> ~/.np
 				return `# replace "latest-stable" with the target version (e.g. 3.12, 3.12)
 # replace "main" with the target repository (e.g. main, community, edge)
 
+# trust the key that Prism signs the index with
+wget -O /etc/apk/keys/prism.rsa.pub ${url}/prism.rsa.pub
+
 # to use Prism and original repositories
 echo "${url}/v3.15/main" >> /etc/apk/repositories
 echo "${url}/v3.15/community" >> /etc/apk/repositories
@@ -94,13 +97,33 @@ apk add somepackage \\
 				return `pip config --user set global.index-url ${API_URL}/api/pypi/${refract.name.toLocaleLowerCase()}/simple/
 pip config --user set global.trusted-host ${API_URL.replace("https://", "")}`;
 			case Archetype.Debian:
-				return `# replace "buster" with the target distribution (e.g. buster, jessie, stretch)
-# replace "main" with the target components (e.g. main, security)
+				return `# replace "bookworm" with the target distribution (e.g. bookworm, bullseye, buster)
+# replace "main" with the target components (e.g. main, contrib)
 #
 # to use Prism and original repositories
-echo "deb ${url} buster main" >> /etc/apt/sources.list
+echo "deb [trusted=yes] ${API_URL}/api/deb/${refract.name.toLocaleLowerCase()}/-/ bookworm main" >> /etc/apt/sources.list
 # to use only Prism
-echo "deb ${url} buster main" > /etc/apt/sources.list`;
+echo "deb [trusted=yes] ${API_URL}/api/deb/${refract.name.toLocaleLowerCase()}/-/ bookworm main" > /etc/apt/sources.list`;
+			case Archetype.Rpm:
+				return `# replace "9" with the target release (e.g. 8, 9)
+# replace "BaseOS" with the target repository (e.g. BaseOS, AppStream)
+cat > /etc/yum.repos.d/prism.repo << EOF
+[prism-baseos]
+name=Prism BaseOS
+baseurl=${url}/9/BaseOS/$basearch/os/
+gpgcheck=1
+gpgkey=file:///etc/pki/rpm-gpg/RPM-GPG-KEY-Rocky-9
+EOF`;
+			case Archetype.Rust:
+				return `# add the following to ~/.cargo/config.toml
+[source.crates-io]
+replace-with = "prism"
+
+[source.prism]
+registry = "sparse+${API_URL}/api/cargo/${refract.name.toLocaleLowerCase()}/"`;
+			case Archetype.Oci:
+				return `# prefix the image with the address of Prism and the name of the refraction
+docker pull ${API_URL.replace("https://", "")}/${refract.name.toLocaleLowerCase()}/library/alpine:latest`;
 			case Archetype.Helm:
 				return `helm repo add prism-${refract.name.toLocaleLowerCase()} ${API_URL}/api/helm/${refract.name.toLocaleLowerCase()}/-/
 helm repo update`;
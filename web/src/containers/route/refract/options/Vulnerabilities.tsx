@@ -0,0 +1,131 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React from "react";
+import {
+	List,
+	ListItem,
+	ListItemText,
+	ListSubheader,
+	MenuItem,
+	Select,
+	Theme,
+	Typography
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {Code, ListItemSkeleton} from "jmp-coreui";
+import {useListAffectedArtifactsQuery, VulnerabilityAction} from "../../../../generated/graphql";
+import InlineError from "../../../alert/InlineError";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	field: {
+		margin: theme.spacing(1)
+	},
+	select: {
+		minWidth: 300,
+		borderRadius: theme.spacing(1)
+	}
+}));
+
+const actions = [
+	{
+		value: VulnerabilityAction.None,
+		primary: "None",
+		secondary: "Serve every version, whether or not it is affected by an advisory."
+	},
+	{
+		value: VulnerabilityAction.Warn,
+		primary: "Warn",
+		secondary: "Serve every version, but log downloads of affected versions."
+	},
+	{
+		value: VulnerabilityAction.Hide,
+		primary: "Hide",
+		secondary: "Remove affected versions from package indices."
+	},
+	{
+		value: VulnerabilityAction.Block,
+		primary: "Block",
+		secondary: "Remove affected versions from package indices and refuse to download them."
+	}
+];
+
+interface VulnerabilitiesProps {
+	id: string;
+	action: VulnerabilityAction;
+	setAction: (a: VulnerabilityAction) => void;
+	loading?: boolean;
+	disabled?: boolean;
+}
+
+const Vulnerabilities: React.FC<VulnerabilitiesProps> = ({
+	id,
+	action,
+	setAction,
+	loading,
+	disabled = false
+}): JSX.Element => {
+	// hooks
+	const {classes} = useStyles();
+	const {data, error} = useListAffectedArtifactsQuery({variables: {refract: id}});
+
+	return <div>
+		<List>
+			<ListItem>
+				<ListItemText
+					primary="Action"
+					secondary={actions.find(a => a.value === action)?.secondary}
+				/>
+				<Select
+					className={classes.select}
+					disabled={loading || disabled}
+					value={action}
+					variant="outlined"
+					size="small"
+					onChange={e => setAction(e.target.value as VulnerabilityAction)}>
+					{actions.map(a => <MenuItem
+						key={a.value}
+						value={a.value}>
+						{a.primary}
+					</MenuItem>)}
+				</Select>
+			</ListItem>
+		</List>
+		<ListSubheader>Affected artifacts ({data?.listAffectedArtifacts.length || 0})</ListSubheader>
+		{error && <InlineError error={error}/>}
+		{data == null && error == null && <ListItemSkeleton/>}
+		{data?.listAffectedArtifacts.length === 0 && <Typography
+			className={classes.field}
+			color="textSecondary"
+			variant="body2">
+			No cached artifacts are affected by a known advisory.
+		</Typography>}
+		<List dense>
+			{data?.listAffectedArtifacts.map(a => <ListItem
+				key={a.advisory.id}>
+				<ListItemText
+					primary={<span><Code>{a.advisory.id}</Code> {a.advisory.summary}</span>}
+					secondary={<React.Fragment>
+						{a.advisory.severity !== "" && <span>{a.advisory.severity} - </span>}
+						{a.artifacts.map(f => f.uri).join(", ")}
+					</React.Fragment>}
+				/>
+			</ListItem>)}
+		</List>
+	</div>
+}
+export default Vulnerabilities;
@@ -21,6 +21,7 @@ import {
 	Box,
 	Button,
 	FormControl,
+	FormControlLabel,
 	FormGroup,
 	FormLabel,
 	InputLabel,
@@ -28,6 +29,7 @@ import {
 	MenuItem,
 	Select,
 	SelectChangeEvent,
+	Switch,
 	Theme,
 	Typography
 } from "@mui/material";
@@ -38,7 +40,7 @@ import {Code, ValidatedData, ValidatedTextField} from "jmp-coreui";
 import StandardLayout from "../../layout/StandardLayout";
 import {DataIsValid} from "../../../utils/data";
 import {getGraphErrorMessage} from "../../../selectors/getErrorMessage";
-import {ARCHETYPE_SAMPLES, REMOTE_ARCHETYPES} from "../../../config/constants";
+import {ARCHETYPE_SAMPLES, HOSTED_ARCHETYPES, REMOTE_ARCHETYPES} from "../../../config/constants";
 import {Archetype, TransportSecurity, useCreateRemoteMutation} from "../../../generated/graphql";
 import Flexbox from "../../widgets/Flexbox";
 import InlineBadge from "../../../components/feedback/InlineBadge";
@@ -99,6 +101,10 @@ const CreateRemote: React.FC = (): JSX.Element => {
 	const [url, setURL] = useState<ValidatedData>(initialURL);
 	const [name, setName] = useState<ValidatedData>(initialName);
 	const [transport, setTransport] = useState<TransportSecurity | null>(null);
+	const [hosted, setHosted] = useState<boolean>(false);
+
+	const canHost = HOSTED_ARCHETYPES.includes(arch);
+	const isHosted = canHost && hosted;
 
 	const handleArchChange = (e: SelectChangeEvent): void => {
 		setArch(e.target.value as Archetype);
@@ -107,9 +113,10 @@ const CreateRemote: React.FC = (): JSX.Element => {
 	const handleCreate = (): void => {
 		createRemote({variables: {
 			name: name.value,
-			uri: url.value,
+			uri: isHosted ? "" : url.value,
 			archetype: arch,
-			transport: transport!.id
+			transport: transport!.id,
+			hosted: isHosted
 		}}).then(r => {
 			if (!r.errors) {
 				history.push(`/remote/${r.data?.createRemote.id}/-/edit`);
@@ -171,30 +178,41 @@ const CreateRemote: React.FC = (): JSX.Element => {
 							</MenuItem>)}
 						</Select>
 					</FormControl>
-					<ValidatedTextField
-						data={url}
-						setData={setURL}
-						invalidLabel="Must be a valid URL."
-						fieldProps={{
-							className: classes.formItem,
-							required: true,
-							label: "URL",
-							variant: "outlined",
-							id: "txt-url",
-							size: "small"
-						}}
+					<FormControlLabel
+						className={classes.formItem}
+						control={<Switch
+							checked={isHosted}
+							disabled={!canHost}
+							onChange={(_, checked) => setHosted(checked)}
+						/>}
+						label="Hosted (files are uploaded to Prism rather than fetched from a URL)"
 					/>
-					<Flexbox>
-						<Box sx={{flexGrow: 1}}/>
-						<Typography
-							variant="caption">
-							<MuiLink
-								href="#"
-								onClick={() => setURL(s => ({...s, value: ARCHETYPE_SAMPLES[arch] || ""}))}>
-								Insert sample URL
-							</MuiLink>
-						</Typography>
-					</Flexbox>
+					{!isHosted && <>
+						<ValidatedTextField
+							data={url}
+							setData={setURL}
+							invalidLabel="Must be a valid URL."
+							fieldProps={{
+								className: classes.formItem,
+								required: true,
+								label: "URL",
+								variant: "outlined",
+								id: "txt-url",
+								size: "small"
+							}}
+						/>
+						<Flexbox>
+							<Box sx={{flexGrow: 1}}/>
+							<Typography
+								variant="caption">
+								<MuiLink
+									href="#"
+									onClick={() => setURL(s => ({...s, value: ARCHETYPE_SAMPLES[arch] || ""}))}>
+									Insert sample URL
+								</MuiLink>
+							</Typography>
+						</Flexbox>
+					</>}
 					<TransportOpts
 						onSelect={setTransport}
 					/>
@@ -219,7 +237,7 @@ const CreateRemote: React.FC = (): JSX.Element => {
 						<Button
 							className={classes.button}
 							style={{color: theme.palette.success.contrastText, backgroundColor: theme.palette.success.main}}
-							disabled={!DataIsValid(url) || !DataIsValid(name) || loading || transport == null}
+							disabled={(!isHosted && !DataIsValid(url)) || !DataIsValid(name) || loading || transport == null}
 							onClick={handleCreate}
 							variant="contained">
 							Create
@@ -52,6 +52,7 @@ import {getRemoteIcon} from "../../../utils/remote";
 import {
 	Archetype,
 	AuthMode,
+	PolicyMode,
 	useDeleteRemoteMutation,
 	useGetRemoteLazyQuery,
 	usePatchRemoteMutation,
@@ -66,6 +67,8 @@ import RestrictedHeaders from "./options/RestrictedHeaders";
 import FirewallRules from "./options/FirewallRules";
 import TransportOpts from "./options/TransportOpts";
 import BandwidthOpts from "./options/BandwidthOpts";
+import HealthOpts from "./options/HealthOpts";
+import RetentionOpts from "./options/RetentionOpts";
 
 const useStyles = makeStyles()((theme: Theme) => ({
 	title: {
@@ -137,10 +140,18 @@ const EditRemote: React.FC = (): JSX.Element => {
 	const [enabled, setEnabled] = useState<boolean>(false);
 	const [allowList, setAllowList] = useState<string[]>([]);
 	const [blockList, setBlockList] = useState<string[]>([]);
+	const [rules, setRules] = useState<string[]>([]);
+	const [policyMode, setPolicyMode] = useState<PolicyMode>(PolicyMode.Enforce);
 	const [resHeaders, setResHeaders] = useState<string[]>([]);
 	const [directHeader, setDirectHeader] = useState<string>("");
 	const [directToken, setDirectToken] = useState<string>("");
 	const [authMode, setAuthMode] = useState<AuthMode>(AuthMode.None);
+	const [probeTimeout, setProbeTimeout] = useState<number>(10);
+	const [threshold, setThreshold] = useState<number>(5);
+	const [cooldown, setCooldown] = useState<number>(30);
+	const [maxAge, setMaxAge] = useState<number>(0);
+	const [maxSize, setMaxSize] = useState<number>(0);
+	const [keepLatest, setKeepLatest] = useState<number>(0);
 	const [readOnly, setReadOnly] = useState<boolean>(false);
 
 	const open = useMemo(() => {
@@ -184,9 +195,17 @@ const EditRemote: React.FC = (): JSX.Element => {
 		setAuthMode(data?.getRemote.security.authMode || AuthMode.None);
 		setAllowList(data?.getRemote.security.allowed || []);
 		setBlockList(data?.getRemote.security.blocked || []);
+		setRules(data?.getRemote.security.rules || []);
+		setPolicyMode(data?.getRemote.security.policyMode || PolicyMode.Enforce);
 		setResHeaders(data?.getRemote.security.authHeaders || []);
 		setDirectHeader(data?.getRemote.security.directHeader || "");
 		setDirectToken(data?.getRemote.security.directToken || "");
+		setProbeTimeout(data?.getRemote.timeout);
+		setThreshold(data?.getRemote.breakerThreshold);
+		setCooldown(data?.getRemote.breakerCooldown);
+		setMaxAge(data?.getRemote.retentionMaxAge);
+		setMaxSize(data?.getRemote.retentionMaxSize);
+		setKeepLatest(data?.getRemote.retentionKeepLatest);
 		setReadOnly(data?.getRemote.archetype === Archetype.Go);
 	}, [data?.getRemote]);
 
@@ -201,6 +220,8 @@ const EditRemote: React.FC = (): JSX.Element => {
 			return true;
 		if (blockList !== data?.getRemote.security.blocked)
 			return true;
+		if (rules !== data?.getRemote.security.rules || policyMode !== data?.getRemote.security.policyMode)
+			return true;
 		if (resHeaders !== data?.getRemote.security.authHeaders)
 			return true;
 		if (authMode !== data?.getRemote.security.authMode)
@@ -209,6 +230,10 @@ const EditRemote: React.FC = (): JSX.Element => {
 			return true;
 		if (directToken !== data?.getRemote.security.directToken)
 			return true;
+		if (probeTimeout !== data?.getRemote.timeout || threshold !== data?.getRemote.breakerThreshold || cooldown !== data?.getRemote.breakerCooldown)
+			return true;
+		if (maxAge !== data?.getRemote.retentionMaxAge || maxSize !== data?.getRemote.retentionMaxSize || keepLatest !== data?.getRemote.retentionKeepLatest)
+			return true;
 		return enabled !== data?.getRemote.enabled;
 	};
 
@@ -221,10 +246,18 @@ const EditRemote: React.FC = (): JSX.Element => {
 			transportID: data.getRemote.transport.id,
 			allowed: allowList,
 			blocked: blockList,
+			rules: rules,
+			policyMode: policyMode,
 			authMode: authMode,
 			directHeader: directHeader,
 			directToken: directToken,
-			authHeaders: resHeaders
+			authHeaders: resHeaders,
+			timeout: probeTimeout,
+			breakerThreshold: threshold,
+			breakerCooldown: cooldown,
+			retentionMaxAge: maxAge,
+			retentionMaxSize: maxSize,
+			retentionKeepLatest: keepLatest
 		}}).then(r => {
 			if (!r.errors) {
 				setSuccess(() => true);
@@ -250,6 +283,10 @@ const EditRemote: React.FC = (): JSX.Element => {
 					blockRules={blockList}
 					setAllowRules={setAllowList}
 					setBlockRules={setBlockList}
+					exprRules={rules}
+					setExprRules={setRules}
+					audit={policyMode === PolicyMode.Audit}
+					setAudit={v => setPolicyMode(v ? PolicyMode.Audit : PolicyMode.Enforce)}
 					loading={loading}
 					disabled={readOnly}
 				/>,
@@ -283,6 +320,41 @@ const EditRemote: React.FC = (): JSX.Element => {
 				/>,
 				hidden: !canPatch
 			},
+			{
+				id: "health",
+				primary: "Health",
+				secondary: "Control when Prism stops sending requests to an unresponsive remote.",
+				children: data?.getRemote == null ? <CircularProgress/> : <HealthOpts
+					id={data.getRemote.id}
+					probeTimeout={probeTimeout}
+					setProbeTimeout={setProbeTimeout}
+					threshold={threshold}
+					setThreshold={setThreshold}
+					cooldown={cooldown}
+					setCooldown={setCooldown}
+					loading={loading}
+					disabled={readOnly}
+				/>,
+				hidden: !canPatch || data?.getRemote.hosted === true
+			},
+			{
+				id: "retention",
+				primary: "Retention",
+				secondary: "Control when Prism removes cached files to save space.",
+				children: data?.getRemote == null ? <CircularProgress/> : <RetentionOpts
+					id={data.getRemote.id}
+					maxAge={maxAge}
+					setMaxAge={setMaxAge}
+					maxSize={maxSize}
+					setMaxSize={setMaxSize}
+					keepLatest={keepLatest}
+					setKeepLatest={setKeepLatest}
+					canCollect={canSudo}
+					loading={loading}
+					disabled={readOnly}
+				/>,
+				hidden: !canPatch || data?.getRemote.hosted === true
+			},
 			{
 				id: "rbac",
 				primary: "Permissions",
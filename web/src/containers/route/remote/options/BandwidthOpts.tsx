@@ -15,7 +15,7 @@
  *
  */
 
-import {List, ListItem, ListItemIcon, ListItemSecondaryAction, ListItemText, Typography} from "@mui/material";
+import {List, ListItem, ListItemIcon, ListItemSecondaryAction, ListItemText, ListSubheader, Typography} from "@mui/material";
 import React, {useMemo} from "react";
 import {format} from "date-fns";
 import {CloudDownload, Database, Icon, LayersLinked, WorldDownload} from "tabler-icons-react";
@@ -23,6 +23,7 @@ import {useTheme} from "@mui/material/styles";
 import {BandwidthType, useGetBandwidthQuery, useGetTotalBandwidthQuery} from "../../../../generated/graphql";
 import InlineError from "../../../alert/InlineError";
 import {formatBytes} from "../../../../utils/format";
+import QuotaOpts from "./QuotaOpts";
 
 interface Props {
 	type: string;
@@ -69,27 +70,34 @@ const BandwidthOpts: React.FC<Props> = ({type, id}): JSX.Element => {
 		return <InlineError error={currentUsage.error || totalUsage.error}/>
 	}
 
-	return <List>
-		{[...data.entries()].map(([key, [current, total]]) => {
-			const [title, desc, icon, colour] = getBandwidth(key);
-			return <ListItem
-				key={key}>
-				<ListItemIcon>
-					{React.createElement(icon, {color: colour})}
-				</ListItemIcon>
-				<ListItemText
-					primaryTypographyProps={{color: "textPrimary"}}
-					primary={title}
-					secondary={desc}
-				/>
-				<ListItemSecondaryAction>
-					<Typography
-						color="textSecondary">
-						{formatBytes(current)}&nbsp;({formatBytes(total)})
-					</Typography>
-				</ListItemSecondaryAction>
-			</ListItem>
-		})}
-	</List>
+	return <div>
+		<List>
+			{[...data.entries()].map(([key, [current, total]]) => {
+				const [title, desc, icon, colour] = getBandwidth(key);
+				return <ListItem
+					key={key}>
+					<ListItemIcon>
+						{React.createElement(icon, {color: colour})}
+					</ListItemIcon>
+					<ListItemText
+						primaryTypographyProps={{color: "textPrimary"}}
+						primary={title}
+						secondary={desc}
+					/>
+					<ListItemSecondaryAction>
+						<Typography
+							color="textSecondary">
+							{formatBytes(current)}&nbsp;({formatBytes(total)})
+						</Typography>
+					</ListItemSecondaryAction>
+				</ListItem>
+			})}
+		</List>
+		<ListSubheader>Quotas</ListSubheader>
+		<QuotaOpts
+			type={type}
+			id={id}
+		/>
+	</div>
 }
 export default BandwidthOpts;
@@ -50,8 +50,12 @@ const useStyles = makeStyles()((theme: Theme) => ({
 interface FirewallRulesProps {
 	allowRules: string[];
 	blockRules: string[];
+	exprRules: string[];
 	setAllowRules: (r: string[]) => void;
 	setBlockRules: (r: string[]) => void;
+	setExprRules: (r: string[]) => void;
+	audit: boolean;
+	setAudit: (v: boolean) => void;
 	loading?: boolean;
 	disabled?: boolean;
 }
@@ -65,8 +69,12 @@ const initialRule: ValidatedData = {
 const FirewallRules: React.FC<FirewallRulesProps> = ({
 	allowRules,
 	blockRules,
+	exprRules,
 	setAllowRules,
 	setBlockRules,
+	setExprRules,
+	audit,
+	setAudit,
 	loading,
 	disabled = false
 }): JSX.Element => {
@@ -77,6 +85,7 @@ const FirewallRules: React.FC<FirewallRulesProps> = ({
 	// local state
 	const [rule, setRule] = useState<ValidatedData>(initialRule);
 	const [block, setBlock] = useState<boolean>(true);
+	const [expr, setExpr] = useState<ValidatedData>(initialRule);
 
 	const onCreateRule = (): void => {
 		if (block) {
@@ -86,6 +95,10 @@ const FirewallRules: React.FC<FirewallRulesProps> = ({
 		setAllowRules([...allowRules, rule.value]);
 	}
 
+	const onCreateExpr = (): void => {
+		setExprRules([...exprRules, expr.value]);
+	}
+
 	const renderRules = (rules: string[], onDelete: (v: string) => void): JSX.Element[] => {
 		return rules.map(r => <ListItem
 			key={r}
@@ -115,8 +128,26 @@ const FirewallRules: React.FC<FirewallRulesProps> = ({
 		return renderRules(blockRules, v => setBlockRules(blockRules.filter(r => r !== v)));
 	}, [blockRules]);
 
+	const exprItems = useMemo(() => {
+		return renderRules(exprRules, v => setExprRules(exprRules.filter(r => r !== v)));
+	}, [exprRules]);
+
 	return (
 		<div>
+			<ListItem>
+				<ListItemIcon>
+					<Switch
+						color="primary"
+						checked={audit}
+						disabled={loading || disabled}
+						onChange={(_, checked) => setAudit(checked)}
+					/>
+				</ListItemIcon>
+				<ListItemText
+					primary="Audit only"
+					secondary={audit ? "Requests that would be denied are logged but still allowed." : "Requests that are denied by a rule will be rejected."}
+				/>
+			</ListItem>
 			<Card
 				style={{padding: theme.spacing(2)}}
 				variant="outlined">
@@ -173,6 +204,47 @@ const FirewallRules: React.FC<FirewallRulesProps> = ({
 			<List>
 				{blockItems}
 			</List>
+			<Card
+				style={{padding: theme.spacing(2)}}
+				variant="outlined">
+				<ListItemText
+					className={classes.text}
+					secondary={<span>
+						Expression rules must all evaluate to <Code>true</Code> for a request to be allowed.
+						Available variables are <Code>path</Code>, <Code>name</Code>, <Code>version</Code>, <Code>archetype</Code>, <Code>user</Code>, <Code>claims</Code>, <Code>header</Code> and <Code>now</Code>.
+					</span>}
+				/>
+				<ValidatedTextField
+					data={expr}
+					setData={setExpr}
+					invalidLabel="Must be set"
+					fieldProps={{
+						required: true,
+						label: "Expression rule",
+						variant: "outlined",
+						id: "txt-expr",
+						size: "small",
+						fullWidth: true,
+						disabled: loading || disabled
+					}}
+				/>
+				<Button
+					className={classes.button}
+					variant="contained"
+					color="primary"
+					onClick={onCreateExpr}
+					disabled={!DataIsValid(expr) || loading || exprRules.includes(expr.value) || disabled}>
+					Create
+				</Button>
+			</Card>
+			<ListSubheader>Expression rules ({exprRules.length})</ListSubheader>
+			{exprRules.length === 0 && <Alert
+				severity="info">
+				No expression rules found.
+			</Alert>}
+			<List>
+				{exprItems}
+			</List>
 		</div>
 	);
 }
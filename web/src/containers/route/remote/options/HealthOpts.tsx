@@ -0,0 +1,177 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React from "react";
+import {
+	Card,
+	Chip,
+	List,
+	ListItem,
+	ListItemText,
+	ListSubheader,
+	TextField,
+	Theme,
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {useTheme} from "@mui/material/styles";
+import {Code, ListItemSkeleton} from "jmp-coreui";
+import {formatDistanceToNow} from "date-fns";
+import {BreakerState, useGetRemoteHealthQuery} from "../../../../generated/graphql";
+import InlineError from "../../../alert/InlineError";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	field: {
+		margin: theme.spacing(1)
+	}
+}));
+
+interface HealthOptsProps {
+	id: string;
+	probeTimeout: number;
+	setProbeTimeout: (v: number) => void;
+	threshold: number;
+	setThreshold: (v: number) => void;
+	cooldown: number;
+	setCooldown: (v: number) => void;
+	loading?: boolean;
+	disabled?: boolean;
+}
+
+const HealthOpts: React.FC<HealthOptsProps> = ({
+	id,
+	probeTimeout,
+	setProbeTimeout,
+	threshold,
+	setThreshold,
+	cooldown,
+	setCooldown,
+	loading,
+	disabled = false
+}): JSX.Element => {
+	// hooks
+	const theme = useTheme();
+	const {classes} = useStyles();
+	const {data, error} = useGetRemoteHealthQuery({variables: {id}, pollInterval: 10_000});
+
+	const getStateColour = (s: BreakerState): string => {
+		switch (s) {
+			case BreakerState.Open:
+				return theme.palette.error.main;
+			case BreakerState.HalfOpen:
+				return theme.palette.warning.main;
+			default:
+				return theme.palette.success.main;
+		}
+	}
+
+	const health = data?.getRemoteHealth;
+
+	return <div>
+		<Card
+			style={{padding: theme.spacing(1)}}
+			variant="outlined">
+			<TextField
+				className={classes.field}
+				label="Timeout (seconds)"
+				helperText="How long the remote has to answer before it is skipped."
+				type="number"
+				size="small"
+				value={probeTimeout}
+				onChange={e => setProbeTimeout(Number(e.target.value))}
+				inputProps={{min: 1}}
+				disabled={loading || disabled}
+			/>
+			<TextField
+				className={classes.field}
+				label="Failure threshold"
+				helperText="Consecutive failures before the remote is skipped. Set to 0 to never skip."
+				type="number"
+				size="small"
+				value={threshold}
+				onChange={e => setThreshold(Number(e.target.value))}
+				inputProps={{min: 0}}
+				disabled={loading || disabled}
+			/>
+			<TextField
+				className={classes.field}
+				label="Cooldown (seconds)"
+				helperText="How long the remote is skipped before it is tried again."
+				type="number"
+				size="small"
+				value={cooldown}
+				onChange={e => setCooldown(Number(e.target.value))}
+				inputProps={{min: 1}}
+				disabled={loading || disabled}
+			/>
+		</Card>
+		<ListSubheader>Current health{health != null && ` (as seen by ${health.replica})`}</ListSubheader>
+		{error != null && <InlineError error={error}/>}
+		{health == null && error == null && <ListItemSkeleton/>}
+		{health != null && <List>
+			<ListItem>
+				<ListItemText
+					primary="Circuit"
+					secondary="Requests are not sent to the remote while the circuit is open. Each replica has its own circuit, so other replicas may be in a different state."
+				/>
+				<Chip
+					label={health.state.toLocaleLowerCase().replace("_", "-")}
+					size="small"
+					style={{color: theme.palette.getContrastText(getStateColour(health.state)), backgroundColor: getStateColour(health.state)}}
+				/>
+			</ListItem>
+			<ListItem>
+				<ListItemText
+					primary="Error rate"
+					secondary={`${health.failures} of the last ${health.requests} requests failed.`}
+				/>
+				{Math.round(health.errorRate * 100)}%
+			</ListItem>
+			<ListItem>
+				<ListItemText
+					primary="Latency"
+					secondary="Average and slowest response time of recent requests."
+				/>
+				{health.latency}ms ({health.latencyMax}ms)
+			</ListItem>
+			{health.lastError !== "" && <ListItem>
+				<ListItemText
+					primary="Last error"
+					secondary={<Code>{health.lastError}</Code>}
+				/>
+				{formatDistanceToNow(new Date(health.lastFailure * 1000), {addSuffix: true})}
+			</ListItem>}
+		</List>}
+		{health != null && health.history.length > 0 && <React.Fragment>
+			<ListSubheader>History</ListSubheader>
+			<List dense>
+				{[...health.history].reverse().map(e => <ListItem
+					key={`${e.time}-${e.state}-${e.reason}`}>
+					<ListItemText
+						primary={e.reason}
+						secondary={formatDistanceToNow(new Date(e.time * 1000), {addSuffix: true})}
+					/>
+					<Chip
+						label={e.state.toLocaleLowerCase().replace("_", "-")}
+						size="small"
+						variant="outlined"
+					/>
+				</ListItem>)}
+			</List>
+		</React.Fragment>}
+	</div>
+}
+export default HealthOpts;
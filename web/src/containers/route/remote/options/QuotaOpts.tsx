@@ -0,0 +1,208 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React, {useEffect, useState} from "react";
+import {
+	Button,
+	Card,
+	Chip,
+	List,
+	ListItem,
+	ListItemText,
+	ListSubheader,
+	MenuItem,
+	TextField,
+	Theme,
+	Typography
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {useTheme} from "@mui/material/styles";
+import {ListItemSkeleton} from "jmp-coreui";
+import {formatDistanceToNow} from "date-fns";
+import {
+	BandwidthType,
+	QuotaAction,
+	Role,
+	useDeleteQuotaMutation,
+	useListQuotasQuery,
+	useSetQuotaMutation,
+	useUserHasQuery
+} from "../../../../generated/graphql";
+import InlineError from "../../../alert/InlineError";
+import {formatBytes} from "../../../../utils/format";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	field: {
+		margin: theme.spacing(1)
+	},
+	button: {
+		margin: theme.spacing(1),
+		fontFamily: "Manrope",
+		fontWeight: 600,
+		textTransform: "none"
+	}
+}));
+
+// limits are entered in GiB but stored in bytes
+const GiB = 1024 * 1024 * 1024;
+
+const quotaTypes: [BandwidthType, string][] = [
+	[BandwidthType.NetworkB, "Egress"],
+	[BandwidthType.NetworkA, "Upstream"],
+	[BandwidthType.Storage, "Storage"]
+];
+
+const quotaActions: [QuotaAction, string][] = [
+	[QuotaAction.TooManyRequests, "Reject (429 Too Many Requests)"],
+	[QuotaAction.InsufficientStorage, "Reject (507 Insufficient Storage)"],
+	[QuotaAction.CacheOnly, "Serve cached files only"]
+];
+
+interface QuotaOptsProps {
+	type: string;
+	id: string;
+	disabled?: boolean;
+}
+
+const QuotaOpts: React.FC<QuotaOptsProps> = ({type, id, disabled = false}): JSX.Element => {
+	// hooks
+	const theme = useTheme();
+	const {classes} = useStyles();
+	const resource = `${type}::${id}`;
+	const {data, loading, error, refetch} = useListQuotasQuery({variables: {resource}});
+	const [setQuota, {loading: setLoading, error: setErr}] = useSetQuotaMutation();
+	const [deleteQuota, {loading: deleteLoading, error: deleteErr}] = useDeleteQuotaMutation();
+	// only super users can change quotas
+	const isSuper = useUserHasQuery({variables: {role: Role.Super}});
+
+	// local state
+	const [limits, setLimits] = useState<Record<string, number>>({});
+	const [actions, setActions] = useState<Record<string, QuotaAction>>({});
+
+	useEffect(() => {
+		if (data?.listQuotas == null)
+			return;
+		const l: Record<string, number> = {};
+		const a: Record<string, QuotaAction> = {};
+		data.listQuotas.forEach(q => {
+			l[q.type] = q.limit / GiB;
+			a[q.type] = q.action;
+		});
+		setLimits(l);
+		setActions(a);
+	}, [data?.listQuotas]);
+
+	const handleSave = (t: BandwidthType): void => {
+		void setQuota({variables: {
+			resource,
+			type: t,
+			limit: Math.round((limits[t] || 0) * GiB),
+			action: actions[t] || QuotaAction.TooManyRequests
+		}}).then(r => {
+			if (!r.errors)
+				void refetch();
+		});
+	}
+
+	const handleDelete = (t: BandwidthType): void => {
+		void deleteQuota({variables: {resource, type: t}}).then(r => {
+			if (!r.errors)
+				void refetch();
+		});
+	}
+
+	const busy = loading || setLoading || deleteLoading || disabled || isSuper.data?.userHas !== true;
+
+	return <div>
+		{(error || setErr || deleteErr) && <InlineError error={error || setErr || deleteErr}/>}
+		{data == null && error == null && <ListItemSkeleton/>}
+		{data != null && <Card
+			style={{padding: theme.spacing(1)}}
+			variant="outlined">
+			{quotaTypes.map(([t, name]) => {
+				const existing = data.listQuotas.find(q => q.type === t);
+				return <div
+					key={t}>
+					<TextField
+						className={classes.field}
+						label={`${name} limit (GiB)`}
+						helperText={existing != null ? `Currently ${formatBytes(existing.limit)} per month.` : "No limit."}
+						type="number"
+						size="small"
+						value={limits[t] || ""}
+						onChange={e => setLimits({...limits, [t]: Number(e.target.value)})}
+						inputProps={{min: 0}}
+						disabled={busy}
+					/>
+					<TextField
+						className={classes.field}
+						label="When exceeded"
+						select
+						size="small"
+						value={actions[t] || QuotaAction.TooManyRequests}
+						onChange={e => setActions({...actions, [t]: e.target.value as QuotaAction})}
+						disabled={busy}>
+						{quotaActions.map(([a, label]) => <MenuItem
+							key={a}
+							value={a}>
+							{label}
+						</MenuItem>)}
+					</TextField>
+					<Button
+						className={classes.button}
+						variant="outlined"
+						disabled={busy || !(limits[t] > 0)}
+						onClick={() => handleSave(t)}>
+						Save
+					</Button>
+					{existing != null && <Button
+						className={classes.button}
+						color="error"
+						disabled={busy}
+						onClick={() => handleDelete(t)}>
+						Remove
+					</Button>}
+				</div>
+			})}
+		</Card>}
+		{data != null && <React.Fragment>
+			<ListSubheader>Recent events</ListSubheader>
+			{data.listQuotaEvents.length === 0 && <Typography
+				className={classes.field}
+				color="textSecondary"
+				variant="body2">
+				No quota has reached its warning threshold.
+			</Typography>}
+			<List dense>
+				{data.listQuotaEvents.map(e => <ListItem
+					key={e.id}>
+					<ListItemText
+						primary={`${quotaTypes.find(([t]) => t === e.type)?.[1] || e.type} reached ${e.threshold}% of its quota`}
+						secondary={`${formatBytes(e.usage)} of ${formatBytes(e.limit)} - ${formatDistanceToNow(new Date(e.createdAt * 1000), {addSuffix: true})}`}
+					/>
+					<Chip
+						label={`${e.threshold}%`}
+						size="small"
+						color={e.threshold >= 100 ? "error" : "warning"}
+						variant="outlined"
+					/>
+				</ListItem>)}
+			</List>
+		</React.Fragment>}
+	</div>
+}
+export default QuotaOpts;
@@ -0,0 +1,183 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React from "react";
+import {
+	Alert,
+	Button,
+	Card,
+	Chip,
+	List,
+	ListItem,
+	ListItemText,
+	ListSubheader,
+	TextField,
+	Theme,
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {useTheme} from "@mui/material/styles";
+import {ListItemSkeleton} from "jmp-coreui";
+import {formatDistanceToNow} from "date-fns";
+import {
+	EvictionReason,
+	useCollectGarbageMutation,
+	useGetRetentionReportLazyQuery
+} from "../../../../generated/graphql";
+import InlineError from "../../../alert/InlineError";
+import {formatBytes} from "../../../../utils/format";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	field: {
+		margin: theme.spacing(1)
+	},
+	button: {
+		margin: theme.spacing(1),
+		fontFamily: "Manrope",
+		fontWeight: 600,
+		textTransform: "none"
+	}
+}));
+
+// the size limit is entered in GiB but stored in bytes
+const GiB = 1024 * 1024 * 1024;
+
+const reasons: Record<EvictionReason, string> = {
+	[EvictionReason.KeepLatest]: "old version",
+	[EvictionReason.MaxAge]: "not downloaded recently",
+	[EvictionReason.MaxSize]: "over size limit"
+};
+
+interface RetentionOptsProps {
+	id: string;
+	maxAge: number;
+	setMaxAge: (v: number) => void;
+	maxSize: number;
+	setMaxSize: (v: number) => void;
+	keepLatest: number;
+	setKeepLatest: (v: number) => void;
+	canCollect?: boolean;
+	loading?: boolean;
+	disabled?: boolean;
+}
+
+const RetentionOpts: React.FC<RetentionOptsProps> = ({
+	id,
+	maxAge,
+	setMaxAge,
+	maxSize,
+	setMaxSize,
+	keepLatest,
+	setKeepLatest,
+	canCollect = false,
+	loading,
+	disabled = false
+}): JSX.Element => {
+	// hooks
+	const theme = useTheme();
+	const {classes} = useStyles();
+	const [getReport, report] = useGetRetentionReportLazyQuery({fetchPolicy: "network-only"});
+	const [collectGarbage, collectData] = useCollectGarbageMutation();
+
+	const data = report.data?.getRetentionReport;
+
+	return <div>
+		<Card
+			style={{padding: theme.spacing(1)}}
+			variant="outlined">
+			<TextField
+				className={classes.field}
+				label="Maximum age (days)"
+				helperText="Remove files that haven't been downloaded for this long. Set to 0 to keep files forever."
+				type="number"
+				size="small"
+				value={maxAge}
+				onChange={e => setMaxAge(Number(e.target.value))}
+				inputProps={{min: 0}}
+				disabled={loading || disabled}
+			/>
+			<TextField
+				className={classes.field}
+				label="Maximum size (GiB)"
+				helperText="Remove the least-recently downloaded files once the cache grows past this size. Set to 0 for no limit."
+				type="number"
+				size="small"
+				value={maxSize / GiB}
+				onChange={e => setMaxSize(Math.round(Number(e.target.value) * GiB))}
+				inputProps={{min: 0}}
+				disabled={loading || disabled}
+			/>
+			<TextField
+				className={classes.field}
+				label="Versions to keep"
+				helperText="Only keep the newest versions of each package. Set to 0 to keep every version."
+				type="number"
+				size="small"
+				value={keepLatest}
+				onChange={e => setKeepLatest(Number(e.target.value))}
+				inputProps={{min: 0}}
+				disabled={loading || disabled}
+			/>
+		</Card>
+		<Alert
+			sx={{mt: 1}}
+			severity="info">
+			Changes must be saved before they are included in the preview.
+		</Alert>
+		<div>
+			<Button
+				className={classes.button}
+				variant="outlined"
+				disabled={report.loading}
+				onClick={() => void getReport({variables: {id}})}>
+				Preview
+			</Button>
+			{canCollect && <Button
+				className={classes.button}
+				color="error"
+				disabled={collectData.loading || collectData.data?.collectGarbage === true}
+				onClick={() => void collectGarbage({variables: {id}})}>
+				Run now
+			</Button>}
+		</div>
+		{collectData.data?.collectGarbage === true && <Alert
+			severity="success">
+			Cached files will be removed in the background.
+		</Alert>}
+		{(report.error || collectData.error) && <InlineError error={report.error || collectData.error}/>}
+		{report.loading && <ListItemSkeleton/>}
+		{data != null && <React.Fragment>
+			<ListSubheader>
+				{data.count === 0 ? "Nothing would be removed" : `${data.count} files (${formatBytes(data.size)}) would be removed`}
+			</ListSubheader>
+			<List dense>
+				{data.candidates.map(c => <ListItem
+					key={c.id}>
+					<ListItemText
+						primary={c.uri}
+						secondary={`${formatBytes(c.size)} - ${c.downloads} downloads, last ${formatDistanceToNow(new Date(c.updatedAt * 1000), {addSuffix: true})}`}
+					/>
+					<Chip
+						label={reasons[c.reason]}
+						size="small"
+						variant="outlined"
+					/>
+				</ListItem>)}
+			</List>
+		</React.Fragment>}
+	</div>
+}
+export default RetentionOpts;
@@ -0,0 +1,226 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React, {useEffect, useState} from "react";
+import {
+	Box,
+	Button,
+	Card,
+	Table,
+	TableBody,
+	TableCell,
+	TableContainer,
+	TableHead,
+	TablePagination,
+	TableRow,
+	TextField,
+	Theme,
+	Tooltip,
+	Typography
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {useTheme} from "@mui/material/styles";
+import {formatDistanceToNow} from "date-fns";
+import {ListItemSkeleton} from "jmp-coreui";
+import {AuditFilter, useListAuditEventsQuery} from "../../../generated/graphql";
+import {API_URL} from "../../../config";
+import {parseUsername} from "../../../utils/parse";
+import InlineError from "../../alert/InlineError";
+import InlineNotFound from "../../widgets/InlineNotFound";
+import Header from "../../layout/Header";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	card: {
+		minHeight: "100%"
+	},
+	field: {
+		margin: theme.spacing(1)
+	},
+	button: {
+		margin: theme.spacing(1)
+	},
+	diff: {
+		fontFamily: "monospace",
+		fontSize: 12,
+		whiteSpace: "pre-wrap",
+		wordBreak: "break-all"
+	}
+}));
+
+const pageSizes = [25, 50, 100];
+
+const AuditLog: React.FC = (): JSX.Element => {
+	// hooks
+	const theme = useTheme();
+	const {classes} = useStyles();
+
+	// local state
+	const [actor, setActor] = useState<string>("");
+	const [action, setAction] = useState<string>("");
+	const [resource, setResource] = useState<string>("");
+	const [filter, setFilter] = useState<AuditFilter>({});
+	const [page, setPage] = useState<number>(0);
+	const [pageSize, setPageSize] = useState<number>(pageSizes[1]);
+
+	// global state
+	const {data, loading, error} = useListAuditEventsQuery({
+		variables: {filter, offset: page * pageSize, limit: pageSize},
+		fetchPolicy: "network-only"
+	});
+
+	useEffect(() => {
+		window.document.title = "Audit log";
+	}, []);
+
+	const handleSearch = (): void => {
+		setFilter({
+			actor: actor.trim() || null,
+			action: action.trim() || null,
+			resource: resource.trim() || null
+		});
+		setPage(0);
+	}
+
+	const exportURL = (): string => {
+		const params = new URLSearchParams();
+		Object.entries(filter).forEach(([k, v]) => {
+			if (v != null)
+				params.set(k, `${v}`);
+		});
+		return `${API_URL}/api/audit/export?${params.toString()}`;
+	}
+
+	const formatDiff = (diff: string): string => {
+		try {
+			return JSON.stringify(JSON.parse(diff), null, 2);
+		} catch {
+			return diff;
+		}
+	}
+
+	const events = data?.listAuditEvents.items || [];
+
+	return (
+		<div
+			className={classes.card}>
+			<Header
+				title="Audit log"
+				counter={data?.listAuditEvents.total || 0}
+			/>
+			<Card
+				style={{padding: theme.spacing(1)}}
+				variant="outlined">
+				<TextField
+					className={classes.field}
+					label="Actor"
+					size="small"
+					value={actor}
+					onChange={e => setActor(e.target.value)}
+				/>
+				<TextField
+					className={classes.field}
+					label="Action"
+					size="small"
+					value={action}
+					onChange={e => setAction(e.target.value)}
+				/>
+				<TextField
+					className={classes.field}
+					label="Resource"
+					size="small"
+					placeholder="remote::"
+					value={resource}
+					onChange={e => setResource(e.target.value)}
+				/>
+				<Button
+					className={classes.button}
+					variant="outlined"
+					onClick={handleSearch}>
+					Search
+				</Button>
+				<Button
+					className={classes.button}
+					href={exportURL()}>
+					Export
+				</Button>
+			</Card>
+			{loading && <div>
+				<ListItemSkeleton/>
+				<ListItemSkeleton invertLengths/>
+			</div>}
+			{!loading && error != null && <InlineError error={error}/>}
+			{!loading && error == null && <Card
+				sx={{mt: 1}}
+				variant="outlined">
+				<TableContainer>
+					<Table
+						size="small">
+						<TableHead>
+							<TableRow>
+								<TableCell>Time</TableCell>
+								<TableCell>Actor</TableCell>
+								<TableCell>Action</TableCell>
+								<TableCell>Resource</TableCell>
+								<TableCell>Changes</TableCell>
+								<TableCell>Source</TableCell>
+							</TableRow>
+						</TableHead>
+						<TableBody>
+							{events.length === 0 && <TableRow>
+								<TableCell colSpan={6}>
+									<InlineNotFound/>
+								</TableCell>
+							</TableRow>}
+							{events.map(e => <TableRow
+								key={e.id}>
+								<TableCell>
+									<Tooltip title={new Date(e.createdAt * 1000).toISOString()}>
+										<Typography variant="body2">
+											{formatDistanceToNow(new Date(e.createdAt * 1000), {addSuffix: true})}
+										</Typography>
+									</Tooltip>
+								</TableCell>
+								<TableCell>{parseUsername(e.actor)}</TableCell>
+								<TableCell>{e.action}</TableCell>
+								<TableCell>{e.resource}</TableCell>
+								<TableCell>
+									<Box className={classes.diff}>
+										{e.diff === "{}" ? "" : formatDiff(e.diff)}
+									</Box>
+								</TableCell>
+								<TableCell>{e.sourceIP}</TableCell>
+							</TableRow>)}
+						</TableBody>
+					</Table>
+				</TableContainer>
+				<TablePagination
+					component="div"
+					count={data?.listAuditEvents.total || 0}
+					page={page}
+					onPageChange={(_, p) => setPage(p)}
+					rowsPerPage={pageSize}
+					rowsPerPageOptions={pageSizes}
+					onRowsPerPageChange={e => {
+						setPageSize(Number(e.target.value));
+						setPage(0);
+					}}
+				/>
+			</Card>}
+		</div>
+	);
+}
+export default AuditLog;
@@ -0,0 +1,183 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React, {useState} from "react";
+import {
+	Accordion,
+	AccordionDetails,
+	AccordionSummary,
+	Alert,
+	Box,
+	Button,
+	Card,
+	Divider,
+	List,
+	TextField,
+	Theme,
+	Typography
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {useTheme} from "@mui/material/styles";
+import {ListItemSkeleton} from "jmp-coreui";
+import {ChevronDown} from "tabler-icons-react";
+import {useHistory, useLocation} from "react-router-dom";
+import {
+	useCreateServiceAccountMutation,
+	useDeleteServiceAccountMutation,
+	useListServiceAccountsQuery
+} from "../../../generated/graphql";
+import {getGraphErrorMessage} from "../../../selectors/getErrorMessage";
+import InlineError from "../../alert/InlineError";
+import Header from "../../layout/Header";
+import {AccessTokenList} from "../user/AccessTokens";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	card: {
+		minHeight: "100%"
+	},
+	field: {
+		margin: theme.spacing(1)
+	},
+	button: {
+		margin: theme.spacing(1)
+	}
+}));
+
+const ServiceAccounts: React.FC = (): JSX.Element => {
+	// hooks
+	const theme = useTheme();
+	const {classes} = useStyles();
+	const location = useLocation();
+	const history = useHistory();
+
+	// global state
+	const selected = location.hash.replace("#", "");
+	const {data, loading, error, refetch} = useListServiceAccountsQuery();
+	const [createAccount, {loading: createLoading, error: createErr}] = useCreateServiceAccountMutation();
+	const [deleteAccount, {loading: deleteLoading, error: deleteErr}] = useDeleteServiceAccountMutation();
+
+	// local state
+	const [name, setName] = useState<string>("");
+	const [description, setDescription] = useState<string>("");
+
+	const setSelected = (val: string): void => {
+		history.push({...location, hash: `#${val}`});
+	}
+
+	const handleCreate = (): void => {
+		void createAccount({variables: {name: name.trim(), description: description.trim()}}).then(r => {
+			if (!r.errors) {
+				setName("");
+				setDescription("");
+				void refetch();
+			}
+		});
+	}
+
+	const handleDelete = (id: string): void => {
+		void deleteAccount({variables: {id}}).then(r => {
+			if (!r.errors)
+				void refetch();
+		});
+	}
+
+	const busy = createLoading || deleteLoading;
+
+	return (
+		<div
+			className={classes.card}>
+			<Header
+				title="Service accounts"
+				counter={data?.listServiceAccounts.length || 0}
+			/>
+			{(createErr || deleteErr) && <InlineError error={createErr || deleteErr}/>}
+			<Card
+				style={{padding: theme.spacing(1)}}
+				variant="outlined">
+				<TextField
+					className={classes.field}
+					label="Name"
+					size="small"
+					value={name}
+					onChange={e => setName(e.target.value)}
+					disabled={busy}
+				/>
+				<TextField
+					className={classes.field}
+					label="Description"
+					size="small"
+					value={description}
+					onChange={e => setDescription(e.target.value)}
+					disabled={busy}
+				/>
+				<Button
+					className={classes.button}
+					variant="outlined"
+					disabled={busy || name.trim() === ""}
+					onClick={handleCreate}>
+					Create
+				</Button>
+			</Card>
+			{loading && <div>
+				<ListItemSkeleton/>
+				<ListItemSkeleton invertLengths/>
+			</div>}
+			{!loading && error != null && <Alert
+				severity="error">
+				An error occurred attempting to load service accounts: "{getGraphErrorMessage(error)}"
+			</Alert>}
+			{!loading && error == null && data?.listServiceAccounts && <List>
+				{data.listServiceAccounts.map(sa => <Accordion
+					key={sa.id}
+					variant="outlined"
+					expanded={selected === sa.id}
+					onChange={() => setSelected(selected === sa.id ? "" : sa.id)}
+					TransitionProps={{mountOnEnter: true}}>
+					<AccordionSummary
+						centerRipple={false}
+						expandIcon={<ChevronDown/>}>
+						<Typography>
+							{sa.name}
+						</Typography>
+						<Typography
+							sx={{ml: 2}}
+							color="textSecondary">
+							{sa.description}
+						</Typography>
+					</AccordionSummary>
+					<AccordionDetails>
+						<Divider/>
+						<Box sx={{p: 1, pr: 3}}>
+							<AccessTokenList
+								serviceAccount={sa.id}
+							/>
+							<Button
+								className={classes.button}
+								variant="outlined"
+								color="error"
+								disabled={busy}
+								onClick={() => handleDelete(sa.id)}>
+								Delete service account
+							</Button>
+						</Box>
+					</AccordionDetails>
+				</Accordion>)}
+			</List>}
+		</div>
+	);
+}
+export default ServiceAccounts;
@@ -24,6 +24,8 @@ import SimpleSidebar, {SidebarItem} from "../../layout/SimpleSidebar";
 import Info from "./Info";
 import GoLangSettings from "./GoLangSettings";
 import Transports from "./Transports";
+import ServiceAccounts from "./ServiceAccounts";
+import AuditLog from "./AuditLog";
 
 export interface IDParams {
 	id: string;
@@ -45,6 +47,11 @@ const Settings: React.FC = (): JSX.Element => {
 			label: "Transport profiles",
 			to: "/settings/security/transport"
 		},
+		{
+			id: "service-accounts",
+			label: "Service accounts",
+			to: "/settings/security/service-accounts"
+		},
 		{
 			id: "div-sys",
 			label: "System",
@@ -55,6 +62,11 @@ const Settings: React.FC = (): JSX.Element => {
 			label: "Permissions",
 			to: "/settings/sys/acl"
 		},
+		{
+			id: "audit",
+			label: "Audit log",
+			to: "/settings/sys/audit"
+		},
 		{
 			id: "about",
 			label: "About",
@@ -79,6 +91,11 @@ const Settings: React.FC = (): JSX.Element => {
 					path="/settings/security/transport"
 					component={Transports}
 				/>
+				<Route
+					exact
+					path="/settings/security/service-accounts"
+					component={ServiceAccounts}
+				/>
 				<Route
 					exact
 					path="/settings/fwk/go"
@@ -89,6 +106,11 @@ const Settings: React.FC = (): JSX.Element => {
 					path="/settings/sys/about"
 					component={Info}
 				/>
+				<Route
+					exact
+					path="/settings/sys/audit"
+					component={AuditLog}
+				/>
 				<Route
 					exact
 					path="/settings/sys/acl"
@@ -0,0 +1,192 @@
+/*
+ *    Copyright 2023 Django Cass
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ *
+ */
+
+import React, {useState} from "react";
+import {
+	Alert,
+	Button,
+	Card,
+	List,
+	ListItem,
+	ListItemSecondaryAction,
+	ListItemText,
+	ListSubheader,
+	TextField,
+	Theme,
+	Typography
+} from "@mui/material";
+import {makeStyles} from "tss-react/mui";
+import {useTheme} from "@mui/material/styles";
+import {Code, GenericIconButton, ListItemSkeleton} from "jmp-coreui";
+import {mdiDeleteOutline} from "@mdi/js";
+import {formatDistanceToNow} from "date-fns";
+import {
+	useCreateAccessTokenMutation,
+	useListAccessTokensQuery,
+	useRevokeAccessTokenMutation
+} from "../../../generated/graphql";
+import InlineError from "../../alert/InlineError";
+import StandardLayout from "../../layout/StandardLayout";
+
+const useStyles = makeStyles()((theme: Theme) => ({
+	field: {
+		margin: theme.spacing(1)
+	},
+	button: {
+		margin: theme.spacing(1),
+		fontFamily: "Manrope",
+		fontWeight: 600,
+		textTransform: "none"
+	}
+}));
+
+interface AccessTokenListProps {
+	serviceAccount?: string;
+}
+
+export const AccessTokenList: React.FC<AccessTokenListProps> = ({serviceAccount}): JSX.Element => {
+	// hooks
+	const theme = useTheme();
+	const {classes} = useStyles();
+	const {data, loading: listLoading, error, refetch} = useListAccessTokensQuery({variables: {serviceAccount}});
+	const [createToken, {loading: createLoading, error: createErr}] = useCreateAccessTokenMutation();
+	const [revokeToken, {loading: revokeLoading, error: revokeErr}] = useRevokeAccessTokenMutation();
+
+	// local state
+	const [name, setName] = useState<string>("");
+	const [scopes, setScopes] = useState<string>("");
+	const [days, setDays] = useState<number>(30);
+	const [secret, setSecret] = useState<string>("");
+
+	const handleCreate = (): void => {
+		const expiresAt = Math.floor(Date.now() / 1000) + days * 24 * 60 * 60;
+		void createToken({variables: {
+			name: name.trim(),
+			serviceAccountID: serviceAccount,
+			scopes: scopes.split(",").map(s => s.trim()).filter(s => s !== ""),
+			expiresAt
+		}}).then(r => {
+			if (!r.errors && r.data) {
+				setName("");
+				setScopes("");
+				setSecret(r.data.createAccessToken.token);
+				void refetch();
+			}
+		});
+	}
+
+	const handleRevoke = (id: string): void => {
+		void revokeToken({variables: {id}}).then(r => {
+			if (!r.errors)
+				void refetch();
+		});
+	}
+
+	const busy = listLoading || createLoading || revokeLoading;
+
+	return <div>
+		{(error || createErr || revokeErr) && <InlineError error={error || createErr || revokeErr}/>}
+		{secret !== "" && <Alert
+			sx={{mb: 1}}
+			severity="success"
+			onClose={() => setSecret("")}>
+			Copy your new access token now. You won't be able to see it again.<br/>
+			<Code>{secret}</Code>
+		</Alert>}
+		<Card
+			style={{padding: theme.spacing(1)}}
+			variant="outlined">
+			<TextField
+				className={classes.field}
+				label="Name"
+				size="small"
+				value={name}
+				onChange={e => setName(e.target.value)}
+				disabled={busy}
+			/>
+			<TextField
+				className={classes.field}
+				label="Scopes"
+				helperText="Comma-separated list (e.g. refraction::<id>::READ)"
+				size="small"
+				value={scopes}
+				onChange={e => setScopes(e.target.value)}
+				disabled={busy}
+			/>
+			<TextField
+				className={classes.field}
+				label="Expires in (days)"
+				type="number"
+				size="small"
+				value={days}
+				onChange={e => setDays(Number(e.target.value))}
+				inputProps={{min: 1}}
+				disabled={busy}
+			/>
+			<Button
+				className={classes.button}
+				variant="outlined"
+				disabled={busy || name.trim() === "" || days < 1}
+				onClick={handleCreate}>
+				Create
+			</Button>
+		</Card>
+		<ListSubheader>Tokens ({data?.listAccessTokens.length || 0})</ListSubheader>
+		{data == null && error == null && <ListItemSkeleton/>}
+		{data?.listAccessTokens.length === 0 && <Typography
+			className={classes.field}
+			color="textSecondary"
+			variant="body2">
+			No access tokens have been created.
+		</Typography>}
+		<List dense>
+			{data?.listAccessTokens.map(t => <ListItem
+				key={t.id}>
+				<ListItemText
+					primary={<span>{t.name} - {(t.scopes as string[]).map(s => <Code key={s}>{s}</Code>)}</span>}
+					secondary={`Expires ${formatDistanceToNow(new Date(t.expiresAt * 1000), {addSuffix: true})}, ${t.lastUsedAt === 0 ? "never used" : `last used ${formatDistanceToNow(new Date(t.lastUsedAt * 1000), {addSuffix: true})}`}`}
+				/>
+				<ListItemSecondaryAction>
+					<GenericIconButton
+						title="Revoke"
+						icon={mdiDeleteOutline}
+						colour={theme.palette.error.main}
+						disabled={busy}
+						onClick={() => handleRevoke(t.id)}
+					/>
+				</ListItemSecondaryAction>
+			</ListItem>)}
+		</List>
+	</div>
+}
+
+const AccessTokens: React.FC = (): JSX.Element => {
+	return <StandardLayout>
+		<ListSubheader>
+			Access tokens
+		</ListSubheader>
+		<Typography
+			sx={{m: 1}}
+			color="textSecondary"
+			variant="body2">
+			Access tokens let package managers authenticate as you without going through the login proxy.
+			They can only access the resources in their scopes.
+		</Typography>
+		<AccessTokenList/>
+	</StandardLayout>
+}
+export default AccessTokens;
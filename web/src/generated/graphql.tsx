@@ -35,6 +35,35 @@ export type Scalars = {
   Strings: any;
 };
 
+export type AccessToken = {
+  __typename?: 'AccessToken';
+  createdAt: Scalars['Int'];
+  expiresAt: Scalars['Int'];
+  id: Scalars['ID'];
+  iss: Scalars['String'];
+  lastUsedAt: Scalars['Int'];
+  name: Scalars['String'];
+  scopes: Scalars['Strings'];
+  serviceAccountID: Scalars['ID'];
+  sub: Scalars['String'];
+};
+
+export type Advisory = {
+  __typename?: 'Advisory';
+  aliases: Scalars['Strings'];
+  id: Scalars['ID'];
+  modified: Scalars['Int'];
+  published: Scalars['Int'];
+  severity: Scalars['String'];
+  summary: Scalars['String'];
+};
+
+export type AffectedArtifacts = {
+  __typename?: 'AffectedArtifacts';
+  advisory: Advisory;
+  artifacts: Array<Artifact>;
+};
+
 export enum Archetype {
   Alpine = 'ALPINE',
   Debian = 'DEBIAN',
@@ -43,7 +72,9 @@ export enum Archetype {
   Helm = 'HELM',
   Maven = 'MAVEN',
   Npm = 'NPM',
+  Oci = 'OCI',
   Pip = 'PIP',
+  Rpm = 'RPM',
   Rust = 'RUST'
 }
 
@@ -53,9 +84,36 @@ export type Artifact = {
   downloads: Scalars['Int'];
   id: Scalars['ID'];
   remoteID: Scalars['ID'];
+  sha256: Scalars['String'];
   slices: Scalars['Strings'];
   updatedAt: Scalars['Int'];
   uri: Scalars['String'];
+  verifiedAt: Scalars['Int'];
+};
+
+export type AuditEvent = {
+  __typename?: 'AuditEvent';
+  action: Scalars['String'];
+  actor: Scalars['String'];
+  createdAt: Scalars['Int'];
+  diff: Scalars['String'];
+  id: Scalars['ID'];
+  resource: Scalars['String'];
+  sourceIP: Scalars['String'];
+};
+
+export type AuditEventPage = {
+  __typename?: 'AuditEventPage';
+  items: Array<AuditEvent>;
+  total: Scalars['Int'];
+};
+
+export type AuditFilter = {
+  action?: InputMaybe<Scalars['String']>;
+  actor?: InputMaybe<Scalars['String']>;
+  resource?: InputMaybe<Scalars['String']>;
+  since?: InputMaybe<Scalars['Int']>;
+  until?: InputMaybe<Scalars['Int']>;
 };
 
 export enum AuthMode {
@@ -80,17 +138,67 @@ export type BandwidthUsage = {
   usage: Scalars['Int'];
 };
 
+export enum BreakerState {
+  Closed = 'CLOSED',
+  HalfOpen = 'HALF_OPEN',
+  Open = 'OPEN'
+}
+
+export type CreatedAccessToken = {
+  __typename?: 'CreatedAccessToken';
+  accessToken: AccessToken;
+  token: Scalars['String'];
+};
+
+export enum EvictionReason {
+  KeepLatest = 'KEEP_LATEST',
+  MaxAge = 'MAX_AGE',
+  MaxSize = 'MAX_SIZE'
+}
+
+export type HealthEvent = {
+  __typename?: 'HealthEvent';
+  reason: Scalars['String'];
+  state: BreakerState;
+  time: Scalars['Int'];
+};
+
 export type Mutation = {
   __typename?: 'Mutation';
+  collectGarbage: Scalars['Boolean'];
+  createAccessToken: CreatedAccessToken;
+  createQuarantineExemption: QuarantineExemption;
   createRefraction: Refraction;
   createRemote: Remote;
   createRoleBinding: RoleBinding;
+  createServiceAccount: ServiceAccount;
   createTransportProfile: TransportSecurity;
+  deleteQuarantineExemption: Scalars['Boolean'];
+  deleteQuota: Scalars['Boolean'];
   deleteRefraction: Scalars['Boolean'];
   deleteRemote: Scalars['Boolean'];
+  deleteRoleBinding: Scalars['Boolean'];
+  deleteServiceAccount: Scalars['Boolean'];
   patchRefraction: Refraction;
   patchRemote: Remote;
+  revokeAccessToken: Scalars['Boolean'];
   setPreference: Scalars['Boolean'];
+  setQuota: Quota;
+};
+
+
+export type MutationCollectGarbageArgs = {
+  id: Scalars['ID'];
+};
+
+
+export type MutationCreateAccessTokenArgs = {
+  input: NewAccessToken;
+};
+
+
+export type MutationCreateQuarantineExemptionArgs = {
+  input: NewQuarantineExemption;
 };
 
 
@@ -109,11 +217,27 @@ export type MutationCreateRoleBindingArgs = {
 };
 
 
+export type MutationCreateServiceAccountArgs = {
+  input: NewServiceAccount;
+};
+
+
 export type MutationCreateTransportProfileArgs = {
   input: NewTransportProfile;
 };
 
 
+export type MutationDeleteQuarantineExemptionArgs = {
+  id: Scalars['ID'];
+};
+
+
+export type MutationDeleteQuotaArgs = {
+  resource: Scalars['String'];
+  type: BandwidthType;
+};
+
+
 export type MutationDeleteRefractionArgs = {
   id: Scalars['ID'];
 };
@@ -124,6 +248,16 @@ export type MutationDeleteRemoteArgs = {
 };
 
 
+export type MutationDeleteRoleBindingArgs = {
+  input: NewRoleBinding;
+};
+
+
+export type MutationDeleteServiceAccountArgs = {
+  id: Scalars['ID'];
+};
+
+
 export type MutationPatchRefractionArgs = {
   id: Scalars['ID'];
   input: PatchRefract;
@@ -136,20 +270,50 @@ export type MutationPatchRemoteArgs = {
 };
 
 
+export type MutationRevokeAccessTokenArgs = {
+  id: Scalars['ID'];
+};
+
+
 export type MutationSetPreferenceArgs = {
   key: Scalars['String'];
   value: Scalars['String'];
 };
 
+
+export type MutationSetQuotaArgs = {
+  input: SetQuota;
+};
+
+export type NewAccessToken = {
+  expiresAt: Scalars['Int'];
+  name: Scalars['String'];
+  scopes: Array<Scalars['String']>;
+  serviceAccountID?: InputMaybe<Scalars['ID']>;
+};
+
+export type NewQuarantineExemption = {
+  package: Scalars['String'];
+  refractionID: Scalars['ID'];
+  version?: Scalars['String'];
+};
+
 export type NewRefract = {
   archetype: Archetype;
   name: Scalars['String'];
+  offline?: Scalars['Boolean'];
+  pins?: InputMaybe<Scalars['StringMap']>;
+  private?: Scalars['Boolean'];
+  quarantineDays?: Scalars['Int'];
   remotes: Array<Scalars['ID']>;
+  strategy?: ResolutionStrategy;
+  vulnerabilityAction?: VulnerabilityAction;
 };
 
 export type NewRemote = {
   archetype: Archetype;
   authMode: AuthMode;
+  hosted?: InputMaybe<Scalars['Boolean']>;
   name: Scalars['String'];
   transport: Scalars['ID'];
   uri: Scalars['String'];
@@ -161,6 +325,11 @@ export type NewRoleBinding = {
   verb: Verb;
 };
 
+export type NewServiceAccount = {
+  description?: Scalars['String'];
+  name: Scalars['String'];
+};
+
 export type NewTransportProfile = {
   ca: Scalars['String'];
   cert: Scalars['String'];
@@ -192,7 +361,13 @@ export type Overview = {
 
 export type PatchRefract = {
   name: Scalars['String'];
+  offline?: Scalars['Boolean'];
+  pins?: InputMaybe<Scalars['StringMap']>;
+  private?: Scalars['Boolean'];
+  quarantineDays?: Scalars['Int'];
   remotes: Array<Scalars['ID']>;
+  strategy?: ResolutionStrategy;
+  vulnerabilityAction?: VulnerabilityAction;
 };
 
 export type PatchRemote = {
@@ -200,11 +375,24 @@ export type PatchRemote = {
   authHeaders: Array<Scalars['String']>;
   authMode: AuthMode;
   blocked: Array<Scalars['String']>;
+  breakerCooldown?: Scalars['Int'];
+  breakerThreshold?: Scalars['Int'];
   directHeader: Scalars['String'];
   directToken: Scalars['String'];
+  policyMode?: PolicyMode;
+  retentionKeepLatest?: Scalars['Int'];
+  retentionMaxAge?: Scalars['Int'];
+  retentionMaxSize?: Scalars['Int'];
+  rules?: Array<Scalars['String']>;
+  timeout?: Scalars['Int'];
   transportID: Scalars['ID'];
 };
 
+export enum PolicyMode {
+  Audit = 'AUDIT',
+  Enforce = 'ENFORCE'
+}
+
 export type Query = {
   __typename?: 'Query';
   getBandwidthUsage: Array<BandwidthUsage>;
@@ -212,14 +400,23 @@ export type Query = {
   getOverview: Overview;
   getRefraction: Refraction;
   getRemote: Remote;
+  getRemoteHealth: RemoteHealth;
   getRemoteOverview: RemoteOverview;
+  getRetentionReport: RetentionReport;
   getRoleBindings: Array<RoleBinding>;
   getTotalBandwidthUsage: Array<BandwidthUsage>;
   getUsers: Array<RoleBinding>;
+  listAccessTokens: Array<AccessToken>;
+  listAffectedArtifacts: Array<AffectedArtifacts>;
   listArtifacts: Array<Artifact>;
+  listAuditEvents: AuditEventPage;
   listCombinedArtifacts: Array<Artifact>;
+  listQuarantineExemptions: Array<QuarantineExemption>;
+  listQuotaEvents: Array<QuotaEvent>;
+  listQuotas: Array<Quota>;
   listRefractions: Array<Refraction>;
   listRemotes: Array<Remote>;
+  listServiceAccounts: Array<ServiceAccount>;
   listTransports: Array<TransportSecurity>;
   listUsers: Array<StoredUser>;
   userCan: Scalars['Boolean'];
@@ -243,11 +440,21 @@ export type QueryGetRemoteArgs = {
 };
 
 
+export type QueryGetRemoteHealthArgs = {
+  id: Scalars['ID'];
+};
+
+
 export type QueryGetRemoteOverviewArgs = {
   id: Scalars['ID'];
 };
 
 
+export type QueryGetRetentionReportArgs = {
+  id: Scalars['ID'];
+};
+
+
 export type QueryGetRoleBindingsArgs = {
   user: Scalars['String'];
 };
@@ -263,16 +470,48 @@ export type QueryGetUsersArgs = {
 };
 
 
+export type QueryListAccessTokensArgs = {
+  serviceAccount?: InputMaybe<Scalars['ID']>;
+};
+
+
+export type QueryListAffectedArtifactsArgs = {
+  refract?: InputMaybe<Scalars['ID']>;
+};
+
+
 export type QueryListArtifactsArgs = {
   remote: Scalars['ID'];
 };
 
 
+export type QueryListAuditEventsArgs = {
+  filter?: InputMaybe<AuditFilter>;
+  limit?: Scalars['Int'];
+  offset?: Scalars['Int'];
+};
+
+
 export type QueryListCombinedArtifactsArgs = {
   refract: Scalars['ID'];
 };
 
 
+export type QueryListQuarantineExemptionsArgs = {
+  refract: Scalars['ID'];
+};
+
+
+export type QueryListQuotaEventsArgs = {
+  resource: Scalars['String'];
+};
+
+
+export type QueryListQuotasArgs = {
+  resource: Scalars['String'];
+};
+
+
 export type QueryListRemotesArgs = {
   arch: Scalars['String'];
 };
@@ -288,31 +527,97 @@ export type QueryUserHasArgs = {
   role: Role;
 };
 
+export type QuarantineExemption = {
+  __typename?: 'QuarantineExemption';
+  createdAt: Scalars['Int'];
+  createdBy: Scalars['String'];
+  id: Scalars['ID'];
+  package: Scalars['String'];
+  refractionID: Scalars['ID'];
+  version: Scalars['String'];
+};
+
+export type Quota = {
+  __typename?: 'Quota';
+  action: QuotaAction;
+  id: Scalars['ID'];
+  limit: Scalars['Int'];
+  resource: Scalars['String'];
+  type: BandwidthType;
+};
+
+export enum QuotaAction {
+  CacheOnly = 'CACHE_ONLY',
+  InsufficientStorage = 'INSUFFICIENT_STORAGE',
+  TooManyRequests = 'TOO_MANY_REQUESTS'
+}
+
+export type QuotaEvent = {
+  __typename?: 'QuotaEvent';
+  createdAt: Scalars['Int'];
+  date: Scalars['String'];
+  id: Scalars['ID'];
+  limit: Scalars['Int'];
+  resource: Scalars['String'];
+  threshold: Scalars['Int'];
+  type: BandwidthType;
+  usage: Scalars['Int'];
+};
+
 export type Refraction = {
   __typename?: 'Refraction';
   archetype: Archetype;
   createdAt: Scalars['Int'];
   id: Scalars['ID'];
   name: Scalars['String'];
+  offline: Scalars['Boolean'];
+  pins: Scalars['StringMap'];
+  private: Scalars['Boolean'];
+  quarantineDays: Scalars['Int'];
   remotes: Array<Remote>;
+  strategy: ResolutionStrategy;
   updatedAt: Scalars['Int'];
+  vulnerabilityAction: VulnerabilityAction;
 };
 
 export type Remote = {
   __typename?: 'Remote';
   archetype: Archetype;
+  breakerCooldown: Scalars['Int'];
+  breakerThreshold: Scalars['Int'];
   createdAt: Scalars['Int'];
   enabled: Scalars['Boolean'];
+  hosted: Scalars['Boolean'];
   id: Scalars['ID'];
   name: Scalars['String'];
+  retentionKeepLatest: Scalars['Int'];
+  retentionMaxAge: Scalars['Int'];
+  retentionMaxSize: Scalars['Int'];
   security: RemoteSecurity;
   securityID: Scalars['ID'];
+  timeout: Scalars['Int'];
   transport: TransportSecurity;
   transportID: Scalars['ID'];
   updatedAt: Scalars['Int'];
   uri: Scalars['String'];
 };
 
+export type RemoteHealth = {
+  __typename?: 'RemoteHealth';
+  consecutiveFailures: Scalars['Int'];
+  errorRate: Scalars['Float'];
+  failures: Scalars['Int'];
+  history: Array<HealthEvent>;
+  id: Scalars['ID'];
+  lastError: Scalars['String'];
+  lastFailure: Scalars['Int'];
+  latency: Scalars['Int'];
+  latencyMax: Scalars['Int'];
+  replica: Scalars['String'];
+  requests: Scalars['Int'];
+  state: BreakerState;
+};
+
 export type RemoteOverview = {
   __typename?: 'RemoteOverview';
   artifacts: Scalars['Int'];
@@ -328,6 +633,32 @@ export type RemoteSecurity = {
   directHeader: Scalars['String'];
   directToken: Scalars['String'];
   id: Scalars['ID'];
+  policyMode: PolicyMode;
+  rules: Scalars['Strings'];
+};
+
+export enum ResolutionStrategy {
+  Fastest = 'FASTEST',
+  Ordered = 'ORDERED',
+  Pinned = 'PINNED'
+}
+
+export type RetentionCandidate = {
+  __typename?: 'RetentionCandidate';
+  downloads: Scalars['Int'];
+  id: Scalars['ID'];
+  reason: EvictionReason;
+  size: Scalars['Int'];
+  updatedAt: Scalars['Int'];
+  uri: Scalars['String'];
+};
+
+export type RetentionReport = {
+  __typename?: 'RetentionReport';
+  candidates: Array<RetentionCandidate>;
+  count: Scalars['Int'];
+  remoteID: Scalars['ID'];
+  size: Scalars['Int'];
 };
 
 export enum Role {
@@ -342,6 +673,22 @@ export type RoleBinding = {
   verb: Verb;
 };
 
+export type ServiceAccount = {
+  __typename?: 'ServiceAccount';
+  createdAt: Scalars['Int'];
+  createdBy: Scalars['String'];
+  description: Scalars['String'];
+  id: Scalars['ID'];
+  name: Scalars['String'];
+};
+
+export type SetQuota = {
+  action?: QuotaAction;
+  limit: Scalars['Int'];
+  resource: Scalars['String'];
+  type: BandwidthType;
+};
+
 export type StoredUser = {
   __typename?: 'StoredUser';
   claims: Scalars['StringMap'];
@@ -383,6 +730,22 @@ export enum Verb {
   Update = 'UPDATE'
 }
 
+export enum VulnerabilityAction {
+  Block = 'BLOCK',
+  Hide = 'HIDE',
+  None = 'NONE',
+  Warn = 'WARN'
+}
+
+export type ListAuditEventsQueryVariables = Exact<{
+  filter?: InputMaybe<AuditFilter>;
+  offset: Scalars['Int'];
+  limit: Scalars['Int'];
+}>;
+
+
+export type ListAuditEventsQuery = { __typename?: 'Query', listAuditEvents: { __typename?: 'AuditEventPage', total: number, items: Array<{ __typename?: 'AuditEvent', id: string, createdAt: number, actor: string, action: string, resource: string, diff: string, sourceIP: string }> } };
+
 export type GetBandwidthQueryVariables = Exact<{
   resource: Scalars['String'];
   date: Scalars['String'];
@@ -398,6 +761,31 @@ export type GetTotalBandwidthQueryVariables = Exact<{
 
 export type GetTotalBandwidthQuery = { __typename?: 'Query', getTotalBandwidthUsage: Array<{ __typename?: 'BandwidthUsage', usage: number, type: BandwidthType }> };
 
+export type ListQuotasQueryVariables = Exact<{
+  resource: Scalars['String'];
+}>;
+
+
+export type ListQuotasQuery = { __typename?: 'Query', listQuotas: Array<{ __typename?: 'Quota', id: string, resource: string, type: BandwidthType, limit: number, action: QuotaAction }>, listQuotaEvents: Array<{ __typename?: 'QuotaEvent', id: string, createdAt: number, date: string, type: BandwidthType, threshold: number, usage: number, limit: number }> };
+
+export type SetQuotaMutationVariables = Exact<{
+  resource: Scalars['String'];
+  type: BandwidthType;
+  limit: Scalars['Int'];
+  action: QuotaAction;
+}>;
+
+
+export type SetQuotaMutation = { __typename?: 'Mutation', setQuota: { __typename?: 'Quota', id: string } };
+
+export type DeleteQuotaMutationVariables = Exact<{
+  resource: Scalars['String'];
+  type: BandwidthType;
+}>;
+
+
+export type DeleteQuotaMutation = { __typename?: 'Mutation', deleteQuota: boolean };
+
 export type GetOverviewQueryVariables = Exact<{ [key: string]: never; }>;
 
 
@@ -408,7 +796,7 @@ export type OverviewQueryVariables = Exact<{
 }>;
 
 
-export type OverviewQuery = { __typename?: 'Query', getRefraction: { __typename?: 'Refraction', id: string, name: string, createdAt: number, updatedAt: number, archetype: Archetype }, listCombinedArtifacts: Array<{ __typename?: 'Artifact', id: string, uri: string, updatedAt: number, createdAt: number, downloads: number }> };
+export type OverviewQuery = { __typename?: 'Query', getRefraction: { __typename?: 'Refraction', id: string, name: string, createdAt: number, updatedAt: number, archetype: Archetype }, listCombinedArtifacts: Array<{ __typename?: 'Artifact', id: string, uri: string, updatedAt: number, createdAt: number, downloads: number, sha256: string, verifiedAt: number }> };
 
 export type CreateRoleBindingMutationVariables = Exact<{
   subject: Scalars['String'];
@@ -471,10 +859,60 @@ export type SetPreferenceMutationVariables = Exact<{
 
 export type SetPreferenceMutation = { __typename?: 'Mutation', setPreference: boolean };
 
+export type ListAccessTokensQueryVariables = Exact<{
+  serviceAccount?: InputMaybe<Scalars['ID']>;
+}>;
+
+
+export type ListAccessTokensQuery = { __typename?: 'Query', listAccessTokens: Array<{ __typename?: 'AccessToken', id: string, createdAt: number, name: string, scopes: any, expiresAt: number, lastUsedAt: number }> };
+
+export type ListServiceAccountsQueryVariables = Exact<{ [key: string]: never; }>;
+
+
+export type ListServiceAccountsQuery = { __typename?: 'Query', listServiceAccounts: Array<{ __typename?: 'ServiceAccount', id: string, createdAt: number, name: string, description: string, createdBy: string }> };
+
+export type CreateAccessTokenMutationVariables = Exact<{
+  name: Scalars['String'];
+  serviceAccountID?: InputMaybe<Scalars['ID']>;
+  scopes: Array<Scalars['String']> | Scalars['String'];
+  expiresAt: Scalars['Int'];
+}>;
+
+
+export type CreateAccessTokenMutation = { __typename?: 'Mutation', createAccessToken: { __typename?: 'CreatedAccessToken', token: string } };
+
+export type RevokeAccessTokenMutationVariables = Exact<{
+  id: Scalars['ID'];
+}>;
+
+
+export type RevokeAccessTokenMutation = { __typename?: 'Mutation', revokeAccessToken: boolean };
+
+export type CreateServiceAccountMutationVariables = Exact<{
+  name: Scalars['String'];
+  description: Scalars['String'];
+}>;
+
+
+export type CreateServiceAccountMutation = { __typename?: 'Mutation', createServiceAccount: { __typename?: 'ServiceAccount', id: string } };
+
+export type DeleteServiceAccountMutationVariables = Exact<{
+  id: Scalars['ID'];
+}>;
+
+
+export type DeleteServiceAccountMutation = { __typename?: 'Mutation', deleteServiceAccount: boolean };
+
 export type PatchRefractMutationVariables = Exact<{
   id: Scalars['ID'];
   name: Scalars['String'];
   remotes: Array<Scalars['ID']> | Scalars['ID'];
+  strategy: ResolutionStrategy;
+  pins: Scalars['StringMap'];
+  offline: Scalars['Boolean'];
+  quarantineDays: Scalars['Int'];
+  vulnerabilityAction: VulnerabilityAction;
+  private: Scalars['Boolean'];
 }>;
 
 
@@ -501,7 +939,7 @@ export type GetRefractionQueryVariables = Exact<{
 }>;
 
 
-export type GetRefractionQuery = { __typename?: 'Query', getRefraction: { __typename?: 'Refraction', id: string, createdAt: number, updatedAt: number, name: string, archetype: Archetype, remotes: Array<{ __typename?: 'Remote', id: string, name: string }> } };
+export type GetRefractionQuery = { __typename?: 'Query', getRefraction: { __typename?: 'Refraction', id: string, createdAt: number, updatedAt: number, name: string, archetype: Archetype, strategy: ResolutionStrategy, pins: any, offline: boolean, quarantineDays: number, vulnerabilityAction: VulnerabilityAction, private: boolean, remotes: Array<{ __typename?: 'Remote', id: string, name: string }> } };
 
 export type ListRefractionsQueryVariables = Exact<{ [key: string]: never; }>;
 
@@ -513,11 +951,42 @@ export type RefSelectQueryVariables = Exact<{ [key: string]: never; }>;
 
 export type RefSelectQuery = { __typename?: 'Query', listRefractions: Array<{ __typename?: 'Refraction', id: string, name: string }> };
 
+export type ListQuarantineExemptionsQueryVariables = Exact<{
+  refract: Scalars['ID'];
+}>;
+
+
+export type ListQuarantineExemptionsQuery = { __typename?: 'Query', listQuarantineExemptions: Array<{ __typename?: 'QuarantineExemption', id: string, createdAt: number, package: string, version: string, createdBy: string }> };
+
+export type CreateQuarantineExemptionMutationVariables = Exact<{
+  refractionID: Scalars['ID'];
+  package: Scalars['String'];
+  version: Scalars['String'];
+}>;
+
+
+export type CreateQuarantineExemptionMutation = { __typename?: 'Mutation', createQuarantineExemption: { __typename?: 'QuarantineExemption', id: string } };
+
+export type DeleteQuarantineExemptionMutationVariables = Exact<{
+  id: Scalars['ID'];
+}>;
+
+
+export type DeleteQuarantineExemptionMutation = { __typename?: 'Mutation', deleteQuarantineExemption: boolean };
+
+export type ListAffectedArtifactsQueryVariables = Exact<{
+  refract?: InputMaybe<Scalars['ID']>;
+}>;
+
+
+export type ListAffectedArtifactsQuery = { __typename?: 'Query', listAffectedArtifacts: Array<{ __typename?: 'AffectedArtifacts', advisory: { __typename?: 'Advisory', id: string, summary: string, severity: string }, artifacts: Array<{ __typename?: 'Artifact', id: string, uri: string }> }> };
+
 export type CreateRemoteMutationVariables = Exact<{
   name: Scalars['String'];
   uri: Scalars['String'];
   archetype: Archetype;
   transport: Scalars['ID'];
+  hosted: Scalars['Boolean'];
 }>;
 
 
@@ -528,10 +997,18 @@ export type PatchRemoteMutationVariables = Exact<{
   transportID: Scalars['ID'];
   allowed: Array<Scalars['String']> | Scalars['String'];
   blocked: Array<Scalars['String']> | Scalars['String'];
+  rules: Array<Scalars['String']> | Scalars['String'];
+  policyMode: PolicyMode;
   authHeaders: Array<Scalars['String']> | Scalars['String'];
   directHeader: Scalars['String'];
   directToken: Scalars['String'];
   authMode: AuthMode;
+  timeout: Scalars['Int'];
+  breakerThreshold: Scalars['Int'];
+  breakerCooldown: Scalars['Int'];
+  retentionMaxAge: Scalars['Int'];
+  retentionMaxSize: Scalars['Int'];
+  retentionKeepLatest: Scalars['Int'];
 }>;
 
 
@@ -549,14 +1026,35 @@ export type GetRemoteQueryVariables = Exact<{
 }>;
 
 
-export type GetRemoteQuery = { __typename?: 'Query', getRemote: { __typename?: 'Remote', id: string, createdAt: number, updatedAt: number, name: string, uri: string, archetype: Archetype, enabled: boolean, security: { __typename?: 'RemoteSecurity', id: string, allowed: any, blocked: any, authMode: AuthMode, directHeader: string, directToken: string, authHeaders: any }, transport: { __typename?: 'TransportSecurity', id: string, name: string, cert: string, key: string, ca: string, skipTLSVerify: boolean, httpProxy: string, httpsProxy: string, noProxy: string } } };
+export type GetRemoteQuery = { __typename?: 'Query', getRemote: { __typename?: 'Remote', id: string, createdAt: number, updatedAt: number, name: string, uri: string, archetype: Archetype, enabled: boolean, hosted: boolean, timeout: number, breakerThreshold: number, breakerCooldown: number, retentionMaxAge: number, retentionMaxSize: number, retentionKeepLatest: number, security: { __typename?: 'RemoteSecurity', id: string, allowed: any, blocked: any, rules: any, policyMode: PolicyMode, authMode: AuthMode, directHeader: string, directToken: string, authHeaders: any }, transport: { __typename?: 'TransportSecurity', id: string, name: string, cert: string, key: string, ca: string, skipTLSVerify: boolean, httpProxy: string, httpsProxy: string, noProxy: string } } };
+
+export type GetRemoteHealthQueryVariables = Exact<{
+  id: Scalars['ID'];
+}>;
+
+
+export type GetRemoteHealthQuery = { __typename?: 'Query', getRemoteHealth: { __typename?: 'RemoteHealth', id: string, replica: string, state: BreakerState, requests: number, failures: number, errorRate: number, latency: number, latencyMax: number, consecutiveFailures: number, lastError: string, lastFailure: number, history: Array<{ __typename?: 'HealthEvent', time: number, state: BreakerState, reason: string }> } };
+
+export type GetRetentionReportQueryVariables = Exact<{
+  id: Scalars['ID'];
+}>;
+
+
+export type GetRetentionReportQuery = { __typename?: 'Query', getRetentionReport: { __typename?: 'RetentionReport', remoteID: string, count: number, size: number, candidates: Array<{ __typename?: 'RetentionCandidate', id: string, uri: string, reason: EvictionReason, size: number, downloads: number, updatedAt: number }> } };
+
+export type CollectGarbageMutationVariables = Exact<{
+  id: Scalars['ID'];
+}>;
+
+
+export type CollectGarbageMutation = { __typename?: 'Mutation', collectGarbage: boolean };
 
 export type ListRemotesQueryVariables = Exact<{
   arch: Scalars['String'];
 }>;
 
 
-export type ListRemotesQuery = { __typename?: 'Query', listRemotes: Array<{ __typename?: 'Remote', id: string, name: string, updatedAt: number, createdAt: number, uri: string, enabled: boolean, archetype: Archetype }> };
+export type ListRemotesQuery = { __typename?: 'Query', listRemotes: Array<{ __typename?: 'Remote', id: string, name: string, updatedAt: number, createdAt: number, uri: string, enabled: boolean, hosted: boolean, archetype: Archetype }> };
 
 export type CreateTransportMutationVariables = Exact<{
   name: Scalars['String'];
@@ -578,33 +1076,79 @@ export type ListTransportsQueryVariables = Exact<{ [key: string]: never; }>;
 export type ListTransportsQuery = { __typename?: 'Query', listTransports: Array<{ __typename?: 'TransportSecurity', id: string, name: string, skipTLSVerify: boolean, ca: string, cert: string, noProxy: string, httpProxy: string, httpsProxy: string }> };
 
 
-export const GetBandwidthDocument = gql`
-    query getBandwidth($resource: String!, $date: String!) {
-  getBandwidthUsage(resource: $resource, date: $date) {
-    id
-    date
-    resource
-    usage
-    limit
-    type
+export const ListAuditEventsDocument = gql`
+    query listAuditEvents($filter: AuditFilter, $offset: Int!, $limit: Int!) {
+  listAuditEvents(filter: $filter, offset: $offset, limit: $limit) {
+    total
+    items {
+      id
+      createdAt
+      actor
+      action
+      resource
+      diff
+      sourceIP
+    }
   }
 }
     `;
 
 /**
- * __useGetBandwidthQuery__
+ * __useListAuditEventsQuery__
  *
- * To run a query within a React component, call `useGetBandwidthQuery` and pass it any options that fit your needs.
- * When your component renders, `useGetBandwidthQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * To run a query within a React component, call `useListAuditEventsQuery` and pass it any options that fit your needs.
+ * When your component renders, `useListAuditEventsQuery` returns an object from Apollo Client that contains loading, error, and data properties
  * you can use to render your UI.
  *
  * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
  *
  * @example
- * const { data, loading, error } = useGetBandwidthQuery({
+ * const { data, loading, error } = useListAuditEventsQuery({
  *   variables: {
- *      resource: // value for 'resource'
- *      date: // value for 'date'
+ *      filter: // value for 'filter'
+ *      offset: // value for 'offset'
+ *      limit: // value for 'limit'
+ *   },
+ * });
+ */
+export function useListAuditEventsQuery(baseOptions: Apollo.QueryHookOptions<ListAuditEventsQuery, ListAuditEventsQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<ListAuditEventsQuery, ListAuditEventsQueryVariables>(ListAuditEventsDocument, options);
+      }
+export function useListAuditEventsLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<ListAuditEventsQuery, ListAuditEventsQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<ListAuditEventsQuery, ListAuditEventsQueryVariables>(ListAuditEventsDocument, options);
+        }
+export type ListAuditEventsQueryHookResult = ReturnType<typeof useListAuditEventsQuery>;
+export type ListAuditEventsLazyQueryHookResult = ReturnType<typeof useListAuditEventsLazyQuery>;
+export type ListAuditEventsQueryResult = Apollo.QueryResult<ListAuditEventsQuery, ListAuditEventsQueryVariables>;
+export const GetBandwidthDocument = gql`
+    query getBandwidth($resource: String!, $date: String!) {
+  getBandwidthUsage(resource: $resource, date: $date) {
+    id
+    date
+    resource
+    usage
+    limit
+    type
+  }
+}
+    `;
+
+/**
+ * __useGetBandwidthQuery__
+ *
+ * To run a query within a React component, call `useGetBandwidthQuery` and pass it any options that fit your needs.
+ * When your component renders, `useGetBandwidthQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useGetBandwidthQuery({
+ *   variables: {
+ *      resource: // value for 'resource'
+ *      date: // value for 'date'
  *   },
  * });
  */
@@ -655,6 +1199,124 @@ export function useGetTotalBandwidthLazyQuery(baseOptions?: Apollo.LazyQueryHook
 export type GetTotalBandwidthQueryHookResult = ReturnType<typeof useGetTotalBandwidthQuery>;
 export type GetTotalBandwidthLazyQueryHookResult = ReturnType<typeof useGetTotalBandwidthLazyQuery>;
 export type GetTotalBandwidthQueryResult = Apollo.QueryResult<GetTotalBandwidthQuery, GetTotalBandwidthQueryVariables>;
+export const ListQuotasDocument = gql`
+    query listQuotas($resource: String!) {
+  listQuotas(resource: $resource) {
+    id
+    resource
+    type
+    limit
+    action
+  }
+  listQuotaEvents(resource: $resource) {
+    id
+    createdAt
+    date
+    type
+    threshold
+    usage
+    limit
+  }
+}
+    `;
+
+/**
+ * __useListQuotasQuery__
+ *
+ * To run a query within a React component, call `useListQuotasQuery` and pass it any options that fit your needs.
+ * When your component renders, `useListQuotasQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useListQuotasQuery({
+ *   variables: {
+ *      resource: // value for 'resource'
+ *   },
+ * });
+ */
+export function useListQuotasQuery(baseOptions: Apollo.QueryHookOptions<ListQuotasQuery, ListQuotasQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<ListQuotasQuery, ListQuotasQueryVariables>(ListQuotasDocument, options);
+      }
+export function useListQuotasLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<ListQuotasQuery, ListQuotasQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<ListQuotasQuery, ListQuotasQueryVariables>(ListQuotasDocument, options);
+        }
+export type ListQuotasQueryHookResult = ReturnType<typeof useListQuotasQuery>;
+export type ListQuotasLazyQueryHookResult = ReturnType<typeof useListQuotasLazyQuery>;
+export type ListQuotasQueryResult = Apollo.QueryResult<ListQuotasQuery, ListQuotasQueryVariables>;
+export const SetQuotaDocument = gql`
+    mutation setQuota($resource: String!, $type: BandwidthType!, $limit: Int!, $action: QuotaAction!) {
+  setQuota(
+    input: {resource: $resource, type: $type, limit: $limit, action: $action}
+  ) {
+    id
+  }
+}
+    `;
+export type SetQuotaMutationFn = Apollo.MutationFunction<SetQuotaMutation, SetQuotaMutationVariables>;
+
+/**
+ * __useSetQuotaMutation__
+ *
+ * To run a mutation, you first call `useSetQuotaMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useSetQuotaMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [setQuotaMutation, { data, loading, error }] = useSetQuotaMutation({
+ *   variables: {
+ *      resource: // value for 'resource'
+ *      type: // value for 'type'
+ *      limit: // value for 'limit'
+ *      action: // value for 'action'
+ *   },
+ * });
+ */
+export function useSetQuotaMutation(baseOptions?: Apollo.MutationHookOptions<SetQuotaMutation, SetQuotaMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<SetQuotaMutation, SetQuotaMutationVariables>(SetQuotaDocument, options);
+      }
+export type SetQuotaMutationHookResult = ReturnType<typeof useSetQuotaMutation>;
+export type SetQuotaMutationResult = Apollo.MutationResult<SetQuotaMutation>;
+export type SetQuotaMutationOptions = Apollo.BaseMutationOptions<SetQuotaMutation, SetQuotaMutationVariables>;
+export const DeleteQuotaDocument = gql`
+    mutation deleteQuota($resource: String!, $type: BandwidthType!) {
+  deleteQuota(resource: $resource, type: $type)
+}
+    `;
+export type DeleteQuotaMutationFn = Apollo.MutationFunction<DeleteQuotaMutation, DeleteQuotaMutationVariables>;
+
+/**
+ * __useDeleteQuotaMutation__
+ *
+ * To run a mutation, you first call `useDeleteQuotaMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useDeleteQuotaMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [deleteQuotaMutation, { data, loading, error }] = useDeleteQuotaMutation({
+ *   variables: {
+ *      resource: // value for 'resource'
+ *      type: // value for 'type'
+ *   },
+ * });
+ */
+export function useDeleteQuotaMutation(baseOptions?: Apollo.MutationHookOptions<DeleteQuotaMutation, DeleteQuotaMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<DeleteQuotaMutation, DeleteQuotaMutationVariables>(DeleteQuotaDocument, options);
+      }
+export type DeleteQuotaMutationHookResult = ReturnType<typeof useDeleteQuotaMutation>;
+export type DeleteQuotaMutationResult = Apollo.MutationResult<DeleteQuotaMutation>;
+export type DeleteQuotaMutationOptions = Apollo.BaseMutationOptions<DeleteQuotaMutation, DeleteQuotaMutationVariables>;
 export const GetOverviewDocument = gql`
     query getOverview {
   getOverview {
@@ -717,6 +1379,8 @@ export const OverviewDocument = gql`
     updatedAt
     createdAt
     downloads
+    sha256
+    verifiedAt
   }
 }
     `;
@@ -1063,9 +1727,224 @@ export function useSetPreferenceMutation(baseOptions?: Apollo.MutationHookOption
 export type SetPreferenceMutationHookResult = ReturnType<typeof useSetPreferenceMutation>;
 export type SetPreferenceMutationResult = Apollo.MutationResult<SetPreferenceMutation>;
 export type SetPreferenceMutationOptions = Apollo.BaseMutationOptions<SetPreferenceMutation, SetPreferenceMutationVariables>;
+export const ListAccessTokensDocument = gql`
+    query listAccessTokens($serviceAccount: ID) {
+  listAccessTokens(serviceAccount: $serviceAccount) {
+    id
+    createdAt
+    name
+    scopes
+    expiresAt
+    lastUsedAt
+  }
+}
+    `;
+
+/**
+ * __useListAccessTokensQuery__
+ *
+ * To run a query within a React component, call `useListAccessTokensQuery` and pass it any options that fit your needs.
+ * When your component renders, `useListAccessTokensQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useListAccessTokensQuery({
+ *   variables: {
+ *      serviceAccount: // value for 'serviceAccount'
+ *   },
+ * });
+ */
+export function useListAccessTokensQuery(baseOptions?: Apollo.QueryHookOptions<ListAccessTokensQuery, ListAccessTokensQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<ListAccessTokensQuery, ListAccessTokensQueryVariables>(ListAccessTokensDocument, options);
+      }
+export function useListAccessTokensLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<ListAccessTokensQuery, ListAccessTokensQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<ListAccessTokensQuery, ListAccessTokensQueryVariables>(ListAccessTokensDocument, options);
+        }
+export type ListAccessTokensQueryHookResult = ReturnType<typeof useListAccessTokensQuery>;
+export type ListAccessTokensLazyQueryHookResult = ReturnType<typeof useListAccessTokensLazyQuery>;
+export type ListAccessTokensQueryResult = Apollo.QueryResult<ListAccessTokensQuery, ListAccessTokensQueryVariables>;
+export const ListServiceAccountsDocument = gql`
+    query listServiceAccounts {
+  listServiceAccounts {
+    id
+    createdAt
+    name
+    description
+    createdBy
+  }
+}
+    `;
+
+/**
+ * __useListServiceAccountsQuery__
+ *
+ * To run a query within a React component, call `useListServiceAccountsQuery` and pass it any options that fit your needs.
+ * When your component renders, `useListServiceAccountsQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useListServiceAccountsQuery({
+ *   variables: {
+ *   },
+ * });
+ */
+export function useListServiceAccountsQuery(baseOptions?: Apollo.QueryHookOptions<ListServiceAccountsQuery, ListServiceAccountsQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<ListServiceAccountsQuery, ListServiceAccountsQueryVariables>(ListServiceAccountsDocument, options);
+      }
+export function useListServiceAccountsLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<ListServiceAccountsQuery, ListServiceAccountsQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<ListServiceAccountsQuery, ListServiceAccountsQueryVariables>(ListServiceAccountsDocument, options);
+        }
+export type ListServiceAccountsQueryHookResult = ReturnType<typeof useListServiceAccountsQuery>;
+export type ListServiceAccountsLazyQueryHookResult = ReturnType<typeof useListServiceAccountsLazyQuery>;
+export type ListServiceAccountsQueryResult = Apollo.QueryResult<ListServiceAccountsQuery, ListServiceAccountsQueryVariables>;
+export const CreateAccessTokenDocument = gql`
+    mutation createAccessToken($name: String!, $serviceAccountID: ID, $scopes: [String!]!, $expiresAt: Int!) {
+  createAccessToken(
+    input: {name: $name, serviceAccountID: $serviceAccountID, scopes: $scopes, expiresAt: $expiresAt}
+  ) {
+    token
+  }
+}
+    `;
+export type CreateAccessTokenMutationFn = Apollo.MutationFunction<CreateAccessTokenMutation, CreateAccessTokenMutationVariables>;
+
+/**
+ * __useCreateAccessTokenMutation__
+ *
+ * To run a mutation, you first call `useCreateAccessTokenMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useCreateAccessTokenMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [createAccessTokenMutation, { data, loading, error }] = useCreateAccessTokenMutation({
+ *   variables: {
+ *      name: // value for 'name'
+ *      serviceAccountID: // value for 'serviceAccountID'
+ *      scopes: // value for 'scopes'
+ *      expiresAt: // value for 'expiresAt'
+ *   },
+ * });
+ */
+export function useCreateAccessTokenMutation(baseOptions?: Apollo.MutationHookOptions<CreateAccessTokenMutation, CreateAccessTokenMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<CreateAccessTokenMutation, CreateAccessTokenMutationVariables>(CreateAccessTokenDocument, options);
+      }
+export type CreateAccessTokenMutationHookResult = ReturnType<typeof useCreateAccessTokenMutation>;
+export type CreateAccessTokenMutationResult = Apollo.MutationResult<CreateAccessTokenMutation>;
+export type CreateAccessTokenMutationOptions = Apollo.BaseMutationOptions<CreateAccessTokenMutation, CreateAccessTokenMutationVariables>;
+export const RevokeAccessTokenDocument = gql`
+    mutation revokeAccessToken($id: ID!) {
+  revokeAccessToken(id: $id)
+}
+    `;
+export type RevokeAccessTokenMutationFn = Apollo.MutationFunction<RevokeAccessTokenMutation, RevokeAccessTokenMutationVariables>;
+
+/**
+ * __useRevokeAccessTokenMutation__
+ *
+ * To run a mutation, you first call `useRevokeAccessTokenMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useRevokeAccessTokenMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [revokeAccessTokenMutation, { data, loading, error }] = useRevokeAccessTokenMutation({
+ *   variables: {
+ *      id: // value for 'id'
+ *   },
+ * });
+ */
+export function useRevokeAccessTokenMutation(baseOptions?: Apollo.MutationHookOptions<RevokeAccessTokenMutation, RevokeAccessTokenMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<RevokeAccessTokenMutation, RevokeAccessTokenMutationVariables>(RevokeAccessTokenDocument, options);
+      }
+export type RevokeAccessTokenMutationHookResult = ReturnType<typeof useRevokeAccessTokenMutation>;
+export type RevokeAccessTokenMutationResult = Apollo.MutationResult<RevokeAccessTokenMutation>;
+export type RevokeAccessTokenMutationOptions = Apollo.BaseMutationOptions<RevokeAccessTokenMutation, RevokeAccessTokenMutationVariables>;
+export const CreateServiceAccountDocument = gql`
+    mutation createServiceAccount($name: String!, $description: String!) {
+  createServiceAccount(input: {name: $name, description: $description}) {
+    id
+  }
+}
+    `;
+export type CreateServiceAccountMutationFn = Apollo.MutationFunction<CreateServiceAccountMutation, CreateServiceAccountMutationVariables>;
+
+/**
+ * __useCreateServiceAccountMutation__
+ *
+ * To run a mutation, you first call `useCreateServiceAccountMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useCreateServiceAccountMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [createServiceAccountMutation, { data, loading, error }] = useCreateServiceAccountMutation({
+ *   variables: {
+ *      name: // value for 'name'
+ *      description: // value for 'description'
+ *   },
+ * });
+ */
+export function useCreateServiceAccountMutation(baseOptions?: Apollo.MutationHookOptions<CreateServiceAccountMutation, CreateServiceAccountMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<CreateServiceAccountMutation, CreateServiceAccountMutationVariables>(CreateServiceAccountDocument, options);
+      }
+export type CreateServiceAccountMutationHookResult = ReturnType<typeof useCreateServiceAccountMutation>;
+export type CreateServiceAccountMutationResult = Apollo.MutationResult<CreateServiceAccountMutation>;
+export type CreateServiceAccountMutationOptions = Apollo.BaseMutationOptions<CreateServiceAccountMutation, CreateServiceAccountMutationVariables>;
+export const DeleteServiceAccountDocument = gql`
+    mutation deleteServiceAccount($id: ID!) {
+  deleteServiceAccount(id: $id)
+}
+    `;
+export type DeleteServiceAccountMutationFn = Apollo.MutationFunction<DeleteServiceAccountMutation, DeleteServiceAccountMutationVariables>;
+
+/**
+ * __useDeleteServiceAccountMutation__
+ *
+ * To run a mutation, you first call `useDeleteServiceAccountMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useDeleteServiceAccountMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [deleteServiceAccountMutation, { data, loading, error }] = useDeleteServiceAccountMutation({
+ *   variables: {
+ *      id: // value for 'id'
+ *   },
+ * });
+ */
+export function useDeleteServiceAccountMutation(baseOptions?: Apollo.MutationHookOptions<DeleteServiceAccountMutation, DeleteServiceAccountMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<DeleteServiceAccountMutation, DeleteServiceAccountMutationVariables>(DeleteServiceAccountDocument, options);
+      }
+export type DeleteServiceAccountMutationHookResult = ReturnType<typeof useDeleteServiceAccountMutation>;
+export type DeleteServiceAccountMutationResult = Apollo.MutationResult<DeleteServiceAccountMutation>;
+export type DeleteServiceAccountMutationOptions = Apollo.BaseMutationOptions<DeleteServiceAccountMutation, DeleteServiceAccountMutationVariables>;
 export const PatchRefractDocument = gql`
-    mutation patchRefract($id: ID!, $name: String!, $remotes: [ID!]!) {
-  patchRefraction(id: $id, input: {name: $name, remotes: $remotes}) {
+    mutation patchRefract($id: ID!, $name: String!, $remotes: [ID!]!, $strategy: ResolutionStrategy!, $pins: StringMap!, $offline: Boolean!, $quarantineDays: Int!, $vulnerabilityAction: VulnerabilityAction!, $private: Boolean!) {
+  patchRefraction(
+    id: $id
+    input: {name: $name, remotes: $remotes, strategy: $strategy, pins: $pins, offline: $offline, quarantineDays: $quarantineDays, vulnerabilityAction: $vulnerabilityAction, private: $private}
+  ) {
     id
   }
 }
@@ -1088,6 +1967,12 @@ export type PatchRefractMutationFn = Apollo.MutationFunction<PatchRefractMutatio
  *      id: // value for 'id'
  *      name: // value for 'name'
  *      remotes: // value for 'remotes'
+ *      strategy: // value for 'strategy'
+ *      pins: // value for 'pins'
+ *      offline: // value for 'offline'
+ *      quarantineDays: // value for 'quarantineDays'
+ *      vulnerabilityAction: // value for 'vulnerabilityAction'
+ *      private: // value for 'private'
  *   },
  * });
  */
@@ -1172,6 +2057,12 @@ export const GetRefractionDocument = gql`
     updatedAt
     name
     archetype
+    strategy
+    pins
+    offline
+    quarantineDays
+    vulnerabilityAction
+    private
     remotes {
       id
       name
@@ -1280,10 +2171,160 @@ export function useRefSelectLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<
 export type RefSelectQueryHookResult = ReturnType<typeof useRefSelectQuery>;
 export type RefSelectLazyQueryHookResult = ReturnType<typeof useRefSelectLazyQuery>;
 export type RefSelectQueryResult = Apollo.QueryResult<RefSelectQuery, RefSelectQueryVariables>;
+export const ListQuarantineExemptionsDocument = gql`
+    query listQuarantineExemptions($refract: ID!) {
+  listQuarantineExemptions(refract: $refract) {
+    id
+    createdAt
+    package
+    version
+    createdBy
+  }
+}
+    `;
+
+/**
+ * __useListQuarantineExemptionsQuery__
+ *
+ * To run a query within a React component, call `useListQuarantineExemptionsQuery` and pass it any options that fit your needs.
+ * When your component renders, `useListQuarantineExemptionsQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useListQuarantineExemptionsQuery({
+ *   variables: {
+ *      refract: // value for 'refract'
+ *   },
+ * });
+ */
+export function useListQuarantineExemptionsQuery(baseOptions: Apollo.QueryHookOptions<ListQuarantineExemptionsQuery, ListQuarantineExemptionsQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<ListQuarantineExemptionsQuery, ListQuarantineExemptionsQueryVariables>(ListQuarantineExemptionsDocument, options);
+      }
+export function useListQuarantineExemptionsLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<ListQuarantineExemptionsQuery, ListQuarantineExemptionsQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<ListQuarantineExemptionsQuery, ListQuarantineExemptionsQueryVariables>(ListQuarantineExemptionsDocument, options);
+        }
+export type ListQuarantineExemptionsQueryHookResult = ReturnType<typeof useListQuarantineExemptionsQuery>;
+export type ListQuarantineExemptionsLazyQueryHookResult = ReturnType<typeof useListQuarantineExemptionsLazyQuery>;
+export type ListQuarantineExemptionsQueryResult = Apollo.QueryResult<ListQuarantineExemptionsQuery, ListQuarantineExemptionsQueryVariables>;
+export const CreateQuarantineExemptionDocument = gql`
+    mutation createQuarantineExemption($refractionID: ID!, $package: String!, $version: String!) {
+  createQuarantineExemption(
+    input: {refractionID: $refractionID, package: $package, version: $version}
+  ) {
+    id
+  }
+}
+    `;
+export type CreateQuarantineExemptionMutationFn = Apollo.MutationFunction<CreateQuarantineExemptionMutation, CreateQuarantineExemptionMutationVariables>;
+
+/**
+ * __useCreateQuarantineExemptionMutation__
+ *
+ * To run a mutation, you first call `useCreateQuarantineExemptionMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useCreateQuarantineExemptionMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [createQuarantineExemptionMutation, { data, loading, error }] = useCreateQuarantineExemptionMutation({
+ *   variables: {
+ *      refractionID: // value for 'refractionID'
+ *      package: // value for 'package'
+ *      version: // value for 'version'
+ *   },
+ * });
+ */
+export function useCreateQuarantineExemptionMutation(baseOptions?: Apollo.MutationHookOptions<CreateQuarantineExemptionMutation, CreateQuarantineExemptionMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<CreateQuarantineExemptionMutation, CreateQuarantineExemptionMutationVariables>(CreateQuarantineExemptionDocument, options);
+      }
+export type CreateQuarantineExemptionMutationHookResult = ReturnType<typeof useCreateQuarantineExemptionMutation>;
+export type CreateQuarantineExemptionMutationResult = Apollo.MutationResult<CreateQuarantineExemptionMutation>;
+export type CreateQuarantineExemptionMutationOptions = Apollo.BaseMutationOptions<CreateQuarantineExemptionMutation, CreateQuarantineExemptionMutationVariables>;
+export const DeleteQuarantineExemptionDocument = gql`
+    mutation deleteQuarantineExemption($id: ID!) {
+  deleteQuarantineExemption(id: $id)
+}
+    `;
+export type DeleteQuarantineExemptionMutationFn = Apollo.MutationFunction<DeleteQuarantineExemptionMutation, DeleteQuarantineExemptionMutationVariables>;
+
+/**
+ * __useDeleteQuarantineExemptionMutation__
+ *
+ * To run a mutation, you first call `useDeleteQuarantineExemptionMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useDeleteQuarantineExemptionMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [deleteQuarantineExemptionMutation, { data, loading, error }] = useDeleteQuarantineExemptionMutation({
+ *   variables: {
+ *      id: // value for 'id'
+ *   },
+ * });
+ */
+export function useDeleteQuarantineExemptionMutation(baseOptions?: Apollo.MutationHookOptions<DeleteQuarantineExemptionMutation, DeleteQuarantineExemptionMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<DeleteQuarantineExemptionMutation, DeleteQuarantineExemptionMutationVariables>(DeleteQuarantineExemptionDocument, options);
+      }
+export type DeleteQuarantineExemptionMutationHookResult = ReturnType<typeof useDeleteQuarantineExemptionMutation>;
+export type DeleteQuarantineExemptionMutationResult = Apollo.MutationResult<DeleteQuarantineExemptionMutation>;
+export type DeleteQuarantineExemptionMutationOptions = Apollo.BaseMutationOptions<DeleteQuarantineExemptionMutation, DeleteQuarantineExemptionMutationVariables>;
+export const ListAffectedArtifactsDocument = gql`
+    query listAffectedArtifacts($refract: ID) {
+  listAffectedArtifacts(refract: $refract) {
+    advisory {
+      id
+      summary
+      severity
+    }
+    artifacts {
+      id
+      uri
+    }
+  }
+}
+    `;
+
+/**
+ * __useListAffectedArtifactsQuery__
+ *
+ * To run a query within a React component, call `useListAffectedArtifactsQuery` and pass it any options that fit your needs.
+ * When your component renders, `useListAffectedArtifactsQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useListAffectedArtifactsQuery({
+ *   variables: {
+ *      refract: // value for 'refract'
+ *   },
+ * });
+ */
+export function useListAffectedArtifactsQuery(baseOptions?: Apollo.QueryHookOptions<ListAffectedArtifactsQuery, ListAffectedArtifactsQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<ListAffectedArtifactsQuery, ListAffectedArtifactsQueryVariables>(ListAffectedArtifactsDocument, options);
+      }
+export function useListAffectedArtifactsLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<ListAffectedArtifactsQuery, ListAffectedArtifactsQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<ListAffectedArtifactsQuery, ListAffectedArtifactsQueryVariables>(ListAffectedArtifactsDocument, options);
+        }
+export type ListAffectedArtifactsQueryHookResult = ReturnType<typeof useListAffectedArtifactsQuery>;
+export type ListAffectedArtifactsLazyQueryHookResult = ReturnType<typeof useListAffectedArtifactsLazyQuery>;
+export type ListAffectedArtifactsQueryResult = Apollo.QueryResult<ListAffectedArtifactsQuery, ListAffectedArtifactsQueryVariables>;
 export const CreateRemoteDocument = gql`
-    mutation createRemote($name: String!, $uri: String!, $archetype: Archetype!, $transport: ID!) {
+    mutation createRemote($name: String!, $uri: String!, $archetype: Archetype!, $transport: ID!, $hosted: Boolean!) {
   createRemote(
-    input: {name: $name, uri: $uri, archetype: $archetype, transport: $transport, authMode: NONE}
+    input: {name: $name, uri: $uri, archetype: $archetype, transport: $transport, authMode: NONE, hosted: $hosted}
   ) {
     id
   }
@@ -1308,6 +2349,7 @@ export type CreateRemoteMutationFn = Apollo.MutationFunction<CreateRemoteMutatio
  *      uri: // value for 'uri'
  *      archetype: // value for 'archetype'
  *      transport: // value for 'transport'
+ *      hosted: // value for 'hosted'
  *   },
  * });
  */
@@ -1319,10 +2361,10 @@ export type CreateRemoteMutationHookResult = ReturnType<typeof useCreateRemoteMu
 export type CreateRemoteMutationResult = Apollo.MutationResult<CreateRemoteMutation>;
 export type CreateRemoteMutationOptions = Apollo.BaseMutationOptions<CreateRemoteMutation, CreateRemoteMutationVariables>;
 export const PatchRemoteDocument = gql`
-    mutation patchRemote($id: ID!, $transportID: ID!, $allowed: [String!]!, $blocked: [String!]!, $authHeaders: [String!]!, $directHeader: String!, $directToken: String!, $authMode: AuthMode!) {
+    mutation patchRemote($id: ID!, $transportID: ID!, $allowed: [String!]!, $blocked: [String!]!, $rules: [String!]!, $policyMode: PolicyMode!, $authHeaders: [String!]!, $directHeader: String!, $directToken: String!, $authMode: AuthMode!, $timeout: Int!, $breakerThreshold: Int!, $breakerCooldown: Int!, $retentionMaxAge: Int!, $retentionMaxSize: Int!, $retentionKeepLatest: Int!) {
   patchRemote(
     id: $id
-    input: {transportID: $transportID, allowed: $allowed, blocked: $blocked, authHeaders: $authHeaders, directHeader: $directHeader, directToken: $directToken, authMode: $authMode}
+    input: {transportID: $transportID, allowed: $allowed, blocked: $blocked, rules: $rules, policyMode: $policyMode, authHeaders: $authHeaders, directHeader: $directHeader, directToken: $directToken, authMode: $authMode, timeout: $timeout, breakerThreshold: $breakerThreshold, breakerCooldown: $breakerCooldown, retentionMaxAge: $retentionMaxAge, retentionMaxSize: $retentionMaxSize, retentionKeepLatest: $retentionKeepLatest}
   ) {
     id
   }
@@ -1347,10 +2389,18 @@ export type PatchRemoteMutationFn = Apollo.MutationFunction<PatchRemoteMutation,
  *      transportID: // value for 'transportID'
  *      allowed: // value for 'allowed'
  *      blocked: // value for 'blocked'
+ *      rules: // value for 'rules'
+ *      policyMode: // value for 'policyMode'
  *      authHeaders: // value for 'authHeaders'
  *      directHeader: // value for 'directHeader'
  *      directToken: // value for 'directToken'
  *      authMode: // value for 'authMode'
+ *      timeout: // value for 'timeout'
+ *      breakerThreshold: // value for 'breakerThreshold'
+ *      breakerCooldown: // value for 'breakerCooldown'
+ *      retentionMaxAge: // value for 'retentionMaxAge'
+ *      retentionMaxSize: // value for 'retentionMaxSize'
+ *      retentionKeepLatest: // value for 'retentionKeepLatest'
  *   },
  * });
  */
@@ -1402,10 +2452,19 @@ export const GetRemoteDocument = gql`
     uri
     archetype
     enabled
+    hosted
+    timeout
+    breakerThreshold
+    breakerCooldown
+    retentionMaxAge
+    retentionMaxSize
+    retentionKeepLatest
     security {
       id
       allowed
       blocked
+      rules
+      policyMode
       authMode
       directHeader
       directToken
@@ -1453,6 +2512,132 @@ export function useGetRemoteLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<
 export type GetRemoteQueryHookResult = ReturnType<typeof useGetRemoteQuery>;
 export type GetRemoteLazyQueryHookResult = ReturnType<typeof useGetRemoteLazyQuery>;
 export type GetRemoteQueryResult = Apollo.QueryResult<GetRemoteQuery, GetRemoteQueryVariables>;
+export const GetRemoteHealthDocument = gql`
+    query getRemoteHealth($id: ID!) {
+  getRemoteHealth(id: $id) {
+    id
+    replica
+    state
+    requests
+    failures
+    errorRate
+    latency
+    latencyMax
+    consecutiveFailures
+    lastError
+    lastFailure
+    history {
+      time
+      state
+      reason
+    }
+  }
+}
+    `;
+
+/**
+ * __useGetRemoteHealthQuery__
+ *
+ * To run a query within a React component, call `useGetRemoteHealthQuery` and pass it any options that fit your needs.
+ * When your component renders, `useGetRemoteHealthQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useGetRemoteHealthQuery({
+ *   variables: {
+ *      id: // value for 'id'
+ *   },
+ * });
+ */
+export function useGetRemoteHealthQuery(baseOptions: Apollo.QueryHookOptions<GetRemoteHealthQuery, GetRemoteHealthQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<GetRemoteHealthQuery, GetRemoteHealthQueryVariables>(GetRemoteHealthDocument, options);
+      }
+export function useGetRemoteHealthLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<GetRemoteHealthQuery, GetRemoteHealthQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<GetRemoteHealthQuery, GetRemoteHealthQueryVariables>(GetRemoteHealthDocument, options);
+        }
+export type GetRemoteHealthQueryHookResult = ReturnType<typeof useGetRemoteHealthQuery>;
+export type GetRemoteHealthLazyQueryHookResult = ReturnType<typeof useGetRemoteHealthLazyQuery>;
+export type GetRemoteHealthQueryResult = Apollo.QueryResult<GetRemoteHealthQuery, GetRemoteHealthQueryVariables>;
+export const GetRetentionReportDocument = gql`
+    query getRetentionReport($id: ID!) {
+  getRetentionReport(id: $id) {
+    remoteID
+    count
+    size
+    candidates {
+      id
+      uri
+      reason
+      size
+      downloads
+      updatedAt
+    }
+  }
+}
+    `;
+
+/**
+ * __useGetRetentionReportQuery__
+ *
+ * To run a query within a React component, call `useGetRetentionReportQuery` and pass it any options that fit your needs.
+ * When your component renders, `useGetRetentionReportQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useGetRetentionReportQuery({
+ *   variables: {
+ *      id: // value for 'id'
+ *   },
+ * });
+ */
+export function useGetRetentionReportQuery(baseOptions: Apollo.QueryHookOptions<GetRetentionReportQuery, GetRetentionReportQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<GetRetentionReportQuery, GetRetentionReportQueryVariables>(GetRetentionReportDocument, options);
+      }
+export function useGetRetentionReportLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<GetRetentionReportQuery, GetRetentionReportQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<GetRetentionReportQuery, GetRetentionReportQueryVariables>(GetRetentionReportDocument, options);
+        }
+export type GetRetentionReportQueryHookResult = ReturnType<typeof useGetRetentionReportQuery>;
+export type GetRetentionReportLazyQueryHookResult = ReturnType<typeof useGetRetentionReportLazyQuery>;
+export type GetRetentionReportQueryResult = Apollo.QueryResult<GetRetentionReportQuery, GetRetentionReportQueryVariables>;
+export const CollectGarbageDocument = gql`
+    mutation collectGarbage($id: ID!) {
+  collectGarbage(id: $id)
+}
+    `;
+export type CollectGarbageMutationFn = Apollo.MutationFunction<CollectGarbageMutation, CollectGarbageMutationVariables>;
+
+/**
+ * __useCollectGarbageMutation__
+ *
+ * To run a mutation, you first call `useCollectGarbageMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useCollectGarbageMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [collectGarbageMutation, { data, loading, error }] = useCollectGarbageMutation({
+ *   variables: {
+ *      id: // value for 'id'
+ *   },
+ * });
+ */
+export function useCollectGarbageMutation(baseOptions?: Apollo.MutationHookOptions<CollectGarbageMutation, CollectGarbageMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<CollectGarbageMutation, CollectGarbageMutationVariables>(CollectGarbageDocument, options);
+      }
+export type CollectGarbageMutationHookResult = ReturnType<typeof useCollectGarbageMutation>;
+export type CollectGarbageMutationResult = Apollo.MutationResult<CollectGarbageMutation>;
+export type CollectGarbageMutationOptions = Apollo.BaseMutationOptions<CollectGarbageMutation, CollectGarbageMutationVariables>;
 export const ListRemotesDocument = gql`
     query listRemotes($arch: String!) {
   listRemotes(arch: $arch) {
@@ -1462,6 +2647,7 @@ export const ListRemotesDocument = gql`
     createdAt
     uri
     enabled
+    hosted
     archetype
   }
 }